@@ -2,17 +2,25 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/snappy"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
 	"k8s.io/klog/v2"
 
 	"kubevirt.io/containerized-data-importer/pkg/common"
@@ -21,12 +29,31 @@ import (
 	prometheusutil "kubevirt.io/containerized-data-importer/pkg/util/prometheus"
 )
 
+const (
+	// bandwidthLimitPollInterval is how often the bandwidth limit file is checked for changes
+	bandwidthLimitPollInterval = 5 * time.Second
+	// minThrottleBurst is the minimum number of bytes read at once when throttled
+	minThrottleBurst = 32 * 1024
+	// fanOutChunkSize is the size of the chunks read from the source and copied to the fan-out targets
+	fanOutChunkSize = 1024 * 1024
+	// fanOutTargetBuffers is the number of chunks a fan-out target may lag behind the fastest target
+	fanOutTargetBuffers = 16
+)
+
 var (
 	contentType string
 	mountPoint  string
 	uploadBytes uint64
+
+	// fanOutWriteTimeout is how long a fan-out target that fell behind may hold back the others before it is dropped
+	fanOutWriteTimeout = 5 * time.Minute
 )
 
+type uploadTarget struct {
+	URL      string `json:"url"`
+	OwnerUID string `json:"ownerUID"`
+}
+
 type execReader struct {
 	cmd    *exec.Cmd
 	stdout io.ReadCloser
@@ -93,7 +120,7 @@ func startPrometheus() {
 	prometheusutil.StartPrometheusEndpoint(certsDirectory)
 }
 
-func createProgressReader(readCloser io.ReadCloser, ownerUID string, totalBytes uint64) io.ReadCloser {
+func createProgressCounter() *prometheus.CounterVec {
 	progress := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: monitoring.InternalMetricOptsList[monitoring.CloneProgress].Name,
@@ -102,7 +129,10 @@ func createProgressReader(readCloser io.ReadCloser, ownerUID string, totalBytes
 		[]string{"ownerUID"},
 	)
 	prometheus.MustRegister(progress)
+	return progress
+}
 
+func createProgressReader(readCloser io.ReadCloser, progress *prometheus.CounterVec, ownerUID string, totalBytes uint64) io.ReadCloser {
 	promReader := prometheusutil.NewProgressReader(readCloser, totalBytes, progress, ownerUID)
 	promReader.StartTimedUpdate()
 
@@ -116,20 +146,307 @@ func pipeToSnappy(reader io.ReadCloser) io.ReadCloser {
 	go func() {
 		n, err := io.Copy(sbw, reader)
 		if err != nil {
-			klog.Fatalf("Error %s piping to gzip", err)
+			// the upload fails as well, stop reading for this target
+			klog.Errorf("Error %s piping to snappy", err)
+			reader.Close()
+			pw.CloseWithError(err)
+			return
 		}
 		if err = sbw.Close(); err != nil {
-			klog.Fatalf("Error closing snappy writer %+v", err)
-		}
-		if err = pw.Close(); err != nil {
-			klog.Fatalf("Error closing pipe writer %+v", err)
+			// only the upload of this target fails, the others continue
+			klog.Errorf("Error closing snappy writer %+v", err)
+			pw.CloseWithError(err)
+			return
 		}
+		pw.Close()
 		klog.Infof("Wrote %d bytes\n", n)
 	}()
 
 	return pr
 }
 
+// fanOutWriter writes the chunks read from the source to a single target, so a slow target doesn't hold back the others
+type fanOutWriter struct {
+	pipe   *io.PipeWriter
+	chunks chan []byte
+	// done is closed once the target stopped reading
+	done chan struct{}
+}
+
+func newFanOutWriter(pipe *io.PipeWriter) *fanOutWriter {
+	w := &fanOutWriter{
+		pipe:   pipe,
+		chunks: make(chan []byte, fanOutTargetBuffers),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		for chunk := range w.chunks {
+			if _, err := w.pipe.Write(chunk); err != nil {
+				return
+			}
+		}
+	}()
+	return w
+}
+
+// write queues the chunk, failing if the target stopped reading or fell too far behind for fanOutWriteTimeout
+func (w *fanOutWriter) write(chunk []byte) error {
+	select {
+	case w.chunks <- chunk:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(fanOutWriteTimeout)
+	defer timer.Stop()
+	select {
+	case w.chunks <- chunk:
+		return nil
+	case <-w.done:
+		return io.ErrClosedPipe
+	case <-timer.C:
+		return fmt.Errorf("clone target did not read for %v", fanOutWriteTimeout)
+	}
+}
+
+// close closes the target pipe with err once the queued chunks are written
+func (w *fanOutWriter) close(err error) {
+	close(w.chunks)
+	go func() {
+		<-w.done
+		w.pipe.CloseWithError(err)
+	}()
+}
+
+// abort drops the target, failing its reader with err
+func (w *fanOutWriter) abort(err error) {
+	w.pipe.CloseWithError(err)
+	close(w.chunks)
+}
+
+// fanOut reads the input once and copies it to one pipe per target.
+// A target whose pipe is closed by the reader side, or which falls behind for too long, is dropped, the others continue.
+func fanOut(reader io.ReadCloser, n int) []*io.PipeReader {
+	readers := make([]*io.PipeReader, n)
+	writers := make([]*fanOutWriter, n)
+	for i := range readers {
+		var pw *io.PipeWriter
+		readers[i], pw = io.Pipe()
+		writers[i] = newFanOutWriter(pw)
+	}
+
+	go func() {
+		defer reader.Close()
+		for {
+			// the chunks are shared by the target writers, so each read gets its own buffer
+			buf := make([]byte, fanOutChunkSize)
+			count, err := reader.Read(buf)
+			if count > 0 {
+				live := 0
+				for i, w := range writers {
+					if w == nil {
+						continue
+					}
+					if werr := w.write(buf[:count]); werr != nil {
+						klog.Errorf("Dropping clone target %d: %v", i, werr)
+						w.abort(werr)
+						writers[i] = nil
+						continue
+					}
+					live++
+				}
+				if live == 0 {
+					klog.Errorf("No clone targets left")
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				for _, w := range writers {
+					if w != nil {
+						w.close(err)
+					}
+				}
+				return
+			}
+		}
+	}()
+
+	return readers
+}
+
+// throttledReader limits the rate at which the source is read
+type throttledReader struct {
+	io.ReadCloser
+	limiter *rate.Limiter
+}
+
+func (tr *throttledReader) Read(p []byte) (int, error) {
+	if tr.limiter.Limit() != rate.Inf && len(p) > tr.limiter.Burst() {
+		p = p[:tr.limiter.Burst()]
+	}
+	n, err := tr.ReadCloser.Read(p)
+	if werr := tr.wait(n); werr != nil {
+		return n, werr
+	}
+	return n, err
+}
+
+// wait blocks until n bytes are allowed, in chunks since the burst may change while reading
+func (tr *throttledReader) wait(n int) error {
+	for n > 0 && tr.limiter.Limit() != rate.Inf {
+		chunk := n
+		if burst := tr.limiter.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := tr.limiter.WaitN(context.Background(), chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// setLimit sets the rate limit of the reader, a limit of rate.Inf disables throttling
+func (tr *throttledReader) setLimit(limit rate.Limit) {
+	if tr.limiter.Limit() == limit {
+		return
+	}
+	klog.Infof("Setting clone bandwidth limit to %v bytes/sec", limit)
+	if limit != rate.Inf {
+		burst := int(limit)
+		if burst < minThrottleBurst {
+			burst = minThrottleBurst
+		}
+		tr.limiter.SetBurst(burst)
+	}
+	tr.limiter.SetLimit(limit)
+}
+
+// watchLimit polls the bandwidth limit file, so the limit can be changed while cloning
+func (tr *throttledReader) watchLimit(path string, interval time.Duration) {
+	go func() {
+		for {
+			time.Sleep(interval)
+			tr.setLimit(readBandwidthLimit(path))
+		}
+	}()
+}
+
+func newThrottledReader(reader io.ReadCloser, limit rate.Limit) *throttledReader {
+	tr := &throttledReader{
+		ReadCloser: reader,
+		limiter:    rate.NewLimiter(rate.Inf, 0),
+	}
+	tr.setLimit(limit)
+	return tr
+}
+
+// readBandwidthLimit returns the bandwidth limit in bytes per second, rate.Inf if not limited
+func readBandwidthLimit(path string) rate.Limit {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return rate.Inf
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return rate.Inf
+	}
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit <= 0 {
+		klog.Errorf("Ignoring invalid clone bandwidth limit %q", value)
+		return rate.Inf
+	}
+	return rate.Limit(limit)
+}
+
+func getUploadTargets() []uploadTarget {
+	value := os.Getenv(common.ClonerUploadTargets)
+	if value == "" {
+		return []uploadTarget{
+			{
+				URL:      getEnvVarOrDie("UPLOAD_URL"),
+				OwnerUID: getEnvVarOrDie(common.OwnerUID),
+			},
+		}
+	}
+
+	var targets []uploadTarget
+	if err := json.Unmarshal([]byte(value), &targets); err != nil {
+		klog.Fatalf("Error parsing %s: %+v", common.ClonerUploadTargets, err)
+	}
+	if len(targets) == 0 {
+		klog.Fatalf("No targets in %s", common.ClonerUploadTargets)
+	}
+
+	return targets
+}
+
+func upload(client *http.Client, url string, reader io.Reader) error {
+	req, err := http.NewRequest("POST", url, reader)
+	if err != nil {
+		return err
+	}
+
+	if contentType != "" {
+		req.Header.Set("x-cdi-content-type", contentType)
+		klog.Infof("Set header to %s", contentType)
+	}
+
+	response, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error %w POSTing to %s", err, url)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d from %s", response.StatusCode, url)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, response.Body)
+	if err != nil {
+		return fmt.Errorf("error %s copying response body", err)
+	}
+
+	klog.V(1).Infof("Response body from %s:\n%s", url, buf.String())
+	return nil
+}
+
+// uploadToTargets streams the input to all targets concurrently and returns the owner UIDs of the failed targets,
+// along with the last target error
+func uploadToTargets(client *http.Client, input io.ReadCloser, targets []uploadTarget, progress *prometheus.CounterVec) ([]string, error) {
+	readers := fanOut(input, len(targets))
+	errs := make([]error, len(targets))
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reader := pipeToSnappy(createProgressReader(readers[i], progress, targets[i].OwnerUID, uploadBytes))
+			if errs[i] = upload(client, targets[i].URL, reader); errs[i] != nil {
+				readers[i].CloseWithError(errs[i])
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var failed []string
+	var lastErr error
+	for i, err := range errs {
+		if err != nil {
+			klog.Errorf("Clone to %s failed: %+v", targets[i].URL, err)
+			failed = append(failed, targets[i].OwnerUID)
+			lastErr = err
+		}
+	}
+	return failed, lastErr
+}
+
 func validateContentType() {
 	switch contentType {
 	case "filesystem-clone", "blockdevice-clone":
@@ -144,7 +461,21 @@ func validateMount() {
 	}
 }
 
-func newTarReader(preallocation bool) (io.ReadCloser, error) {
+func getPathsFromEnv(name string) []string {
+	value := os.Getenv(name)
+	if value == "" {
+		return nil
+	}
+
+	var paths []string
+	if err := json.Unmarshal([]byte(value), &paths); err != nil {
+		klog.Fatalf("Error parsing %s: %+v", name, err)
+	}
+
+	return paths
+}
+
+func newTarReader(preallocation bool, includePaths, excludePaths []string) (io.ReadCloser, error) {
 	excludeMap := map[string]struct{}{
 		"lost+found": struct{}{},
 	}
@@ -155,17 +486,32 @@ func newTarReader(preallocation bool) (io.ReadCloser, error) {
 		args = append(args, "-S")
 	}
 
-	files, err := os.ReadDir(mountPoint)
-	if err != nil {
-		return nil, err
+	for _, p := range excludePaths {
+		// --exclude has to come before the files it applies to
+		args = append(args, "--exclude", p)
 	}
 
 	var tarFiles []string
-	for _, f := range files {
-		if _, ok := excludeMap[f.Name()]; ok {
-			continue
+	if len(includePaths) > 0 {
+		for _, p := range includePaths {
+			p = filepath.Clean(p)
+			if filepath.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+				return nil, fmt.Errorf("include path %q is outside of the volume", p)
+			}
+			tarFiles = append(tarFiles, p)
+		}
+	} else {
+		files, err := os.ReadDir(mountPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range files {
+			if _, ok := excludeMap[f.Name()]; ok {
+				continue
+			}
+			tarFiles = append(tarFiles, f.Name())
 		}
-		tarFiles = append(tarFiles, f.Name())
 	}
 
 	if len(tarFiles) > 0 {
@@ -198,7 +544,7 @@ func getInputStream(preallocation bool) (rc io.ReadCloser) {
 	var err error
 	switch contentType {
 	case "filesystem-clone":
-		rc, err = newTarReader(preallocation)
+		rc, err = newTarReader(preallocation, getPathsFromEnv(common.ClonerIncludePaths), getPathsFromEnv(common.ClonerExcludePaths))
 		if err != nil {
 			klog.Fatalf("Error creating tar reader for %q: %+v", mountPoint, err)
 		}
@@ -224,13 +570,12 @@ func main() {
 	validateContentType()
 	validateMount()
 
-	ownerUID := getEnvVarOrDie(common.OwnerUID)
+	targets := getUploadTargets()
 
 	clientKey := []byte(getEnvVarOrDie("CLIENT_KEY"))
 	clientCert := []byte(getEnvVarOrDie("CLIENT_CERT"))
 	serverCert := []byte(getEnvVarOrDie("SERVER_CA_CERT"))
 
-	url := getEnvVarOrDie("UPLOAD_URL")
 	preallocation, err := strconv.ParseBool(getEnvVarOrDie(common.Preallocation)) // False is default in case of error
 	if err != nil {
 		klog.V(3).Infof("Preallocation variable (%s) not set, defaulting to 'false'", common.Preallocation)
@@ -238,42 +583,33 @@ func main() {
 
 	klog.V(1).Infoln("Starting cloner target")
 
-	reader := pipeToSnappy(createProgressReader(getInputStream(preallocation), ownerUID, uploadBytes))
+	progress := createProgressCounter()
+	limitPath := filepath.Join(common.ClonerBandwidthLimitDir, common.ClonerBandwidthLimitFile)
+	input := newThrottledReader(getInputStream(preallocation), readBandwidthLimit(limitPath))
+	input.watchLimit(limitPath, bandwidthLimitPollInterval)
 
 	startPrometheus()
 
 	client := createHTTPClient(clientKey, clientCert, serverCert)
 
-	req, _ := http.NewRequest("POST", url, reader)
-
-	if contentType != "" {
-		req.Header.Set("x-cdi-content-type", contentType)
-		klog.Infof("Set header to %s", contentType)
-	}
-
-	response, err := client.Do(req)
-	if err != nil {
-		klog.Fatalf("Error %s POSTing to %s", err, url)
-	}
-
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		klog.Fatalf("Unexpected status code %d", response.StatusCode)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, response.Body)
-	if err != nil {
-		klog.Fatalf("Error %s copying response body", err)
+	failed, err := uploadToTargets(client, input, targets, progress)
+	if len(failed) == len(targets) {
+		if err := util.WriteTerminationError(err, fmt.Sprintf("Clone failed for all %d targets: %v", len(targets), err)); err != nil {
+			klog.Errorf("%+v", err)
+		}
+		klog.Fatalf("Clone failed for all %d targets", len(targets))
 	}
 
-	klog.V(1).Infof("Response body:\n%s", buf.String())
-
 	klog.V(1).Infoln("clone complete")
 	message := "Clone Complete"
 	if preallocation {
 		message += ", " + common.PreallocationApplied
 	}
-	err = util.WriteTerminationMessage(message)
+	if len(failed) > 0 {
+		err = util.WriteCloneSourceResult(&util.CloneSourceResult{Message: message, FailedTargets: failed})
+	} else {
+		err = util.WriteTerminationMessage(message)
+	}
 	if err != nil {
 		klog.Errorf("%+v", err)
 		os.Exit(1)
@@ -1,13 +1,24 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/golang/snappy"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/pointer"
 
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	prometheusutil "kubevirt.io/containerized-data-importer/pkg/util/prometheus"
 )
 
@@ -28,6 +39,186 @@ var _ = Describe("Prometheus Endpoint", func() {
 	})
 })
 
+var _ = Describe("Tar reader", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "clone-source")
+		Expect(err).NotTo(HaveOccurred())
+		for _, f := range []string{"lost+found/x", "datasets/train/a", "datasets/train/b.tmp", "datasets/test/c", "disk.img"} {
+			Expect(os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(f)), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(tmpDir, f), []byte("data"), 0644)).To(Succeed())
+		}
+		mountPoint = tmpDir
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	tarFiles := func(includePaths, excludePaths []string) []string {
+		rc, err := newTarReader(false, includePaths, excludePaths)
+		Expect(err).NotTo(HaveOccurred())
+		defer rc.Close()
+		var files []string
+		tr := tar.NewReader(rc)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+			if hdr.Typeflag == tar.TypeReg {
+				files = append(files, hdr.Name)
+			}
+		}
+		return files
+	}
+
+	It("Should tar everything except lost+found", func() {
+		Expect(tarFiles(nil, nil)).To(ConsistOf("datasets/train/a", "datasets/train/b.tmp", "datasets/test/c", "disk.img"))
+	})
+
+	It("Should only tar included paths", func() {
+		Expect(tarFiles([]string{"datasets/train"}, nil)).To(ConsistOf("datasets/train/a", "datasets/train/b.tmp"))
+	})
+
+	It("Should skip excluded paths", func() {
+		Expect(tarFiles([]string{"datasets"}, []string{"*.tmp", "datasets/test"})).To(ConsistOf("datasets/train/a"))
+	})
+
+	It("Should reject include paths outside of the mount", func() {
+		_, err := newTarReader(false, []string{"../etc"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Fan-out", func() {
+	It("Should copy the input to all readers", func() {
+		readers := fanOut(io.NopCloser(bytes.NewReader([]byte("source data"))), 3)
+		var wg sync.WaitGroup
+		results := make([][]byte, len(readers))
+		for i := range readers {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				var err error
+				results[i], err = io.ReadAll(readers[i])
+				Expect(err).NotTo(HaveOccurred())
+			}(i)
+		}
+		wg.Wait()
+		for _, result := range results {
+			Expect(string(result)).To(Equal("source data"))
+		}
+	})
+
+	It("Should keep copying to the other readers when one is closed", func() {
+		readers := fanOut(io.NopCloser(bytes.NewReader(make([]byte, 4*1024*1024))), 2)
+		readers[0].CloseWithError(io.ErrUnexpectedEOF)
+		data, err := io.ReadAll(readers[1])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(4 * 1024 * 1024))
+	})
+
+	It("Should drop a reader which falls behind for too long", func() {
+		defer func(timeout time.Duration) {
+			fanOutWriteTimeout = timeout
+		}(fanOutWriteTimeout)
+		fanOutWriteTimeout = 100 * time.Millisecond
+
+		size := (fanOutTargetBuffers + 4) * fanOutChunkSize
+		readers := fanOut(io.NopCloser(bytes.NewReader(make([]byte, size))), 2)
+		data, err := io.ReadAll(readers[1])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(size))
+		_, err = io.ReadAll(readers[0])
+		Expect(err).To(MatchError(ContainSubstring("did not read")))
+	})
+
+	It("Should upload to all targets and report the failed ones", func() {
+		var mutex sync.Mutex
+		var received [][]byte
+		good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			data, err := io.ReadAll(snappy.NewReader(r.Body))
+			Expect(err).NotTo(HaveOccurred())
+			mutex.Lock()
+			received = append(received, data)
+			mutex.Unlock()
+		}))
+		defer good.Close()
+		bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer bad.Close()
+
+		progress := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_progress"}, []string{"ownerUID"})
+		targets := []uploadTarget{
+			{URL: good.URL, OwnerUID: "uid1"},
+			{URL: bad.URL, OwnerUID: "uid2"},
+			{URL: good.URL, OwnerUID: "uid3"},
+		}
+		input := io.NopCloser(bytes.NewReader([]byte("source data")))
+		failed, err := uploadToTargets(http.DefaultClient, input, targets, progress)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 500")))
+		Expect(failed).To(ConsistOf("uid2"))
+		Expect(received).To(HaveLen(2))
+		for _, data := range received {
+			Expect(string(data)).To(Equal("source data"))
+		}
+	})
+})
+
+var _ = Describe("Throttled reader", func() {
+	It("Should not throttle without a limit", func() {
+		tr := newThrottledReader(io.NopCloser(bytes.NewReader(make([]byte, 4*1024*1024))), rate.Inf)
+		start := time.Now()
+		data, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(4 * 1024 * 1024))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("Should limit the read rate", func() {
+		// the first burst is free, the next one waits a second
+		tr := newThrottledReader(io.NopCloser(bytes.NewReader(make([]byte, 2*minThrottleBurst))), rate.Limit(minThrottleBurst))
+		start := time.Now()
+		data, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(2 * minThrottleBurst))
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+	})
+
+	It("Should apply limit changes", func() {
+		tr := newThrottledReader(io.NopCloser(bytes.NewReader(nil)), rate.Limit(1024*1024))
+		Expect(tr.limiter.Limit()).To(Equal(rate.Limit(1024 * 1024)))
+		Expect(tr.limiter.Burst()).To(Equal(1024 * 1024))
+		tr.setLimit(rate.Limit(1024))
+		Expect(tr.limiter.Limit()).To(Equal(rate.Limit(1024)))
+		Expect(tr.limiter.Burst()).To(Equal(minThrottleBurst))
+		tr.setLimit(rate.Inf)
+		Expect(tr.limiter.Limit()).To(Equal(rate.Inf))
+	})
+
+	DescribeTable("Should read the bandwidth limit", func(content *string, expected rate.Limit) {
+		path := filepath.Join(GinkgoT().TempDir(), common.ClonerBandwidthLimitFile)
+		if content != nil {
+			Expect(os.WriteFile(path, []byte(*content), 0600)).To(Succeed())
+		}
+		Expect(readBandwidthLimit(path)).To(Equal(expected))
+	},
+		Entry("missing file", nil, rate.Inf),
+		Entry("empty file", pointer.String(""), rate.Inf),
+		Entry("invalid value", pointer.String("fast"), rate.Inf),
+		Entry("zero", pointer.String("0"), rate.Inf),
+		Entry("valid value", pointer.String("1048576"), rate.Limit(1048576)),
+		Entry("valid value with newline", pointer.String("1024\n"), rate.Limit(1024)),
+	)
+})
+
 func isDirEmpty(dirName string) (bool, error) {
 	f, err := os.Open(dirName)
 	if err != nil {
@@ -15,11 +15,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap/zapcore"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/clientcmd"
@@ -213,6 +216,10 @@ func start() {
 		klog.Errorf("Unable to create shared indexes: %v", err)
 		os.Exit(1)
 	}
+	if err := controller.CreateWorkerQueueIndexes(mgr); err != nil {
+		klog.Errorf("Unable to create worker queue indexes: %v", err)
+		os.Exit(1)
+	}
 
 	ctx := signals.SetupSignalHandler()
 
@@ -240,7 +247,7 @@ func start() {
 		os.Exit(1)
 	}
 
-	if _, err := controller.NewImportController(mgr, log, importerImage, pullPolicy, verbose, installerLabels); err != nil {
+	if _, err := controller.NewImportController(mgr, log, importerImage, pullPolicy, verbose, getTokenPrivateKey(), installerLabels); err != nil {
 		klog.Errorf("Unable to setup import controller: %v", err)
 		os.Exit(1)
 	}
@@ -264,6 +271,14 @@ func start() {
 		klog.Errorf("Unable to setup dataimportcron controller: %v", err)
 		os.Exit(1)
 	}
+	if _, err := controller.NewImportCacheController(mgr, log, importerImage, pullPolicy, installerLabels); err != nil {
+		klog.Errorf("Unable to setup import cache controller: %v", err)
+		os.Exit(1)
+	}
+	if _, err := controller.NewPreflightController(mgr, log, importerImage, pullPolicy, verbose, installerLabels); err != nil {
+		klog.Errorf("Unable to setup preflight controller: %v", err)
+		os.Exit(1)
+	}
 	if _, err := controller.NewDataSourceController(mgr, log, installerLabels); err != nil {
 		klog.Errorf("Unable to setup datasource controller: %v", err)
 		os.Exit(1)
@@ -380,6 +395,11 @@ func registerMetrics() {
 // at the point of writing this, we don't care about VolumeSnapshots without the CDI label
 func getNewManagerCache(cdiNamespace string) cache.NewCacheFunc {
 	namespaceSelector := fields.Set{"metadata.namespace": cdiNamespace}.AsSelector()
+	// DataImportCron poller Jobs are in the CDI namespace, and validation Jobs are in the cron namespace
+	cronJobRequirement, err := labels.NewRequirement(common.DataImportCronLabel, selection.Exists, nil)
+	if err != nil {
+		klog.Fatalf("Unable to create DataImportCron Job selector: %v\n", errors.WithStack(err))
+	}
 	return cache.BuilderWithOptions(
 		cache.Options{
 			SelectorsByObject: cache.SelectorsByObject{
@@ -392,9 +412,12 @@ func getNewManagerCache(cdiNamespace string) cache.NewCacheFunc {
 				&batchv1.CronJob{}: {
 					Field: namespaceSelector,
 				},
-				&batchv1.Job{}: {
+				&appsv1.DaemonSet{}: {
 					Field: namespaceSelector,
 				},
+				&batchv1.Job{}: {
+					Label: labels.NewSelector().Add(*cronJobRequirement),
+				},
 			},
 		},
 	)
@@ -52,26 +52,20 @@ func waitForReadyFile() {
 		}
 		time.Sleep(time.Second)
 	}
-	err := util.WriteTerminationMessage(fmt.Sprintf("Timeout waiting for file %s", readyFile))
-	if err != nil {
-		klog.Errorf("%+v", err)
-	}
-	os.Exit(1)
+	exitWithTerminationError(errors.Errorf("timeout waiting for file %s", readyFile), fmt.Sprintf("Timeout waiting for file %s", readyFile))
 }
 
 func getHTTPEp(ep string) string {
 	readyFile, err := util.ParseEnvVar(common.ImporterReadyFile, false)
 	if err != nil {
-		klog.Errorf("Failed parsing env var %s: %+v", common.ImporterReadyFile, err)
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("Failed parsing env var %s: %v", common.ImporterReadyFile, err))
 	}
 	if len(readyFile) == 0 {
 		return ep
 	}
 	imageName, err := os.ReadFile(readyFile)
 	if err != nil {
-		klog.Errorf("Failed reading file %s: %+v", readyFile, err)
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("Failed reading file %s: %v", readyFile, err))
 	}
 	if len(imageName) == 0 {
 		return ep
@@ -108,8 +102,18 @@ func main() {
 	filesystemOverhead, _ := strconv.ParseFloat(os.Getenv(common.FilesystemOverheadVar), 64)
 	preallocation, err := strconv.ParseBool(os.Getenv(common.Preallocation))
 	if err != nil {
-		klog.Errorf(`the %s environment variable is with a wrong value "%s"; should be "true" or "false"`, common.Preallocation, os.Getenv(common.Preallocation))
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf(`the %s environment variable is with a wrong value "%s"; should be "true" or "false"`, common.Preallocation, os.Getenv(common.Preallocation)))
+	}
+
+	//Registry import currently support kubevirt content type only
+	if contentType != string(cdiv1.DataVolumeKubeVirt) && (source == cc.SourceRegistry || source == cc.SourceImageio) {
+		message := fmt.Sprintf("Unsupported content type %s when importing from %s", contentType, source)
+		exitWithTerminationError(errors.New(message), message)
+	}
+
+	// A dry run has no target volume
+	if dryRun, _ := strconv.ParseBool(os.Getenv(common.ImporterDryRun)); dryRun {
+		os.Exit(handleDryRun(source, contentType))
 	}
 
 	volumeMode := v1.PersistentVolumeBlock
@@ -122,26 +126,19 @@ func main() {
 	// Also might be a good idea to sync any chmod's we might have done.
 	defer fsyncDataFile(contentType, volumeMode)
 
-	//Registry import currently support kubevirt content type only
-	if contentType != string(cdiv1.DataVolumeKubeVirt) && (source == cc.SourceRegistry || source == cc.SourceImageio) {
-		klog.Errorf("Unsupported content type %s when importing from %s", contentType, source)
-		os.Exit(1)
-	}
-
 	availableDestSpace, err := util.GetAvailableSpaceByVolumeMode(volumeMode)
 	if err != nil {
-		klog.Errorf("%+v", err)
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("Unable to get the available space: %v", err))
 	}
 	if source == cc.SourceNone {
 		err := handleEmptyImage(contentType, imageSize, availableDestSpace, preallocation, volumeMode, filesystemOverhead)
 		if err != nil {
-			klog.Errorf("%+v", err)
-			os.Exit(1)
+			exitWithTerminationError(err, fmt.Sprintf("Unable to create empty image: %v", err))
 		}
 	} else {
 		waitForReadyFile()
-		exitCode := handleImport(source, contentType, volumeMode, imageSize, filesystemOverhead, preallocation)
+		sparsify, _ := strconv.ParseBool(os.Getenv(common.ImporterSparsify))
+		exitCode := handleImport(source, contentType, volumeMode, imageSize, filesystemOverhead, preallocation, sparsify)
 		if exitCode != 0 {
 			os.Exit(exitCode)
 		}
@@ -162,7 +159,7 @@ func handleEmptyImage(contentType string, imageSize string, availableDestSpace i
 		errorEmptyDiskWithContentTypeArchive()
 	}
 
-	err := importCompleteTerminationMessage(preallocationApplied)
+	err := importCompleteTerminationMessage(preallocationApplied, importer.SparsifyResult{}, false)
 	return err
 }
 
@@ -172,24 +169,35 @@ func handleImport(
 	volumeMode v1.PersistentVolumeMode,
 	imageSize string,
 	filesystemOverhead float64,
-	preallocation bool) int {
+	preallocation bool,
+	sparsify bool) int {
 	klog.V(1).Infoln("begin import process")
 
 	ds := newDataSource(source, contentType, volumeMode)
 	defer ds.Close()
 
-	processor := newDataProcessor(contentType, volumeMode, ds, imageSize, filesystemOverhead, preallocation)
+	processor := newDataProcessor(contentType, volumeMode, ds, imageSize, filesystemOverhead, preallocation, sparsify)
 	err := processor.ProcessData()
 
 	if err != nil {
 		klog.Errorf("%+v", err)
 		if err == importer.ErrRequiresScratchSpace {
-			if err := util.WriteTerminationMessage(common.ScratchSpaceRequired); err != nil {
+			message := common.ScratchSpaceRequired
+			if estimate := processor.ScratchSizeEstimate(); estimate > 0 {
+				message = fmt.Sprintf("%s; %s: %d", message, common.ScratchSizeEstimate, estimate)
+			}
+			if err := util.WriteTerminationMessage(message); err != nil {
+				klog.Errorf("%+v", err)
+			}
+			return common.ScratchSpaceNeededExitCode
+		}
+		if err == importer.ErrScratchSpaceTooSmall {
+			if err := util.WriteTerminationMessage(common.ScratchSpaceTooSmall); err != nil {
 				klog.Errorf("%+v", err)
 			}
 			return common.ScratchSpaceNeededExitCode
 		}
-		err = util.WriteTerminationMessage(fmt.Sprintf("Unable to process data: %v", err.Error()))
+		err = util.WriteTerminationError(err, fmt.Sprintf("Unable to process data: %v", err.Error()))
 		if err != nil {
 			klog.Errorf("%+v", err)
 		}
@@ -201,7 +209,8 @@ func handleImport(
 	// after finished (ds.close() ) termination message has to be written first, before the
 	// the ds is closed
 	// TODO: think about making communication explicit, probably DS interface should be extended
-	err = importCompleteTerminationMessage(processor.PreallocationApplied())
+	sparsifyResult, sparsified := processor.SparsifyResult()
+	err = importCompleteTerminationMessage(processor.PreallocationApplied(), sparsifyResult, sparsified)
 	if err != nil {
 		klog.Errorf("%+v", err)
 		return 1
@@ -210,11 +219,40 @@ func handleImport(
 	return 0
 }
 
-func importCompleteTerminationMessage(preallocationApplied bool) error {
+func handleDryRun(source string, contentType string) int {
+	klog.V(1).Infoln("begin dry run")
+
+	ds := newDataSource(source, contentType, v1.PersistentVolumeFilesystem)
+	defer ds.Close()
+
+	info, err := importer.Preflight(ds)
+	if err != nil {
+		klog.Errorf("%+v", err)
+		err = util.WriteTerminationError(err, fmt.Sprintf("Unable to inspect data source: %v", err.Error()))
+		if err != nil {
+			klog.Errorf("%+v", err)
+		}
+		return 1
+	}
+	if err := util.WritePreflightInfo(info); err != nil {
+		klog.Errorf("%+v", err)
+		return 1
+	}
+	klog.V(1).Infof("Dry run complete, format: %q, virtual size: %d", info.Format, info.VirtualSize)
+	return 0
+}
+
+func importCompleteTerminationMessage(preallocationApplied bool, sparsifyResult importer.SparsifyResult, sparsified bool) error {
 	message := "Import Complete"
 	if preallocationApplied {
 		message += ", " + common.PreallocationApplied
 	}
+	if sparsified {
+		message += fmt.Sprintf(", %s: %d", common.SparsifyUnmapped, sparsifyResult.Unmapped)
+		if sparsifyResult.ReclaimedKnown {
+			message += fmt.Sprintf(", %s: %d", common.SparsifyReclaimed, sparsifyResult.Reclaimed)
+		}
+	}
 	err := util.WriteTerminationMessage(message)
 	if err != nil {
 		return err
@@ -224,9 +262,9 @@ func importCompleteTerminationMessage(preallocationApplied bool) error {
 	return nil
 }
 
-func newDataProcessor(contentType string, volumeMode v1.PersistentVolumeMode, ds importer.DataSourceInterface, imageSize string, filesystemOverhead float64, preallocation bool) *importer.DataProcessor {
+func newDataProcessor(contentType string, volumeMode v1.PersistentVolumeMode, ds importer.DataSourceInterface, imageSize string, filesystemOverhead float64, preallocation, sparsify bool) *importer.DataProcessor {
 	dest := getImporterDestPath(contentType, volumeMode)
-	processor := importer.NewDataProcessor(ds, dest, common.ImporterDataDir, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation)
+	processor := importer.NewDataProcessor(ds, dest, common.ImporterDataDir, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation, sparsify)
 	return processor
 }
 
@@ -294,12 +332,8 @@ func newDataSource(source string, contentType string, volumeMode v1.PersistentVo
 		}
 		return ds
 	default:
-		klog.Errorf("Unknown source type %s\n", source)
-		err := util.WriteTerminationMessage(fmt.Sprintf("Unknown data source: %s", source))
-		if err != nil {
-			klog.Errorf("%+v", err)
-		}
-		os.Exit(1)
+		message := fmt.Sprintf("Unknown data source: %s", source)
+		exitWithTerminationError(errors.New(message), message)
 	}
 
 	return nil
@@ -325,29 +359,23 @@ func createBlankImage(imageSize string, availableDestSpace int64, preallocation
 	}
 
 	if err != nil {
-		klog.Errorf("%+v", err)
-		message := fmt.Sprintf("Unable to create blank image: %v", err)
-		err = util.WriteTerminationMessage(message)
-		if err != nil {
-			klog.Errorf("%+v", err)
-		}
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("Unable to create blank image: %v", err))
 	}
 }
 
 func errorCannotConnectDataSource(err error, dsName string) {
-	klog.Errorf("%+v", err)
-	err = util.WriteTerminationMessage(fmt.Sprintf("Unable to connect to %s data source: %v", dsName, err))
-	if err != nil {
-		klog.Errorf("%+v", err)
-	}
-	os.Exit(1)
+	exitWithTerminationError(err, fmt.Sprintf("Unable to connect to %s data source: %v", dsName, err))
 }
 
 func errorEmptyDiskWithContentTypeArchive() {
-	klog.Errorf("%+v", errors.New("Cannot create empty disk with content type archive"))
-	err := util.WriteTerminationMessage("Cannot create empty disk with content type archive")
-	if err != nil {
+	message := "Cannot create empty disk with content type archive"
+	exitWithTerminationError(errors.New(message), message)
+}
+
+// exitWithTerminationError writes the error as the structured termination message and exits
+func exitWithTerminationError(err error, message string) {
+	klog.Errorf("%+v", err)
+	if err := util.WriteTerminationError(err, message); err != nil {
 		klog.Errorf("%+v", err)
 	}
 	os.Exit(1)
@@ -357,12 +385,10 @@ func fsyncDataFile(contentType string, volumeMode v1.PersistentVolumeMode) {
 	dataFile := getImporterDestPath(contentType, volumeMode)
 	file, err := os.Open(dataFile)
 	if err != nil {
-		klog.Errorf("could not get file descriptor for fsync call: %+v", err)
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("could not get file descriptor for fsync call: %v", err))
 	}
 	if err := file.Sync(); err != nil {
-		klog.Errorf("could not fsync following qemu-img writing: %+v", err)
-		os.Exit(1)
+		exitWithTerminationError(err, fmt.Sprintf("could not fsync following qemu-img writing: %v", err))
 	}
 	klog.V(3).Infof("Successfully completed fsync(%s) syscall, commited to disk\n", dataFile)
 	file.Close()
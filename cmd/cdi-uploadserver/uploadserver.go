@@ -21,6 +21,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -76,6 +77,9 @@ func main() {
 	err := server.Run()
 	if err != nil {
 		klog.Errorf("UploadServer failed: %s", err)
+		if err := util.WriteTerminationError(err, fmt.Sprintf("UploadServer failed: %v", err)); err != nil {
+			klog.Errorf("%+v", err)
+		}
 		os.Exit(1)
 	}
 
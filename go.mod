@@ -39,6 +39,7 @@ require (
 	github.com/vmware/govmomi v0.23.1
 	go.uber.org/zap v1.24.0
 	golang.org/x/sys v0.13.0
+	golang.org/x/time v0.3.0
 	google.golang.org/api v0.132.0
 	gopkg.in/fsnotify.v1 v1.4.7
 	gopkg.in/square/go-jose.v2 v2.6.0
@@ -136,7 +137,6 @@ require (
 	golang.org/x/oauth2 v0.10.0 // indirect
 	golang.org/x/term v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
-	golang.org/x/time v0.3.0 // indirect
 	golang.org/x/tools v0.12.0 // indirect
 	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
 	gomodules.xyz/jsonpatch/v2 v2.3.0 // indirect
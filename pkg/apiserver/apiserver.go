@@ -72,6 +72,8 @@ const (
 
 	dataImportCronValidatePath = "/dataimportcron-validate"
 
+	dataSourceValidatePath = "/datasource-validate"
+
 	populatorValidatePath = "/populator-validate"
 
 	healthzPath = "/healthz"
@@ -200,6 +202,11 @@ func NewCdiAPIServer(bindAddress string,
 		return nil, errors.Errorf("failed to create DataImportCron validating webhook: %s", err)
 	}
 
+	err = app.createDataSourceValidatingWebhook()
+	if err != nil {
+		return nil, errors.Errorf("failed to create DataSource validating webhook: %s", err)
+	}
+
 	err = app.createPopulatorValidatingWebhook()
 	if err != nil {
 		return nil, errors.Errorf("failed to create Populator validating webhook: %s", err)
@@ -536,6 +543,10 @@ func (app *cdiAPIApp) createDataImportCronValidatingWebhook() error {
 	app.container.ServeMux.Handle(dataImportCronValidatePath, webhooks.NewDataImportCronValidatingWebhook(app.client, app.cdiClient))
 	return nil
 }
+func (app *cdiAPIApp) createDataSourceValidatingWebhook() error {
+	app.container.ServeMux.Handle(dataSourceValidatePath, webhooks.NewDataSourceValidatingWebhook(app.client, app.cdiClient))
+	return nil
+}
 func (app *cdiAPIApp) createPopulatorValidatingWebhook() error {
 	app.container.ServeMux.Handle(populatorValidatePath, webhooks.NewPopulatorValidatingWebhook(app.client, app.cdiClient))
 	return nil
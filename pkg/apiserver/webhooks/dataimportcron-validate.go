@@ -22,6 +22,9 @@ package webhooks
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gorhill/cronexpr"
 
@@ -33,6 +36,7 @@ import (
 	"k8s.io/klog/v2"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 )
 
 type dataImportCronValidatingWebhook struct {
@@ -90,15 +94,56 @@ func (wh *dataImportCronValidatingWebhook) Admit(ar admissionv1.AdmissionReview)
 func (wh *dataImportCronValidatingWebhook) validateDataImportCronSpec(request *admissionv1.AdmissionRequest, field *k8sfield.Path, spec *cdiv1.DataImportCronSpec, namespace *string) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
-	if spec.Template.Spec.Source == nil || spec.Template.Spec.Source.Registry == nil {
+	source := spec.Template.Spec.Source
+	if source == nil || (source.Registry == nil && source.HTTP == nil && source.S3 == nil) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Missing registry source",
+			Message: "Missing registry, http or s3 source",
 			Field:   field.Child("Template").String(),
 		})
 		return causes
 	}
 
+	if spec.ChecksumURL != nil {
+		if source.HTTP == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "ChecksumURL requires an http source",
+				Field:   field.Child("ChecksumURL").String(),
+			})
+			return causes
+		}
+		if _, err := url.ParseRequestURI(*spec.ChecksumURL); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Illegal ChecksumURL %s", *spec.ChecksumURL),
+				Field:   field.Child("ChecksumURL").String(),
+			})
+			return causes
+		}
+	}
+
+	if spec.Validation != nil && spec.Validation.Image == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Validation requires an image",
+			Field:   field.Child("Validation", "Image").String(),
+		})
+		return causes
+	}
+	if spec.Validation != nil {
+		for _, env := range spec.Validation.Env {
+			if env.ValueFrom != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("Validation env %s may not use valueFrom", env.Name),
+					Field:   field.Child("Validation", "Env").String(),
+				})
+				return causes
+			}
+		}
+	}
+
 	if spec.Template.Spec.SourceRef != nil ||
 		spec.Template.Spec.ContentType != "" ||
 		len(spec.Template.Spec.Checkpoints) > 0 ||
@@ -127,6 +172,22 @@ func (wh *dataImportCronValidatingWebhook) validateDataImportCronSpec(request *a
 		}
 	}
 
+	if spec.ScheduleJitter != nil && spec.ScheduleJitter.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Illegal ScheduleJitter value",
+			Field:   field.Child("ScheduleJitter").String(),
+		})
+		return causes
+	}
+
+	if spec.ImportWindow != nil {
+		if cause := validateImportWindow(field.Child("ImportWindow"), spec.ImportWindow); cause != nil {
+			causes = append(causes, *cause)
+			return causes
+		}
+	}
+
 	if spec.ImportsToKeep != nil && *spec.ImportsToKeep < 0 {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
@@ -136,6 +197,24 @@ func (wh *dataImportCronValidatingWebhook) validateDataImportCronSpec(request *a
 		return causes
 	}
 
+	if spec.ImportsMaxAge != nil && spec.ImportsMaxAge.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Illegal ImportsMaxAge value",
+			Field:   field.Child("ImportsMaxAge").String(),
+		})
+		return causes
+	}
+
+	if spec.ImportsMaxTotalSize != nil && spec.ImportsMaxTotalSize.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Illegal ImportsMaxTotalSize value",
+			Field:   field.Child("ImportsMaxTotalSize").String(),
+		})
+		return causes
+	}
+
 	if spec.GarbageCollect != nil &&
 		*spec.GarbageCollect != cdiv1.DataImportCronGarbageCollectNever &&
 		*spec.GarbageCollect != cdiv1.DataImportCronGarbageCollectOutdated {
@@ -161,5 +240,58 @@ func (wh *dataImportCronValidatingWebhook) validateDataImportCronSpec(request *a
 		return causes
 	}
 
+	storageClasses := map[string]bool{}
+	dataSources := map[string]bool{spec.ManagedDataSource: true}
+	for i, target := range spec.StorageClassTargets {
+		targetField := field.Child("StorageClassTargets").Index(i)
+		if target.StorageClassName == "" || storageClasses[target.StorageClassName] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Missing or duplicate StorageClassName %q", target.StorageClassName),
+				Field:   targetField.Child("StorageClassName").String(),
+			})
+			return causes
+		}
+		storageClasses[target.StorageClassName] = true
+		if target.ManagedDataSource == "" {
+			continue
+		}
+		if dataSources[target.ManagedDataSource] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DataSource %s is already managed by the DataImportCron", target.ManagedDataSource),
+				Field:   targetField.Child("ManagedDataSource").String(),
+			})
+			return causes
+		}
+		dataSources[target.ManagedDataSource] = true
+		if cause := validateNameLength(target.ManagedDataSource, validation.DNS1035LabelMaxLength); cause != nil {
+			causes = append(causes, *cause)
+			return causes
+		}
+	}
+
 	return causes
 }
+
+func validateImportWindow(field *k8sfield.Path, window *cdiv1.DataImportCronImportWindow) *metav1.StatusCause {
+	for _, t := range []struct{ name, value string }{{"Start", window.Start}, {"End", window.End}} {
+		if _, err := time.Parse("15:04", t.value); err != nil {
+			return &metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Illegal import window %s time %q, should be HH:MM", strings.ToLower(t.name), t.value),
+				Field:   field.Child(t.name).String(),
+			}
+		}
+	}
+	for _, day := range window.Days {
+		if _, err := cc.ParseWeekday(day); err != nil {
+			return &metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Illegal import window day %q", day),
+				Field:   field.Child("Days").String(),
+			}
+		}
+	}
+	return nil
+}
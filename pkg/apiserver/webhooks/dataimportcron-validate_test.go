@@ -22,6 +22,7 @@ package webhooks
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	cdiclientfake "kubevirt.io/containerized-data-importer/pkg/client/clientset/versioned/fake"
@@ -79,6 +81,77 @@ var _ = Describe("Validating Webhook", func() {
 			resp := validateDataImportCronCreate(cron)
 			Expect(resp.Allowed).To(BeFalse())
 		})
+		It("should accept DataImportCron with http source on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{})
+			cron.Spec.Template.Spec.Source = &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "https://example.com/disk.qcow2"}}
+			cron.Spec.ChecksumURL = pointer.String("https://example.com/SHA256SUMS")
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		It("should accept DataImportCron with s3 source on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{})
+			cron.Spec.Template.Spec.Source = &cdiv1.DataVolumeSource{S3: &cdiv1.DataVolumeSourceS3{URL: "https://s3.example.com/bucket/disk.qcow2"}}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		It("should reject DataImportCron with ChecksumURL and no http source on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ChecksumURL = pointer.String("https://example.com/SHA256SUMS")
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should reject DataImportCron with illegal ChecksumURL on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{})
+			cron.Spec.Template.Spec.Source = &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "https://example.com/disk.qcow2"}}
+			cron.Spec.ChecksumURL = pointer.String("SHA256SUMS")
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should accept DataImportCron with validation on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.Validation = &cdiv1.DataImportCronValidation{Image: "quay.io/example/virt-inspector"}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		It("should reject DataImportCron with validation and no image on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.Validation = &cdiv1.DataImportCronValidation{Command: []string{"virt-inspector"}}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should reject DataImportCron with validation env from a secret on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.Validation = &cdiv1.DataImportCronValidation{
+				Image: "quay.io/example/virt-inspector",
+				Env: []corev1.EnvVar{{
+					Name: "TOKEN",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "token"},
+					},
+				}},
+			}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should accept DataImportCron with storage class targets on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.StorageClassTargets = []cdiv1.DataImportCronStorageClassTarget{
+				{StorageClassName: "ceph"},
+				{StorageClassName: "local", ManagedDataSource: "fedora-local"},
+			}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		DescribeTable("should reject DataImportCron with illegal storage class targets on create", func(targets []cdiv1.DataImportCronStorageClassTarget) {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.StorageClassTargets = targets
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		},
+			Entry("missing storage class", []cdiv1.DataImportCronStorageClassTarget{{ManagedDataSource: "fedora-local"}}),
+			Entry("duplicate storage class", []cdiv1.DataImportCronStorageClassTarget{{StorageClassName: "local"}, {StorageClassName: "local"}}),
+			Entry("duplicate DataSource", []cdiv1.DataImportCronStorageClassTarget{{StorageClassName: "local", ManagedDataSource: "someDataSource"}}),
+		)
 		It("should reject DataImportCron with no source on create", func() {
 			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{})
 			cron.Spec.Template.Spec.Source = nil
@@ -140,6 +213,50 @@ var _ = Describe("Validating Webhook", func() {
 			resp := validateDataImportCronCreate(cron)
 			Expect(resp.Allowed).To(BeFalse())
 		})
+		It("should accept DataImportCron with schedule jitter and import window on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ScheduleJitter = &metav1.Duration{Duration: time.Hour}
+			cron.Spec.ImportWindow = &cdiv1.DataImportCronImportWindow{Start: "22:00", End: "05:00", Days: []string{"Monday", "fri"}}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		DescribeTable("should reject DataImportCron with illegal import window on create", func(window cdiv1.DataImportCronImportWindow) {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ImportWindow = &window
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		},
+			Entry("missing start", cdiv1.DataImportCronImportWindow{End: "05:00"}),
+			Entry("illegal end", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "25:00"}),
+			Entry("illegal day", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "05:00", Days: []string{"Someday"}}),
+		)
+		It("should reject DataImportCron with negative ScheduleJitter on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ScheduleJitter = &metav1.Duration{Duration: -time.Minute}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should reject DataImportCron with illegal ImportsMaxAge on create", func() {
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ImportsMaxAge = &metav1.Duration{Duration: -time.Hour}
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should reject DataImportCron with illegal ImportsMaxTotalSize on create", func() {
+			maxTotalSize := resource.MustParse("-1Gi")
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ImportsMaxTotalSize = &maxTotalSize
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+		It("should allow DataImportCron with age and size retention on create", func() {
+			maxTotalSize := resource.MustParse("100Gi")
+			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
+			cron.Spec.ImportsMaxAge = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+			cron.Spec.ImportsMaxTotalSize = &maxTotalSize
+			resp := validateDataImportCronCreate(cron)
+			Expect(resp.Allowed).To(BeTrue())
+		})
 		It("should reject DataImportCron with illegal GarbageCollect on create", func() {
 			garbageCollect := cdiv1.DataImportCronGarbageCollect("nosuch")
 			cron := newDataImportCron(cdiv1.DataVolumeSourceRegistry{URL: &testRegistryURL})
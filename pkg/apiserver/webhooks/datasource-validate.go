@@ -0,0 +1,146 @@
+/*
+ * This file is part of the CDI project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cdiclient "kubevirt.io/containerized-data-importer/pkg/client/clientset/versioned"
+)
+
+type dataSourceValidatingWebhook struct {
+	k8sClient kubernetes.Interface
+	cdiClient cdiclient.Interface
+}
+
+func (wh *dataSourceValidatingWebhook) Admit(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != cdiv1.CDIGroupVersionKind.Group || ar.Request.Resource.Resource != "datasources" {
+		klog.V(3).Infof("Got unexpected resource type %s", ar.Request.Resource.Resource)
+		return toAdmissionResponseError(fmt.Errorf("unexpected resource: %s", ar.Request.Resource.Resource))
+	}
+
+	dataSource := cdiv1.DataSource{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, &dataSource); err != nil {
+		return toAdmissionResponseError(err)
+	}
+	if cause := validateDataSourceCache(&dataSource.Spec, k8sfield.NewPath("spec")); cause != nil {
+		return toRejectedAdmissionResponse([]metav1.StatusCause{*cause})
+	}
+	if cause := validateDataSourceRemoteSource(&dataSource.Spec.Source, k8sfield.NewPath("spec", "source")); cause != nil {
+		return toRejectedAdmissionResponse([]metav1.StatusCause{*cause})
+	}
+	if dataSource.Spec.Grant == nil {
+		return allowedAdmissionResponse()
+	}
+	if dataSource.Namespace == "" {
+		dataSource.Namespace = ar.Request.Namespace
+	}
+
+	// The grant shares whatever the DataSource refers to, so any spec change of a granted DataSource is authorized
+	if ar.Request.Operation == admissionv1.Update {
+		oldDataSource := cdiv1.DataSource{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, &oldDataSource); err != nil {
+			return toAdmissionResponseError(err)
+		}
+		if apiequality.Semantic.DeepEqual(dataSource.Spec, oldDataSource.Spec) {
+			return allowedAdmissionResponse()
+		}
+	}
+
+	proxy := &authProxy{k8sClient: wh.k8sClient, cdiClient: wh.cdiClient}
+	allowed, reason, err := dataSource.AuthorizeGrant(proxy, ar.Request.UserInfo)
+	if err != nil {
+		return toAdmissionResponseError(err)
+	}
+	if !allowed {
+		klog.Infof("rejected DataSource %s/%s grant: %s", dataSource.Namespace, dataSource.Name, reason)
+		causes := []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Not authorized to grant DataSource %s/%s: %s", dataSource.Namespace, dataSource.Name, reason),
+				Field:   k8sfield.NewPath("spec", "grant").String(),
+			},
+		}
+		return toRejectedAdmissionResponse(causes)
+	}
+
+	return allowedAdmissionResponse()
+}
+
+// validateDataSourceCache validates a remote source has the storage of the cache PVC it is imported to
+func validateDataSourceCache(spec *cdiv1.DataSourceSpec, field *k8sfield.Path) *metav1.StatusCause {
+	if !spec.Source.IsRemote() {
+		return nil
+	}
+	if spec.Cache == nil {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "Cache is required for a remote source",
+			Field:   field.Child("cache").String(),
+		}
+	}
+	if spec.Cache.Size.Sign() <= 0 {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Cache size must be positive",
+			Field:   field.Child("cache", "size").String(),
+		}
+	}
+	return nil
+}
+
+// validateDataSourceRemoteSource validates a remote source doesn't refer to objects of the DataSource namespace,
+// since it is imported in the CDI namespace
+func validateDataSourceRemoteSource(source *cdiv1.DataSourceSource, field *k8sfield.Path) *metav1.StatusCause {
+	if !source.IsRemote() {
+		return nil
+	}
+	var refField *k8sfield.Path
+	switch http, registry := source.HTTP, source.Registry; {
+	case http != nil && http.SecretRef != "":
+		refField = field.Child("http", "secretRef")
+	case http != nil && http.CertConfigMap != "":
+		refField = field.Child("http", "certConfigMap")
+	case http != nil && len(http.SecretExtraHeaders) > 0:
+		refField = field.Child("http", "secretExtraHeaders")
+	case registry != nil && registry.SecretRef != nil:
+		refField = field.Child("registry", "secretRef")
+	case registry != nil && registry.CertConfigMap != nil:
+		refField = field.Child("registry", "certConfigMap")
+	case registry != nil && registry.ImageStream != nil:
+		refField = field.Child("registry", "imageStream")
+	default:
+		return nil
+	}
+	return &metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: "A remote source is imported in the CDI namespace, so it cannot refer to objects of the DataSource namespace",
+		Field:   refField.String(),
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the CDI project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package webhooks
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authentication "k8s.io/api/authentication/v1"
+	authorization "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cdiclientfake "kubevirt.io/containerized-data-importer/pkg/client/clientset/versioned/fake"
+)
+
+var _ = Describe("DataSource validating webhook", func() {
+	grant := &cdiv1.DataSourceGrant{Namespaces: []string{"tenant"}}
+
+	It("should accept a DataSource without a grant without checking permissions", func() {
+		ds := newGrantDataSource(nil)
+		resp, sars := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Create, ds, nil), false)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(*sars).To(BeEmpty())
+	})
+
+	It("should accept a DataSource grant set by a user allowed to clone in its namespace", func() {
+		ds := newGrantDataSource(grant)
+		resp, sars := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Create, ds, nil), true)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(*sars).ToNot(BeEmpty())
+		for _, sar := range *sars {
+			Expect(sar.Spec.User).To(Equal("grantor"))
+			Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("golden"))
+			Expect(sar.Spec.ResourceAttributes.Name).To(BeEmpty())
+		}
+	})
+
+	It("should reject a DataSource grant set by a user not allowed to clone in its namespace", func() {
+		ds := newGrantDataSource(grant)
+		resp, _ := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Create, ds, nil), false)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.grant"))
+	})
+
+	It("should reject a source change of a granted DataSource by a user not allowed to clone in its namespace", func() {
+		oldDs := newGrantDataSource(grant)
+		ds := newGrantDataSource(grant)
+		ds.Spec.Source.PVC.Name = "secret"
+		resp, _ := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Update, ds, oldDs), false)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("should accept a metadata update of a granted DataSource without checking permissions", func() {
+		oldDs := newGrantDataSource(grant)
+		ds := newGrantDataSource(grant)
+		ds.Labels = map[string]string{"foo": "bar"}
+		resp, sars := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Update, ds, oldDs), false)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(*sars).To(BeEmpty())
+	})
+
+	DescribeTable("should validate the cache of a remote DataSource", func(cache *cdiv1.DataSourceCache, expectAllowed bool) {
+		ds := newGrantDataSource(nil)
+		ds.Spec.Source = cdiv1.DataSourceSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img"}}
+		ds.Spec.Cache = cache
+		resp, _ := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Create, ds, nil), false)
+		Expect(resp.Allowed).To(Equal(expectAllowed))
+	},
+		Entry("accept with a cache size", &cdiv1.DataSourceCache{Size: resource.MustParse("1Gi")}, true),
+		Entry("reject without a cache", nil, false),
+		Entry("reject with a zero cache size", &cdiv1.DataSourceCache{}, false),
+	)
+
+	DescribeTable("should validate a remote source doesn't refer to objects of the DataSource namespace", func(source cdiv1.DataSourceSource, expectAllowed bool) {
+		ds := newGrantDataSource(nil)
+		ds.Spec.Source = source
+		ds.Spec.Cache = &cdiv1.DataSourceCache{Size: resource.MustParse("1Gi")}
+		resp, _ := validateDataSourceAdmission(newDataSourceAdmissionReview(admissionv1.Create, ds, nil), false)
+		Expect(resp.Allowed).To(Equal(expectAllowed))
+	},
+		Entry("accept an http source with extra headers",
+			cdiv1.DataSourceSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img", ExtraHeaders: []string{"X-Foo: bar"}}}, true),
+		Entry("reject an http source with a secret",
+			cdiv1.DataSourceSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img", SecretRef: "creds"}}, false),
+		Entry("reject an http source with a cert ConfigMap",
+			cdiv1.DataSourceSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "https://example.com/disk.img", CertConfigMap: "certs"}}, false),
+		Entry("accept a registry source", cdiv1.DataSourceSource{Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String("docker://example.com/disk")}}, true),
+		Entry("reject a registry source with a secret",
+			cdiv1.DataSourceSource{Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String("docker://example.com/disk"), SecretRef: pointer.String("creds")}}, false),
+		Entry("reject an image stream", cdiv1.DataSourceSource{Registry: &cdiv1.DataVolumeSourceRegistry{ImageStream: pointer.String("disk")}}, false),
+	)
+})
+
+func newGrantDataSource(grant *cdiv1.DataSourceGrant) *cdiv1.DataSource {
+	return &cdiv1.DataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "ds", Namespace: "golden"},
+		Spec: cdiv1.DataSourceSpec{
+			Source: cdiv1.DataSourceSource{PVC: &cdiv1.DataVolumeSourcePVC{Namespace: "golden", Name: "image"}},
+			Grant:  grant,
+		},
+	}
+}
+
+func newDataSourceAdmissionReview(op admissionv1.Operation, ds, oldDs *cdiv1.DataSource) *admissionv1.AdmissionReview {
+	dsBytes, _ := json.Marshal(ds)
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: op,
+			Namespace: ds.Namespace,
+			Resource: metav1.GroupVersionResource{
+				Group:    cdiv1.SchemeGroupVersion.Group,
+				Version:  cdiv1.SchemeGroupVersion.Version,
+				Resource: "datasources",
+			},
+			UserInfo: authentication.UserInfo{Username: "grantor"},
+			Object: runtime.RawExtension{
+				Raw: dsBytes,
+			},
+		},
+	}
+	if oldDs != nil {
+		oldBytes, _ := json.Marshal(oldDs)
+		ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+	}
+	return ar
+}
+
+func validateDataSourceAdmission(ar *admissionv1.AdmissionReview, isAuthorized bool) (*admissionv1.AdmissionResponse, *[]*authorization.SubjectAccessReview) {
+	var sars []*authorization.SubjectAccessReview
+	client := fakeclient.NewSimpleClientset()
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorization.SubjectAccessReview)
+		sars = append(sars, sar)
+		return true, &authorization.SubjectAccessReview{Status: authorization.SubjectAccessReviewStatus{Allowed: isAuthorized}}, nil
+	})
+	wh := NewDataSourceValidatingWebhook(client, cdiclientfake.NewSimpleClientset())
+	return serve(ar, wh), &sars
+}
@@ -195,6 +195,105 @@ var _ = Describe("Mutating DataVolume Webhook", func() {
 			Expect(patchObjs[0].Path).Should(Equal("/metadata/annotations"))
 		})
 
+		DescribeTable("should authorize a DataVolume with sourceRef to a DataSource in another namespace", func(grant *cdicorev1.DataSourceGrant, sourceNamespace string, isAuthorized, expectAllowed bool) {
+			dataVolume := newDataSourceDataVolume("testDV", pointer.String("testNamespace"), "test")
+			dvBytes, _ := json.Marshal(&dataVolume)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Resource: metav1.GroupVersionResource{
+						Group:    cdicorev1.SchemeGroupVersion.Group,
+						Version:  cdicorev1.SchemeGroupVersion.Version,
+						Resource: "datavolumes",
+					},
+					Object: runtime.RawExtension{
+						Raw: dvBytes,
+					},
+				},
+			}
+
+			dataSource := &cdicorev1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dataVolume.Spec.SourceRef.Name,
+					Namespace: "testNamespace",
+				},
+				Spec: cdicorev1.DataSourceSpec{
+					Source: cdicorev1.DataSourceSource{
+						PVC: &cdicorev1.DataVolumeSourcePVC{
+							Name:      "testPVC",
+							Namespace: sourceNamespace,
+						},
+					},
+					Grant: grant,
+				},
+			}
+
+			resp := mutateDVsEx(key, ar, isAuthorized, 0, []runtime.Object{dataSource})
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+			if expectAllowed {
+				Expect(resp.Patch).ToNot(BeNil())
+			}
+		},
+			Entry("granted namespace without source permissions",
+				&cdicorev1.DataSourceGrant{Namespaces: []string{"default"}}, "testNamespace", false, true),
+			Entry("namespace granted by selector without source permissions",
+				&cdicorev1.DataSourceGrant{NamespaceSelector: &metav1.LabelSelector{}}, "testNamespace", false, true),
+			Entry("namespace not granted, with source permissions",
+				&cdicorev1.DataSourceGrant{Namespaces: []string{"other"}}, "testNamespace", true, true),
+			Entry("namespace not granted, without source permissions",
+				&cdicorev1.DataSourceGrant{Namespaces: []string{"other"}}, "testNamespace", false, false),
+			Entry("granted namespace, but source in a third namespace without permissions",
+				&cdicorev1.DataSourceGrant{Namespaces: []string{"default"}}, "other", false, false),
+			Entry("no grant, with source permissions", nil, "testNamespace", true, true),
+			Entry("no grant, without source permissions", nil, "testNamespace", false, false),
+		)
+
+		DescribeTable("should authorize a DataVolume with sourceRef to a remote DataSource by its cache PVC", func(isAuthorized bool) {
+			dataVolume := newDataSourceDataVolume("testDV", pointer.String("testNamespace"), "test")
+			dvBytes, _ := json.Marshal(&dataVolume)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Resource: metav1.GroupVersionResource{
+						Group:    cdicorev1.SchemeGroupVersion.Group,
+						Version:  cdicorev1.SchemeGroupVersion.Version,
+						Resource: "datavolumes",
+					},
+					Object: runtime.RawExtension{
+						Raw: dvBytes,
+					},
+				},
+			}
+
+			dataSource := &cdicorev1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dataVolume.Spec.SourceRef.Name,
+					Namespace: "testNamespace",
+				},
+				Spec: cdicorev1.DataSourceSpec{
+					Source: cdicorev1.DataSourceSource{
+						HTTP: &cdicorev1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img"},
+					},
+				},
+				Status: cdicorev1.DataSourceStatus{
+					Source: cdicorev1.DataSourceSource{
+						PVC: &cdicorev1.DataVolumeSourcePVC{Namespace: "default", Name: cdicorev1.DataSourceCacheNamePrefix + "-0123456789abcdef"},
+					},
+				},
+			}
+
+			resp := mutateDVsEx(key, ar, isAuthorized, 0, []runtime.Object{dataSource})
+			Expect(resp.Allowed).To(Equal(isAuthorized))
+			if isAuthorized {
+				Expect(resp.Patch).ToNot(BeNil())
+			}
+		},
+			Entry("with DataSource namespace permissions", true),
+			Entry("without DataSource namespace permissions", false),
+		)
+
 		It("should allow a DataVolume update with token unchanged", func() {
 			dataVolume := newPVCDataVolume("testDV", "testNamespace", "test")
 			Expect(dataVolume.Annotations).To(BeNil())
@@ -57,6 +57,17 @@ func validateNameLength(name string, maxLen int) *metav1.StatusCause {
 	return nil
 }
 
+func validateCloneFanOutGroupSize(dv *cdiv1.DataVolume) *metav1.StatusCause {
+	if _, err := cc.ParseCloneFanOutGroupSize(dv.Annotations); err != nil {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   k8sfield.NewPath("metadata", "annotations").Key(cc.AnnCloneFanOutGroupSize).String(),
+		}
+	}
+	return nil
+}
+
 func (wh *dataVolumeValidatingWebhook) validateDataVolumeSpec(request *admissionv1.AdmissionRequest, field *k8sfield.Path, spec *cdiv1.DataVolumeSpec, namespace *string) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	var dataSourceRef *v1.TypedObjectReference
@@ -88,6 +99,11 @@ func (wh *dataVolumeValidatingWebhook) validateDataVolumeSpec(request *admission
 		causes = append(causes, *cause)
 		return causes
 	}
+	if spec.RetryPolicy != nil {
+		if retryCauses := validateRetryPolicy(spec.RetryPolicy, field.Child("retryPolicy")); len(retryCauses) > 0 {
+			return append(causes, retryCauses...)
+		}
+	}
 
 	if spec.PVC != nil {
 		dataSourceRef = spec.PVC.DataSourceRef
@@ -283,11 +299,12 @@ func (wh *dataVolumeValidatingWebhook) validateSourceRef(request *admissionv1.Ad
 			Field:   field.Child("sourceRef").String(),
 		}
 	}
+	source := dataSource.GetCloneSource()
 	switch {
-	case dataSource.Spec.Source.PVC != nil:
-		return wh.validateDataVolumeSourcePVC(dataSource.Spec.Source.PVC, field.Child("sourceRef"), spec)
-	case dataSource.Spec.Source.Snapshot != nil:
-		return wh.validateDataVolumeSourceSnapshot(dataSource.Spec.Source.Snapshot, field.Child("sourceRef"), spec)
+	case source.PVC != nil:
+		return wh.validateDataVolumeSourcePVC(source.PVC, field.Child("sourceRef"), spec)
+	case source.Snapshot != nil:
+		return wh.validateDataVolumeSourceSnapshot(source.Snapshot, field.Child("sourceRef"), spec)
 	}
 
 	return &metav1.StatusCause{
@@ -297,6 +314,12 @@ func (wh *dataVolumeValidatingWebhook) validateSourceRef(request *admissionv1.Ad
 }
 
 func (wh *dataVolumeValidatingWebhook) validateDataVolumeSourcePVC(PVC *cdiv1.DataVolumeSourcePVC, field *k8sfield.Path, spec *cdiv1.DataVolumeSpec) *metav1.StatusCause {
+	if PVC.PathFilter != nil {
+		if cause := validateClonePathFilter(PVC.PathFilter, spec, field.Child("pathFilter")); cause != nil {
+			return cause
+		}
+	}
+
 	sourcePVC, err := wh.k8sClient.CoreV1().PersistentVolumeClaims(PVC.Namespace).Get(context.TODO(), PVC.Name, metav1.GetOptions{})
 	if err != nil {
 		// We allow the creation of a clone DV even if the source PVC doesn't exist.
@@ -318,9 +341,65 @@ func (wh *dataVolumeValidatingWebhook) validateDataVolumeSourcePVC(PVC *cdiv1.Da
 		}
 	}
 
+	if PVC.PathFilter != nil && cc.GetVolumeMode(sourcePVC) != v1.PersistentVolumeFilesystem {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is only supported with a filesystem source", field.Child("pathFilter").String()),
+			Field:   field.Child("pathFilter").String(),
+		}
+	}
+
 	return nil
 }
 
+// validateRetryPolicy validates the retry policy of a DataVolume import
+func validateRetryPolicy(policy *cdiv1.RetryPolicy, field *k8sfield.Path) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if policy.MaxAttempts != nil && *policy.MaxAttempts < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least 1", field.Child("maxAttempts").String()),
+			Field:   field.Child("maxAttempts").String(),
+		})
+	}
+	if policy.BackoffBase != nil && policy.BackoffBase.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be positive", field.Child("backoffBase").String()),
+			Field:   field.Child("backoffBase").String(),
+		})
+	}
+	if policy.BackoffCap != nil && policy.BackoffCap.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be positive", field.Child("backoffCap").String()),
+			Field:   field.Child("backoffCap").String(),
+		})
+	}
+	if policy.BackoffBase != nil && policy.BackoffCap != nil && policy.BackoffCap.Duration < policy.BackoffBase.Duration {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be less than %s", field.Child("backoffCap").String(), field.Child("backoffBase").String()),
+			Field:   field.Child("backoffCap").String(),
+		})
+	}
+	for i, class := range policy.RetryOn {
+		switch class {
+		case cdiv1.ImportErrorNetwork, cdiv1.ImportErrorAuth, cdiv1.ImportErrorNotFound,
+			cdiv1.ImportErrorInvalidImage, cdiv1.ImportErrorOutOfSpace, cdiv1.ImportErrorUnknown:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has an unknown error class %s", field.Child("retryOn").String(), class),
+				Field:   field.Child("retryOn").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 // validateDataSource validates a DataSource in a DataVolume spec
 func validateDataSource(dataSource *v1.TypedLocalObjectReference, field *k8sfield.Path) []metav1.StatusCause {
 	var causes []metav1.StatusCause
@@ -448,7 +527,8 @@ func validateStorageSize(spec *cdiv1.DataVolumeSpec, field *k8sfield.Path) (*met
 
 	// The storage size of a DataVolume can only be empty when two conditios are met:
 	//	1. The 'Storage' spec API is used, which allows for additional logic in CDI.
-	//	2. The 'PVC'/'Snapshot' source or SourceRef is used, so the original size can be extracted from the source.
+	//	2. The 'PVC'/'Snapshot' source or SourceRef is used, so the original size can be extracted from the source,
+	//	   or the 'HTTP'/'S3'/'GCS' source is used, so the virtual size can be detected from the image header.
 	isClone := spec.SourceRef != nil || (spec.Source != nil && spec.Source.PVC != nil) || (spec.Source != nil && spec.Source.Snapshot != nil)
 	if pvcSize, ok := resources.Requests["storage"]; ok {
 		if pvcSize.IsZero() || pvcSize.Value() < 0 {
@@ -459,10 +539,14 @@ func validateStorageSize(spec *cdiv1.DataVolumeSpec, field *k8sfield.Path) (*met
 			}
 			return &cause, false
 		}
-	} else if spec.Storage == nil || !isClone {
+	} else if spec.Storage == nil || (!isClone && !cc.IsSizeDetectable(spec)) {
+		message := fmt.Sprintf("%s size is missing", name)
+		if spec.Storage != nil {
+			message += ", it can only be omitted for http, s3, gcs, pvc and snapshot sources or a sourceRef, the size of other sources can't be detected"
+		}
 		cause := metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s size is missing", name),
+			Message: message,
 			Field:   field.Child(name, "resources", "requests", "size").String(),
 		}
 		return &cause, false
@@ -561,6 +645,13 @@ func (wh *dataVolumeValidatingWebhook) Admit(ar admissionv1.AdmissionReview) *ad
 	}
 
 	if ar.Request.Operation == admissionv1.Create {
+		// the annotations are copied to the PVC when it is created
+		if cause := validateCloneFanOutGroupSize(&dv); cause != nil {
+			klog.Infof("rejected DataVolume admission")
+			causes = append(causes, *cause)
+			return toRejectedAdmissionResponse(causes)
+		}
+
 		pvc, err := wh.k8sClient.CoreV1().PersistentVolumeClaims(dv.GetNamespace()).Get(context.TODO(), dv.GetName(), metav1.GetOptions{})
 		if err != nil {
 			if !k8serrors.IsNotFound(err) {
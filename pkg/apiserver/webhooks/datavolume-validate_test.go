@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -42,6 +43,7 @@ import (
 
 	snapclientfake "github.com/kubernetes-csi/external-snapshotter/client/v6/clientset/versioned/fake"
 	cdiclientfake "kubevirt.io/containerized-data-importer/pkg/client/clientset/versioned/fake"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
@@ -217,12 +219,68 @@ var _ = Describe("Validating Webhook", func() {
 			Expect(resp.Allowed).To(BeFalse())
 		})
 
+		DescribeTable("should validate clone path filter", func(modifyDV func(*cdiv1.DataVolume), sourceVolumeMode corev1.PersistentVolumeMode, expected bool) {
+			dataVolume := newPVCDataVolume("testDV", "testNamespace", "test")
+			dataVolume.Spec.ContentType = cdiv1.DataVolumeArchive
+			dataVolume.Spec.Source.PVC.PathFilter = &cdiv1.DataVolumeSourcePathFilter{
+				Include: []string{"datasets/train"},
+				Exclude: []string{"*.tmp"},
+			}
+			if modifyDV != nil {
+				modifyDV(dataVolume)
+			}
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dataVolume.Spec.Source.PVC.Name,
+					Namespace: dataVolume.Spec.Source.PVC.Namespace,
+					Annotations: map[string]string{
+						"cdi.kubevirt.io/storage.contentType": string(cdiv1.DataVolumeArchive),
+					},
+				},
+				Spec: *dataVolume.Spec.PVC.DeepCopy(),
+			}
+			pvc.Spec.VolumeMode = &sourceVolumeMode
+			resp := validateDataVolumeCreate(dataVolume, pvc)
+			Expect(resp.Allowed).To(Equal(expected))
+		},
+			Entry("accept filesystem to filesystem archive clone", nil, corev1.PersistentVolumeFilesystem, true),
+			Entry("reject kubevirt content type", func(dv *cdiv1.DataVolume) {
+				dv.Spec.ContentType = cdiv1.DataVolumeKubeVirt
+			}, corev1.PersistentVolumeFilesystem, false),
+			Entry("reject block target", func(dv *cdiv1.DataVolume) {
+				volumeMode := corev1.PersistentVolumeBlock
+				dv.Spec.PVC.VolumeMode = &volumeMode
+			}, corev1.PersistentVolumeFilesystem, false),
+			Entry("reject block source", nil, corev1.PersistentVolumeBlock, false),
+			Entry("reject absolute include path", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source.PVC.PathFilter.Include = []string{"/etc"}
+			}, corev1.PersistentVolumeFilesystem, false),
+			Entry("reject include path outside of the volume", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source.PVC.PathFilter.Include = []string{"datasets/../../etc"}
+			}, corev1.PersistentVolumeFilesystem, false),
+			Entry("reject empty exclude path", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source.PVC.PathFilter.Exclude = []string{""}
+			}, corev1.PersistentVolumeFilesystem, false),
+		)
+
 		It("should reject DataVolume with name length greater than 253 characters", func() {
 			dataVolume := newHTTPDataVolume(longName, "http://www.example.com")
 			resp := validateDataVolumeCreate(dataVolume)
 			Expect(resp.Allowed).To(BeFalse())
 		})
 
+		DescribeTable("should validate the clone fan-out group size", func(groupSize string, expected bool) {
+			dataVolume := newHTTPDataVolume("testDV", "http://www.example.com")
+			dataVolume.Annotations = map[string]string{cc.AnnCloneFanOutGroupSize: groupSize}
+			resp := validateDataVolumeCreate(dataVolume)
+			Expect(resp.Allowed).To(Equal(expected))
+		},
+			Entry("accept a group within the limit", "50", true),
+			Entry("reject a group over the limit", "51", false),
+			Entry("reject an empty group", "0", false),
+			Entry("reject an invalid size", "many", false),
+		)
+
 		DescribeTable("should", func(scName *string, expected bool) {
 			httpSource := &cdiv1.DataVolumeSource{
 				HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://www.example.com"},
@@ -261,6 +319,40 @@ var _ = Describe("Validating Webhook", func() {
 			Expect(resp.Allowed).To(BeFalse())
 		})
 
+		DescribeTable("should validate DataVolume HTTP source checksum on create", func(checksum string, expectedAllowed bool) {
+			dataVolume := newHTTPDataVolume("testDV", "http://www.example.com")
+			dataVolume.Spec.Source.HTTP.Checksum = checksum
+			resp := validateDataVolumeCreate(dataVolume)
+			Expect(resp.Allowed).To(Equal(expectedAllowed))
+		},
+			Entry("accept sha256 checksum", "sha256:0123456789abcdef", true),
+			Entry("reject checksum without algorithm", "0123456789abcdef", false),
+			Entry("reject checksum with unknown algorithm", "crc32:0123abcd", false),
+			Entry("reject checksum with non hex digest", "sha256:xyz", false),
+		)
+
+		DescribeTable("should validate DataVolume retry policy on create", func(policy *cdiv1.RetryPolicy, expectedAllowed bool) {
+			dataVolume := newHTTPDataVolume("testDV", "http://www.example.com")
+			dataVolume.Spec.RetryPolicy = policy
+			resp := validateDataVolumeCreate(dataVolume)
+			Expect(resp.Allowed).To(Equal(expectedAllowed))
+		},
+			Entry("accept a complete policy", &cdiv1.RetryPolicy{
+				MaxAttempts: pointer.Int32(3),
+				BackoffBase: &metav1.Duration{Duration: time.Second},
+				BackoffCap:  &metav1.Duration{Duration: time.Minute},
+				RetryOn:     []cdiv1.ImportErrorClass{cdiv1.ImportErrorNetwork, cdiv1.ImportErrorAuth},
+			}, true),
+			Entry("accept an empty policy", &cdiv1.RetryPolicy{}, true),
+			Entry("reject zero max attempts", &cdiv1.RetryPolicy{MaxAttempts: pointer.Int32(0)}, false),
+			Entry("reject a negative backoff base", &cdiv1.RetryPolicy{BackoffBase: &metav1.Duration{Duration: -time.Second}}, false),
+			Entry("reject a backoff cap below the base", &cdiv1.RetryPolicy{
+				BackoffBase: &metav1.Duration{Duration: time.Minute},
+				BackoffCap:  &metav1.Duration{Duration: time.Second},
+			}, false),
+			Entry("reject an unknown error class", &cdiv1.RetryPolicy{RetryOn: []cdiv1.ImportErrorClass{"Cosmic"}}, false),
+		)
+
 		It("should reject DataVolume with multiple sources on create", func() {
 			dataVolume := newDataVolumeWithMultipleSources("testDV")
 			resp := validateDataVolumeCreate(dataVolume)
@@ -468,20 +560,26 @@ var _ = Describe("Validating Webhook", func() {
 			}, false),
 		)
 
-		It("should reject empty Requests when using Storage API with DataVolumeSource but without DataVolumeSourcePVC", func() {
-			httpSource := &cdiv1.DataVolumeSource{
-				HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://www.example.com"},
-			}
+		DescribeTable("should validate empty Requests when using Storage API with DataVolumeSource but without DataVolumeSourcePVC", func(source *cdiv1.DataVolumeSource, expected bool) {
 			requests := make(map[corev1.ResourceName]resource.Quantity)
 			storage := &cdiv1.StorageSpec{
 				Resources: corev1.ResourceRequirements{
 					Requests: requests,
 				},
 			}
-			dv := newDataVolumeWithStorageSpec("testDV", httpSource, nil, storage)
+			dv := newDataVolumeWithStorageSpec("testDV", source, nil, storage)
 			resp := validateDataVolumeCreate(dv)
-			Expect(resp.Allowed).To(BeFalse())
-		})
+			Expect(resp.Allowed).To(Equal(expected))
+			if !expected {
+				Expect(resp.Result.Message).To(ContainSubstring("Storage size is missing, it can only be omitted for http, s3, gcs, pvc and snapshot sources"))
+			}
+		},
+			Entry("accept http source, whose size is detected", &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://www.example.com"}}, true),
+			Entry("accept s3 source, whose size is detected", &cdiv1.DataVolumeSource{S3: &cdiv1.DataVolumeSourceS3{URL: "http://www.example.com/bucket/disk.img"}}, true),
+			Entry("reject registry source", &cdiv1.DataVolumeSource{Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String("docker://www.example.com/disk")}}, false),
+			Entry("reject upload source", &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}, false),
+			Entry("reject blank source", &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}}, false),
+		)
 
 		It("should allow empty Requests when using Storage API with DataVolumeSourceRef", func() {
 			pvc := &corev1.PersistentVolumeClaim{
@@ -740,6 +838,38 @@ var _ = Describe("Validating Webhook", func() {
 			Entry("accept DataVolume with PVC and sourceRef missing namespace on create", &emptyNamespace),
 		)
 
+		DescribeTable("should validate DataVolume with SourceRef to a DataSource shared by another namespace", func(grant *cdiv1.DataSourceGrant, expectAllowed bool) {
+			goldenNamespace := "golden-images"
+			dataVolume := newDataSourceDataVolume("testDV", &goldenNamespace, "test")
+			dataVolume.Namespace = testNamespace
+			dataSource := &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dataVolume.Spec.SourceRef.Name,
+					Namespace: goldenNamespace,
+				},
+				Spec: cdiv1.DataSourceSpec{
+					Source: cdiv1.DataSourceSource{
+						PVC: &cdiv1.DataVolumeSourcePVC{
+							Name:      "testPVC",
+							Namespace: goldenNamespace,
+						},
+					},
+					Grant: grant,
+				},
+			}
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace, Labels: map[string]string{"tenant": "true"}}}
+			resp := validateDataVolumeCreateEx(dataVolume, []runtime.Object{ns}, []runtime.Object{dataSource}, nil)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+		},
+			Entry("accept with no grant", nil, true),
+			Entry("accept with granted namespace", &cdiv1.DataSourceGrant{Namespaces: []string{testNamespace}}, true),
+			Entry("accept with namespace granted by selector",
+				&cdiv1.DataSourceGrant{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}}}, true),
+			Entry("accept with namespace not granted, authorized by the source permissions", &cdiv1.DataSourceGrant{Namespaces: []string{"other"}}, true),
+			Entry("accept with namespace not selected, authorized by the source permissions",
+				&cdiv1.DataSourceGrant{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "false"}}}, true),
+		)
+
 		It("should reject DataVolume with SourceRef on create if DataSource does not exist", func() {
 			ns := "testNamespace"
 			dataVolume := newDataSourceDataVolume("testDV", &ns, "test")
@@ -804,6 +934,36 @@ var _ = Describe("Validating Webhook", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should validate DataVolume with SourceRef to a remote DataSource", func(withSize, withCache, expectAllowed bool) {
+			dataVolume := newDataSourceDataVolume("testDV", &testNamespace, "test")
+			dataVolume.Spec.PVC = nil
+			dataVolume.Spec.Storage = &cdiv1.StorageSpec{}
+			if withSize {
+				dataVolume.Spec.Storage.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: *resource.NewQuantity(pvcSizeDefault, resource.BinarySI)}
+			}
+			dataSource := &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dataVolume.Spec.SourceRef.Name,
+					Namespace: testNamespace,
+				},
+				Spec: cdiv1.DataSourceSpec{
+					Source: cdiv1.DataSourceSource{
+						Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String("docker://quay.io/test/image")},
+					},
+					Cache: &cdiv1.DataSourceCache{Size: resource.MustParse("1Gi")},
+				},
+			}
+			if withCache {
+				dataSource.Status.Source.PVC = &cdiv1.DataVolumeSourcePVC{Namespace: "cdi", Name: cdiv1.DataSourceCacheNamePrefix + "-0123456789abcdef"}
+			}
+			resp := validateDataVolumeCreateEx(dataVolume, nil, []runtime.Object{dataSource}, nil)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+		},
+			Entry("accept with storage size", true, true, true),
+			Entry("accept without storage size, as the cache storage is set by the DataSource", false, true, true),
+			Entry("reject before the DataSource records its cache", true, false, false),
+		)
+
 		It("should reject DataVolume with empty SourceRef name on create", func() {
 			dataVolume := newDataSourceDataVolume("testDV", &testNamespace, "")
 			resp := validateDataVolumeCreate(dataVolume)
@@ -77,6 +77,11 @@ func NewDataImportCronValidatingWebhook(k8sClient kubernetes.Interface, cdiClien
 	return newAdmissionHandler(&dataImportCronValidatingWebhook{dataVolumeValidatingWebhook{k8sClient: k8sClient, cdiClient: cdiClient}})
 }
 
+// NewDataSourceValidatingWebhook creates a new DataSource validating webhook
+func NewDataSourceValidatingWebhook(k8sClient kubernetes.Interface, cdiClient cdiclient.Interface) http.Handler {
+	return newAdmissionHandler(&dataSourceValidatingWebhook{k8sClient: k8sClient, cdiClient: cdiClient})
+}
+
 // NewPopulatorValidatingWebhook creates a new DataVolumeValidation webhook
 func NewPopulatorValidatingWebhook(k8sClient kubernetes.Interface, cdiClient cdiclient.Interface) http.Handler {
 	return newAdmissionHandler(&populatorValidatingWebhook{dataVolumeValidatingWebhook{k8sClient: k8sClient, cdiClient: cdiClient}})
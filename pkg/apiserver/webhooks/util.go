@@ -22,13 +22,19 @@ package webhooks
 import (
 	"fmt"
 	neturl "net/url"
+	"path"
 	"reflect"
+	"regexp"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	field "k8s.io/apimachinery/pkg/util/validation/field"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+var checksumRegex = regexp.MustCompile(`^(md5|sha1|sha256|sha512):[0-9a-fA-F]+$`)
+
 func validateNumberOfSources(source interface{}, sourceKind string, field *field.Path) []metav1.StatusCause {
 	numberOfSources := 0
 	s := reflect.ValueOf(source).Elem()
@@ -162,7 +168,17 @@ func validateDataVolumeSourceRegistry(sourceRegistry *cdiv1.DataVolumeSourceRegi
 // if source types are HTTP, Imageio, S3, GCS or VDDK, check if URL is valid
 
 func validateHTTPSource(http *cdiv1.DataVolumeSourceHTTP, field *field.Path) []metav1.StatusCause {
-	return checkSourceURL(http.URL, "HTTP", field)
+	if causes := checkSourceURL(http.URL, "HTTP", field); causes != nil {
+		return causes
+	}
+	if http.Checksum != "" && !checksumRegex.MatchString(http.Checksum) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s invalid checksum %s, expected <algorithm>:<hex digest>", field.Child("source").String(), http.Checksum),
+			Field:   field.Child("source", "HTTP", "checksum").String(),
+		}}
+	}
+	return nil
 }
 
 func validateS3Source(s3 *cdiv1.DataVolumeSourceS3, field *field.Path) []metav1.StatusCause {
@@ -195,6 +211,35 @@ func validateVDDKSource(vddk *cdiv1.DataVolumeSourceVDDK, field *field.Path) []m
 	return checkSourceURL(vddk.URL, "VDDK", field)
 }
 
+func validateClonePathFilter(filter *cdiv1.DataVolumeSourcePathFilter, spec *cdiv1.DataVolumeSpec, field *field.Path) *metav1.StatusCause {
+	if spec.ContentType != cdiv1.DataVolumeArchive {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is only supported with contentType %s", field.String(), cdiv1.DataVolumeArchive),
+			Field:   field.String(),
+		}
+	}
+	if (spec.PVC != nil && spec.PVC.VolumeMode != nil && *spec.PVC.VolumeMode == v1.PersistentVolumeBlock) ||
+		(spec.Storage != nil && spec.Storage.VolumeMode != nil && *spec.Storage.VolumeMode == v1.PersistentVolumeBlock) {
+		return &metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is only supported with a filesystem target", field.String()),
+			Field:   field.String(),
+		}
+	}
+	for _, p := range append(filter.Include, filter.Exclude...) {
+		cleaned := path.Clean(p)
+		if p == "" || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return &metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s path %q must be relative to the root of the source PVC", field.String(), p),
+				Field:   field.String(),
+			}
+		}
+	}
+	return nil
+}
+
 func checkSourceURL(url, sourceType string, field *field.Path) []metav1.StatusCause {
 	if errString := validateSourceURL(url); errString != "" {
 		return []metav1.StatusCause{{
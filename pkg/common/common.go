@@ -49,6 +49,14 @@ const (
 	DataImportCronLabel = CDIComponentLabel + "/dataImportCron"
 	// DataImportCronCleanupLabel tells whether to delete the resource when its DataImportCron is deleted
 	DataImportCronCleanupLabel = DataImportCronLabel + ".cleanup"
+	// DataImportCronStorageClassLabel has the storage class target of the labeled DataImportCron import copy
+	DataImportCronStorageClassLabel = DataImportCronLabel + ".storageClass"
+	// DataSourceCacheLabel marks the DataVolumes importing DataSource remote sources to their shared cache in the CDI namespace
+	DataSourceCacheLabel = CDIComponentLabel + "/dataSourceCache"
+	// ImportSourceIdentityLabel has the hashed source identity of the labeled imported volume, indexing it for import deduplication
+	ImportSourceIdentityLabel = CDIComponentLabel + "/importSourceIdentity"
+	// WorkerQueuedLabel is set on PVCs waiting for a worker pod due to the CDIConfig worker pod limits
+	WorkerQueuedLabel = CDIComponentLabel + "/workerQueued"
 
 	// ImporterVolumePath provides a constant for the directory where the PV is mounted.
 	ImporterVolumePath = "/data"
@@ -95,6 +103,8 @@ const (
 	ImporterSecretKey = "IMPORTER_SECRET_KEY"
 	// ImporterImageSize provides a constant to capture our env variable "IMPORTER_IMAGE_SIZE"
 	ImporterImageSize = "IMPORTER_IMAGE_SIZE"
+	// ImporterScratchSizeEstimated provides a constant to capture our env variable "IMPORTER_SCRATCH_SIZE_ESTIMATED"
+	ImporterScratchSizeEstimated = "IMPORTER_SCRATCH_SIZE_ESTIMATED"
 	// ImporterCertDirVar provides a constant to capture our env variable "IMPORTER_CERT_DIR"
 	ImporterCertDirVar = "IMPORTER_CERT_DIR"
 	// InsecureTLSVar provides a constant to capture our env variable "INSECURE_TLS"
@@ -125,6 +135,16 @@ const (
 	ImporterFinalCheckpoint = "IMPORTER_FINAL_CHECKPOINT"
 	// Preallocation provides a constant to capture out env variable "PREALLOCATION"
 	Preallocation = "PREALLOCATION"
+	// ClonerIncludePaths provides a constant to capture our env variable "CLONER_INCLUDE_PATHS"
+	ClonerIncludePaths = "CLONER_INCLUDE_PATHS"
+	// ClonerExcludePaths provides a constant to capture our env variable "CLONER_EXCLUDE_PATHS"
+	ClonerExcludePaths = "CLONER_EXCLUDE_PATHS"
+	// ClonerUploadTargets provides a constant to capture our env variable "CLONER_UPLOAD_TARGETS"
+	ClonerUploadTargets = "CLONER_UPLOAD_TARGETS"
+	// ClonerBandwidthLimitDir is the directory of the file holding the clone source bandwidth limit
+	ClonerBandwidthLimitDir = "/var/run/cdi/clone-limits"
+	// ClonerBandwidthLimitFile is the file holding the clone source bandwidth limit in bytes per second
+	ClonerBandwidthLimitFile = "bandwidthLimit"
 	// ImportProxyHTTP provides a constant to capture our env variable "http_proxy"
 	ImportProxyHTTP = "http_proxy"
 	// ImportProxyHTTPS provides a constant to capture our env variable "https_proxy"
@@ -141,6 +161,26 @@ const (
 	ImporterExtraHeader = "IMPORTER_EXTRA_HEADER_"
 	// ImporterSecretExtraHeadersDir is where the secrets containing extra HTTP headers will be mounted
 	ImporterSecretExtraHeadersDir = "/extraheaders"
+	// ImportCacheURL provides a constant to capture our env variable "IMPORT_CACHE_URL", the node-local import cache endpoint
+	ImportCacheURL = "IMPORT_CACHE_URL"
+	// ImportCacheToken provides a constant to capture our env variable "IMPORT_CACHE_TOKEN", the token authorizing the importer to use the import cache
+	ImportCacheToken = "IMPORT_CACHE_TOKEN"
+	// ImportCacheName is the name of the node-local import cache DaemonSet and Service
+	ImportCacheName = "cdi-import-cache"
+	// ImportCachePort is the port of the node-local import cache
+	ImportCachePort = 8080
+	// ImportCacheDir is where the node-local import cache keeps its entries
+	ImportCacheDir = "/var/cache/cdi-import"
+	// ImporterDryRun provides a constant to capture our env variable "IMPORTER_DRY_RUN", to inspect the source without importing it
+	ImporterDryRun = "IMPORTER_DRY_RUN"
+	// ImporterSparsify provides a constant to capture our env variable "IMPORTER_SPARSIFY", to deallocate the zero blocks of the target
+	ImporterSparsify = "IMPORTER_SPARSIFY"
+	// ImporterSourceChecksum provides a constant to capture our env variable "IMPORTER_SOURCE_CHECKSUM", the checksum the source must match
+	ImporterSourceChecksum = "IMPORTER_SOURCE_CHECKSUM"
+	// ImporterSourceVersion provides a constant to capture our env variable "IMPORTER_SOURCE_VERSION", the source version digest the source must match
+	ImporterSourceVersion = "IMPORTER_SOURCE_VERSION"
+	// PreflightPodName provides a constant to name and label the dry-run Pods of DataVolumes (controller only)
+	PreflightPodName = "preflight"
 
 	// ImporterGoogleCredentialFileVar provides a constant to capture our env variable "GOOGLE_APPLICATION_CREDENTIALS"
 	ImporterGoogleCredentialFileVar = "GOOGLE_APPLICATION_CREDENTIALS"
@@ -218,6 +258,9 @@ const (
 	// ExtendedCloneTokenIssuer is the JWT issuer for clone tokens
 	ExtendedCloneTokenIssuer = "cdi-deployment"
 
+	// ImportCacheTokenIssuer is the JWT issuer of import cache tokens
+	ImportCacheTokenIssuer = "cdi-deployment"
+
 	// QemuSubGid is the gid used as the qemu group in fsGroup
 	QemuSubGid = int64(107)
 
@@ -233,6 +276,8 @@ const (
 	VddkConfigDataKey = "vddk-init-image"
 	// AwaitingVDDK is a Pending condition reason that indicates the PVC is waiting for a VDDK image
 	AwaitingVDDK = "AwaitingVDDK"
+	// WorkerQueued is a Running condition reason that indicates the PVC is queued for a worker pod
+	WorkerQueued = "Queued"
 
 	// UploadContentTypeHeader is the header upload clients may use to set the content type explicitly
 	UploadContentTypeHeader = "x-cdi-content-type"
@@ -263,9 +308,17 @@ const (
 
 	// PreallocationApplied is a string inserted into importer's/uploader's exit message
 	PreallocationApplied = "Preallocation applied"
+	// SparsifyUnmapped is a string inserted into importer's exit message before the size of the zero ranges the sparsify phase deallocated
+	SparsifyUnmapped = "Sparsify unmapped bytes"
+	// SparsifyReclaimed is a string inserted into importer's exit message before the allocated space the sparsify phase reclaimed
+	SparsifyReclaimed = "Sparsify reclaimed bytes"
 
 	// ScratchSpaceRequired is a string inserted into a pod exist message when scratch space is needed
 	ScratchSpaceRequired = "scratch space required and none found"
+	// ScratchSizeEstimate is a string inserted into a pod exit message before the estimated scratch space size in bytes
+	ScratchSizeEstimate = "estimated scratch size"
+	// ScratchSpaceTooSmall is a string inserted into a pod exit message when scratch space sized from an estimate is full
+	ScratchSpaceTooSmall = "scratch space too small for the image"
 
 	// SecretHeader is the key in a secret containing a sensitive extra header for HTTP data sources
 	SecretHeader = "secretHeader"
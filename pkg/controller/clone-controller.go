@@ -3,8 +3,10 @@ package controller
 import (
 	"context"
 	"crypto/rsa"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -56,6 +58,11 @@ const (
 	hostAssistedCloneSource = "cdi.kubevirt.io/hostAssistedSourcePodCloneSource"
 
 	uploadClientCertDuration = 365 * 24 * time.Hour
+
+	// cloneTargetInProgressField indexes the clone target PVCs which may have a running source pod
+	cloneTargetInProgressField = "cloneTargetInProgress"
+	// cloneFanOutSourcePodField indexes the clone fan-out target PVCs by the name of their source pod
+	cloneFanOutSourcePodField = "cloneFanOutSourcePod"
 )
 
 // CloneReconciler members
@@ -122,29 +129,84 @@ func addCloneControllerWatches(mgr manager.Manager, cloneController controller.C
 	}
 	if err := cloneController.Watch(&source.Kind{Type: &corev1.Pod{}}, handler.EnqueueRequestsFromMapFunc(
 		func(obj client.Object) []reconcile.Request {
-			target, ok := obj.GetAnnotations()[AnnOwnerRef]
-			if !ok {
-				return nil
+			var targets []string
+			if target, ok := obj.GetAnnotations()[AnnOwnerRef]; ok {
+				targets = append(targets, target)
 			}
-			namespace, name, err := cache.SplitMetaNamespaceKey(target)
-			if err != nil {
-				return nil
+			if fanOutTargets, ok := obj.GetAnnotations()[AnnCloneFanOutTargets]; ok {
+				targets = append(targets, strings.Split(fanOutTargets, ",")...)
 			}
-			return []reconcile.Request{
-				{
+			var reqs []reconcile.Request
+			for _, target := range targets {
+				namespace, name, err := cache.SplitMetaNamespaceKey(target)
+				if err != nil {
+					continue
+				}
+				reqs = append(reqs, reconcile.Request{
 					NamespacedName: types.NamespacedName{
 						Namespace: namespace,
 						Name:      name,
 					},
-				},
+				})
 			}
+			return reqs
 		},
 	)); err != nil {
 		return err
 	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &corev1.PersistentVolumeClaim{}, cloneFanOutSourcePodField, indexCloneFanOutSourcePod); err != nil {
+		return err
+	}
+
+	// Bandwidth limit changes are applied to running clone source pods
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &corev1.PersistentVolumeClaim{}, cloneTargetInProgressField, indexCloneTargetInProgress); err != nil {
+		return err
+	}
+	mapToCloneTargets := func(obj client.Object) []reconcile.Request {
+		return getCloneTargetsInProgress(mgr.GetClient())
+	}
+	if err := cloneController.Watch(&source.Kind{Type: &cdiv1.StorageProfile{}}, handler.EnqueueRequestsFromMapFunc(mapToCloneTargets)); err != nil {
+		return err
+	}
+	if err := cloneController.Watch(&source.Kind{Type: &cdiv1.CDIConfig{}}, handler.EnqueueRequestsFromMapFunc(mapToCloneTargets)); err != nil {
+		return err
+	}
 	return nil
 }
 
+// indexCloneTargetInProgress indexes the clone target PVCs which may have a running source pod
+func indexCloneTargetInProgress(obj client.Object) []string {
+	pvc := obj.(*corev1.PersistentVolumeClaim)
+	if !metav1.HasAnnotation(pvc.ObjectMeta, cc.AnnCloneSourcePod) || metav1.HasAnnotation(pvc.ObjectMeta, cc.AnnCloneOf) {
+		return nil
+	}
+	return []string{"true"}
+}
+
+// indexCloneFanOutSourcePod indexes the clone fan-out target PVCs by the name of their source pod
+func indexCloneFanOutSourcePod(obj client.Object) []string {
+	pvc := obj.(*corev1.PersistentVolumeClaim)
+	sourcePod := pvc.Annotations[cc.AnnCloneSourcePod]
+	if sourcePod == "" || pvc.Annotations[cc.AnnCloneFanOutGroup] == "" {
+		return nil
+	}
+	return []string{sourcePod}
+}
+
+// getCloneTargetsInProgress returns the requests of the clone target PVCs which may have a running source pod
+func getCloneTargetsInProgress(c client.Client) []reconcile.Request {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(context.TODO(), pvcList, client.MatchingFields{cloneTargetInProgressField: "true"}); err != nil {
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, pvc := range pvcList.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}})
+	}
+	return reqs
+}
+
 func (r *CloneReconciler) shouldReconcile(pvc *corev1.PersistentVolumeClaim, log logr.Logger) bool {
 	return checkPVC(pvc, cc.AnnCloneRequest, log) &&
 		!metav1.HasAnnotation(pvc.ObjectMeta, cc.AnnCloneOf) &&
@@ -196,6 +258,9 @@ func (r *CloneReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	_, nameExists := pvc.Annotations[cc.AnnCloneSourcePod]
 	if !nameExists && sourcePod == nil {
 		pvc.Annotations[cc.AnnCloneSourcePod] = cc.CreateCloneSourcePodName(pvc)
+		if pvc.Annotations[cc.AnnCloneFanOutGroup] != "" {
+			pvc.Annotations[cc.AnnCloneSourcePod] = cc.CreateCloneFanOutSourcePodName(pvc)
+		}
 
 		// add finalizer before creating clone source pod
 		cc.AddFinalizer(pvc, cloneSourcePodFinalizer)
@@ -208,6 +273,11 @@ func (r *CloneReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{}, nil
 	}
 
+	if sourcePod != nil && isCloneFanOutTarget(pvc) && !cloneFanOutPodServesTarget(sourcePod, pvc) {
+		// late or failed fan-out target, clone it with a dedicated source pod
+		return reconcile.Result{}, r.leaveCloneFanOut(ctx, sourcePod, pvc, log)
+	}
+
 	if requeueAfter, err := r.reconcileSourcePod(ctx, sourcePod, pvc, log); requeueAfter != 0 || err != nil {
 		return reconcile.Result{RequeueAfter: requeueAfter}, err
 	}
@@ -216,6 +286,10 @@ func (r *CloneReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{}, err
 	}
 
+	if err := r.updateSourcePodBandwidthLimit(ctx, sourcePod, pvc, log); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if err := r.updatePvcFromPod(sourcePod, pvc, log); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -260,9 +334,25 @@ func (r *CloneReconciler) reconcileSourcePod(ctx context.Context, sourcePod *cor
 			return 2 * time.Second, nil
 		}
 
-		sourcePod, err := r.CreateCloneSourcePod(r.image, r.pullPolicy, targetPvc, log)
+		var fanOutTargets []*corev1.PersistentVolumeClaim
+		if isCloneFanOutTarget(targetPvc) {
+			fanOutTargets, err = r.getCloneFanOutTargets(ctx, sourcePvc, targetPvc, log)
+			if err != nil {
+				return 0, err
+			}
+			if fanOutTargets == nil {
+				log.V(3).Info("Waiting for clone fan-out group", "group", targetPvc.Annotations[cc.AnnCloneFanOutGroup])
+				return 2 * time.Second, nil
+			}
+		}
+
+		sourcePod, err := r.CreateCloneSourcePod(r.image, r.pullPolicy, targetPvc, fanOutTargets, log)
+		if fanOutTargets != nil && k8serrors.IsAlreadyExists(errors.Cause(err)) {
+			// created by another target of the fan-out group
+			return 2 * time.Second, nil
+		}
 		// Check if pod has failed and, in that case, record an event with the error
-		if podErr := cc.HandleFailedPod(err, cc.CreateCloneSourcePodName(targetPvc), targetPvc, r.recorder, r.client); podErr != nil {
+		if podErr := cc.HandleFailedPod(err, targetPvc.Annotations[cc.AnnCloneSourcePod], targetPvc, r.recorder, r.client); podErr != nil {
 			return 0, podErr
 		}
 
@@ -271,6 +361,107 @@ func (r *CloneReconciler) reconcileSourcePod(ctx context.Context, sourcePod *cor
 	return 0, nil
 }
 
+// getCloneFanOutTargets returns the targets to populate from the fan-out source pod of targetPvc,
+// or nil if not all targets of the fan-out group are ready yet
+func (r *CloneReconciler) getCloneFanOutTargets(ctx context.Context, sourcePvc, targetPvc *corev1.PersistentVolumeClaim, log logr.Logger) ([]*corev1.PersistentVolumeClaim, error) {
+	groupSize, err := cc.ParseCloneFanOutGroupSize(targetPvc.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.client.List(ctx, pvcList, client.InNamespace(targetPvc.Namespace),
+		client.MatchingFields{cloneFanOutSourcePodField: targetPvc.Annotations[cc.AnnCloneSourcePod]}); err != nil {
+		return nil, errors.Wrap(err, "error listing pvcs")
+	}
+
+	members := 0
+	targets := []*corev1.PersistentVolumeClaim{}
+	for i := range pvcList.Items {
+		pvc := &pvcList.Items[i]
+		if pvc.DeletionTimestamp != nil {
+			continue
+		}
+		members++
+
+		ready, err := r.waitTargetPodRunningOrSucceeded(pvc, log)
+		if err != nil {
+			return nil, err
+		}
+		if !ready || podSucceededFromPVC(pvc) || !cloneFanOutCompatible(targetPvc, pvc) {
+			continue
+		}
+		if pvc.UID != targetPvc.UID {
+			if err := r.validateSourceAndTarget(ctx, sourcePvc, pvc); err != nil {
+				log.V(1).Info("Excluding PVC from clone fan-out", "pvc", pvc.Name, "error", err.Error())
+				continue
+			}
+		}
+		targets = append(targets, pvc)
+	}
+
+	if members < groupSize {
+		return nil, nil
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Name < targets[j].Name
+	})
+	// the targets beyond the limit are cloned with dedicated source pods
+	if len(targets) > cc.MaxCloneFanOutGroupSize {
+		targets = targets[:cc.MaxCloneFanOutGroupSize]
+	}
+
+	return targets, nil
+}
+
+// leaveCloneFanOut switches a target the fan-out source pod did not populate to a dedicated source pod
+func (r *CloneReconciler) leaveCloneFanOut(ctx context.Context, sourcePod *corev1.Pod, pvc *corev1.PersistentVolumeClaim, log logr.Logger) error {
+	log.V(1).Info("PVC not populated by clone fan-out, using a dedicated source pod", "pod", sourcePod.Name)
+
+	inUse, err := r.isCloneFanOutPodInUse(ctx, sourcePod, pvc)
+	if err != nil {
+		return err
+	}
+	if !inUse && sourcePodFinished(sourcePod) {
+		if err := r.client.Delete(ctx, sourcePod); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrap(err, "error deleting clone fan-out source pod")
+		}
+	}
+
+	pvc.Annotations[cc.AnnCloneSourcePod] = cc.CreateCloneSourcePodName(pvc)
+	return r.updatePVC(pvc)
+}
+
+// isCloneFanOutPodInUse returns true if targets other than pvc still depend on the fan-out source pod
+func (r *CloneReconciler) isCloneFanOutPodInUse(ctx context.Context, sourcePod *corev1.Pod, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	fanOutTargets, ok := sourcePod.Annotations[AnnCloneFanOutTargets]
+	if !ok {
+		return false, nil
+	}
+
+	for _, key := range strings.Split(fanOutTargets, ",") {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil || (namespace == pvc.Namespace && name == pvc.Name) {
+			continue
+		}
+		target := &corev1.PersistentVolumeClaim{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, target); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if target.DeletionTimestamp == nil &&
+			target.Annotations[cc.AnnCloneSourcePod] == sourcePod.Name &&
+			target.Annotations[cc.AnnCloneOf] != "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *CloneReconciler) ensureCertSecret(sourcePod *corev1.Pod, targetPvc *corev1.PersistentVolumeClaim, log logr.Logger) error {
 	if sourcePod == nil {
 		return nil
@@ -450,7 +641,13 @@ func (r *CloneReconciler) cleanup(pvc *corev1.PersistentVolumeClaim, log logr.Lo
 			log.V(3).Info("Clone succeeded, waiting for source pod to stop running", "pod.Namespace", pod.Namespace, "pod.Name", pod.Name)
 			return nil
 		}
-		if cc.ShouldDeletePod(pvc) {
+		inUse, err := r.isCloneFanOutPodInUse(context.TODO(), pod, pvc)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			log.V(3).Info("Clone fan-out source pod still in use by other targets", "pod.Name", pod.Name)
+		} else if cc.ShouldDeletePod(pvc) {
 			log.V(3).Info("Deleting pod", "pod.Name", pod.Name)
 			if err = r.client.Delete(context.TODO(), pod); err != nil {
 				if !k8serrors.IsNotFound(err) {
@@ -466,7 +663,8 @@ func (r *CloneReconciler) cleanup(pvc *corev1.PersistentVolumeClaim, log logr.Lo
 }
 
 // CreateCloneSourcePod creates our cloning src pod which will be used for out of band cloning to read the contents of the src PVC
-func (r *CloneReconciler) CreateCloneSourcePod(image, pullPolicy string, pvc *corev1.PersistentVolumeClaim, log logr.Logger) (*corev1.Pod, error) {
+// If fanOutTargets is not empty, the pod streams the src PVC to all of them
+func (r *CloneReconciler) CreateCloneSourcePod(image, pullPolicy string, pvc *corev1.PersistentVolumeClaim, fanOutTargets []*corev1.PersistentVolumeClaim, log logr.Logger) (*corev1.Pod, error) {
 	exists, _, _ := ParseCloneRequestAnnotation(pvc)
 	if !exists {
 		return nil, errors.Errorf("bad CloneRequest Annotation")
@@ -509,7 +707,19 @@ func (r *CloneReconciler) CreateCloneSourcePod(image, pullPolicy string, pvc *co
 		sourceVolumeMode = corev1.PersistentVolumeFilesystem
 	}
 
+	bandwidthLimit, err := cc.GetCloneBandwidthLimit(context.TODO(), r.client, sourcePvc)
+	if err != nil {
+		return nil, err
+	}
+
 	pod := MakeCloneSourcePodSpec(sourceVolumeMode, image, pullPolicy, ownerKey, imagePullSecrets, serverCABundle, pvc, sourcePvc, podResourceRequirements, workloadNodePlacement)
+	setCloneBandwidthLimit(pod, bandwidthLimit)
+	setWorkerPodAnnotations(pod, pvc, getPVCWorkerKey(sourcePvc))
+	if len(fanOutTargets) > 0 {
+		if err := setCloneFanOutTargets(pod, fanOutTargets); err != nil {
+			return nil, err
+		}
+	}
 	util.SetRecommendedLabels(pod, r.installerLabels, "cdi-controller")
 
 	if err := r.client.Create(context.TODO(), pod); err != nil {
@@ -530,18 +740,9 @@ func MakeCloneSourcePodSpec(sourceVolumeMode corev1.PersistentVolumeMode, image,
 	sourcePvcNamespace := sourcePvc.GetNamespace()
 	sourcePvcUID := string(sourcePvc.GetUID())
 
-	var ownerID string
 	cloneSourcePodName := targetPvc.Annotations[cc.AnnCloneSourcePod]
 	url := GetUploadServerURL(targetPvc.Namespace, targetPvc.Name, common.UploadPathSync)
-	pvcOwner := metav1.GetControllerOf(targetPvc)
-	if pvcOwner != nil && pvcOwner.Kind == "DataVolume" {
-		ownerID = string(pvcOwner.UID)
-	} else {
-		ouid, ok := targetPvc.Annotations[cc.AnnOwnerUID]
-		if ok {
-			ownerID = ouid
-		}
-	}
+	ownerID := getCloneOwnerID(targetPvc)
 
 	preallocationRequested := targetPvc.Annotations[cc.AnnPreallocationRequested]
 
@@ -719,14 +920,177 @@ func MakeCloneSourcePodSpec(sourceVolumeMode corev1.PersistentVolumeMode, image,
 				Value: common.ClonerMountPath,
 			},
 		}
+		if includePaths, ok := targetPvc.Annotations[cc.AnnCloneIncludePaths]; ok {
+			addVars = append(addVars, corev1.EnvVar{
+				Name:  common.ClonerIncludePaths,
+				Value: includePaths,
+			})
+		} else if util.ResolveVolumeMode(targetPvc.Spec.VolumeMode) == corev1.PersistentVolumeBlock {
+			// a block target only receives the disk image
+			addVars = append(addVars, corev1.EnvVar{
+				Name:  common.ClonerIncludePaths,
+				Value: fmt.Sprintf("[%q]", common.DiskImageName),
+			})
+		}
+		if excludePaths, ok := targetPvc.Annotations[cc.AnnCloneExcludePaths]; ok {
+			addVars = append(addVars, corev1.EnvVar{
+				Name:  common.ClonerExcludePaths,
+				Value: excludePaths,
+			})
+		}
 	}
 
+	// the bandwidth limit annotation is exposed to the cloner, so it can be changed while cloning
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: CloneLimitsVolName,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{
+						Path: common.ClonerBandwidthLimitFile,
+						FieldRef: &corev1.ObjectFieldSelector{
+							FieldPath: fmt.Sprintf("metadata.annotations['%s']", AnnCloneBandwidthLimit),
+						},
+					},
+				},
+			},
+		},
+	})
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      CloneLimitsVolName,
+		MountPath: common.ClonerBandwidthLimitDir,
+		ReadOnly:  true,
+	})
+
 	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, addVars...)
 	cc.SetPvcAllowedAnnotations(pod, targetPvc)
 	cc.SetRestrictedSecurityContext(&pod.Spec)
 	return pod
 }
 
+// setCloneBandwidthLimit sets the bandwidth limit of a clone source pod, returns true if it changed
+func setCloneBandwidthLimit(pod *corev1.Pod, limit int64) bool {
+	current, exists := pod.Annotations[AnnCloneBandwidthLimit]
+	if limit <= 0 {
+		delete(pod.Annotations, AnnCloneBandwidthLimit)
+		return exists
+	}
+	desired := strconv.FormatInt(limit, 10)
+	if current == desired {
+		return false
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[AnnCloneBandwidthLimit] = desired
+	return true
+}
+
+// updateSourcePodBandwidthLimit applies bandwidth limit changes to a running clone source pod
+func (r *CloneReconciler) updateSourcePodBandwidthLimit(ctx context.Context, sourcePod *corev1.Pod, targetPvc *corev1.PersistentVolumeClaim, log logr.Logger) error {
+	if sourcePod == nil || sourcePod.Status.Phase != corev1.PodRunning {
+		return nil
+	}
+
+	sourcePvc, err := r.getCloneRequestSourcePVC(targetPvc)
+	if err != nil {
+		return err
+	}
+
+	limit, err := cc.GetCloneBandwidthLimit(ctx, r.client, sourcePvc)
+	if err != nil {
+		return err
+	}
+
+	if !setCloneBandwidthLimit(sourcePod, limit) {
+		return nil
+	}
+
+	log.V(1).Info("Updating clone source pod bandwidth limit", "pod.Name", sourcePod.Name, "limit", limit)
+	return r.client.Update(ctx, sourcePod)
+}
+
+type cloneUploadTarget struct {
+	URL      string `json:"url"`
+	OwnerUID string `json:"ownerUID"`
+}
+
+// setCloneFanOutTargets configures a clone source pod to stream the source to multiple targets
+func setCloneFanOutTargets(pod *corev1.Pod, targets []*corev1.PersistentVolumeClaim) error {
+	var keys []string
+	var uploadTargets []cloneUploadTarget
+	for _, target := range targets {
+		keys = append(keys, fmt.Sprintf("%s/%s", target.Namespace, target.Name))
+		uploadTargets = append(uploadTargets, cloneUploadTarget{
+			URL:      GetUploadServerURL(target.Namespace, target.Name, common.UploadPathSync),
+			OwnerUID: getCloneOwnerID(target),
+		})
+	}
+
+	val, err := json.Marshal(uploadTargets)
+	if err != nil {
+		return err
+	}
+
+	pod.Annotations[AnnCloneFanOutTargets] = strings.Join(keys, ",")
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:  common.ClonerUploadTargets,
+		Value: string(val),
+	})
+
+	return nil
+}
+
+func getCloneOwnerID(targetPvc *corev1.PersistentVolumeClaim) string {
+	pvcOwner := metav1.GetControllerOf(targetPvc)
+	if pvcOwner != nil && pvcOwner.Kind == "DataVolume" {
+		return string(pvcOwner.UID)
+	}
+	return targetPvc.Annotations[cc.AnnOwnerUID]
+}
+
+func isCloneFanOutTarget(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Annotations[cc.AnnCloneFanOutGroup] != "" &&
+		pvc.Annotations[cc.AnnCloneSourcePod] == cc.CreateCloneFanOutSourcePodName(pvc)
+}
+
+// cloneFanOutCompatible returns true if the source pod of one target can populate the other
+func cloneFanOutCompatible(pvc1, pvc2 *corev1.PersistentVolumeClaim) bool {
+	for _, ann := range []string{cc.AnnPreallocationRequested, cc.AnnCloneIncludePaths, cc.AnnCloneExcludePaths} {
+		if pvc1.Annotations[ann] != pvc2.Annotations[ann] {
+			return false
+		}
+	}
+	return true
+}
+
+// cloneFanOutPodServesTarget returns false if the source pod is a fan-out pod that was not
+// created for the target, or that reported the target as failed
+func cloneFanOutPodServesTarget(sourcePod *corev1.Pod, pvc *corev1.PersistentVolumeClaim) bool {
+	fanOutTargets, ok := sourcePod.Annotations[AnnCloneFanOutTargets]
+	if !ok {
+		return true
+	}
+	if !sets.New(strings.Split(fanOutTargets, ",")...).Has(fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name)) {
+		return false
+	}
+	for _, status := range sourcePod.Status.ContainerStatuses {
+		if status.State.Terminated == nil || !strings.HasPrefix(status.State.Terminated.Message, "{") {
+			// no target failed
+			continue
+		}
+		result, ok := util.ParseCloneSourceResult(status.State.Terminated.Message)
+		if ok && sets.New(result.FailedTargets...).Has(getCloneOwnerID(pvc)) {
+			return false
+		}
+		if !ok || result.Truncated {
+			// the failed targets are unknown, only an upload which completed is known to be populated
+			return podSucceededFromPVC(pvc)
+		}
+	}
+	return true
+}
+
 // ParseCloneRequestAnnotation parses the clone request annotation
 func ParseCloneRequestAnnotation(pvc *corev1.PersistentVolumeClaim) (exists bool, namespace, name string) {
 	var ann string
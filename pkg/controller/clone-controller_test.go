@@ -32,6 +32,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -41,6 +43,7 @@ import (
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 	"kubevirt.io/containerized-data-importer/pkg/token"
 	"kubevirt.io/containerized-data-importer/pkg/util/cert/fetcher"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 )
 
 var (
@@ -296,6 +299,122 @@ var _ = Describe("Clone controller reconcile loop", func() {
 		}),
 	)
 
+	It("Should wait for all targets of the clone fan-out group before creating the source pod", func() {
+		testPvc := createFanOutTargetPvc("target1", "2")
+		reconciler = createCloneReconciler(testPvc, cc.CreatePvc("source", "default", map[string]string{}, nil))
+		result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "target1", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).ToNot(BeZero())
+		sourcePod, err := reconciler.findCloneSourcePod(testPvc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sourcePod).To(BeNil())
+	})
+
+	It("Should create a single source pod for the clone fan-out group", func() {
+		target1 := createFanOutTargetPvc("target1", "2")
+		target2 := createFanOutTargetPvc("target2", "2")
+		reconciler = createCloneReconciler(target1, target2, cc.CreatePvc("source", "default", map[string]string{}, nil))
+		for _, name := range []string{"target1", "target2"} {
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		podList := &corev1.PodList{}
+		Expect(reconciler.client.List(context.TODO(), podList)).To(Succeed())
+		Expect(podList.Items).To(HaveLen(1))
+		sourcePod := &podList.Items[0]
+		Expect(sourcePod.Name).To(Equal(cc.CreateCloneFanOutSourcePodName(target1)))
+		Expect(sourcePod.Annotations[AnnCloneFanOutTargets]).To(Equal("default/target1,default/target2"))
+		Expect(sourcePod.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+			Name: common.ClonerUploadTargets,
+			Value: fmt.Sprintf(`[{"url":%q,"ownerUID":"uid-target1"},{"url":%q,"ownerUID":"uid-target2"}]`,
+				GetUploadServerURL("default", "target1", common.UploadPathSync), GetUploadServerURL("default", "target2", common.UploadPathSync)),
+		}))
+	})
+
+	It("Should clone a failed clone fan-out target with a dedicated source pod", func() {
+		target1 := createFanOutTargetPvc("target1", "2")
+		target2 := createFanOutTargetPvc("target2", "2")
+		sourcePod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cc.CreateCloneFanOutSourcePodName(target1),
+				Namespace: "default",
+				Labels: map[string]string{
+					cc.CloneUniqueID: cc.CreateCloneFanOutSourcePodName(target1),
+				},
+				Annotations: map[string]string{
+					AnnCloneFanOutTargets: "default/target1,default/target2",
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: `{"message":"Clone Complete","failedCount":1,"failedTargets":["uid-target2"]}`,
+							},
+						},
+					},
+				},
+			},
+		}
+		reconciler = createCloneReconciler(target1, target2, cc.CreatePvc("source", "default", map[string]string{}, nil), sourcePod)
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "target2", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "target2", Namespace: "default"}, target2)).To(Succeed())
+		Expect(target2.Annotations[cc.AnnCloneSourcePod]).To(Equal(cc.CreateCloneSourcePodName(target2)))
+		By("Keeping the fan-out source pod for the other target")
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: sourcePod.Name, Namespace: "default"}, &corev1.Pod{})).To(Succeed())
+		By("Not leaving the fan-out for a successful target")
+		_, err = reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "target1", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "target1", Namespace: "default"}, target1)).To(Succeed())
+		Expect(target1.Annotations[cc.AnnCloneSourcePod]).To(Equal(sourcePod.Name))
+	})
+
+	DescribeTable("Should clone all clone fan-out targets with dedicated source pods when the failed targets are unknown", func(message string) {
+		target1 := createFanOutTargetPvc("target1", "2")
+		target2 := createFanOutTargetPvc("target2", "2")
+		target2.Annotations[cc.AnnPodPhase] = string(corev1.PodSucceeded)
+		sourcePod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cc.CreateCloneFanOutSourcePodName(target1),
+				Namespace: "default",
+				Labels: map[string]string{
+					cc.CloneUniqueID: cc.CreateCloneFanOutSourcePodName(target1),
+				},
+				Annotations: map[string]string{
+					AnnCloneFanOutTargets: "default/target1,default/target2",
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: message,
+							},
+						},
+					},
+				},
+			},
+		}
+		reconciler = createCloneReconciler(target1, target2, cc.CreatePvc("source", "default", map[string]string{}, nil), sourcePod)
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "target1", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "target1", Namespace: "default"}, target1)).To(Succeed())
+		Expect(target1.Annotations[cc.AnnCloneSourcePod]).To(Equal(cc.CreateCloneSourcePodName(target1)))
+		By("Not leaving the fan-out for a target whose upload completed")
+		_, err = reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "target2", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "target2", Namespace: "default"}, target2)).To(Succeed())
+		Expect(target2.Annotations[cc.AnnCloneSourcePod]).To(Equal(sourcePod.Name))
+	},
+		Entry("with a cut off result", `{"message":"Clone Complete","failedCount":2,"failedTargets":["uid-ta`),
+		Entry("with a truncated result", `{"message":"Clone Complete","failedCount":2,"truncated":true}`),
+	)
+
 	It("Should error with missing upload client name annotation if none provided", func() {
 		testPvc := cc.CreatePvc("testPvc1", "default", map[string]string{
 			cc.AnnCloneRequest: "default/source", cc.AnnPodReady: "true", cc.AnnCloneToken: "foobaz", cc.AnnCloneSourcePod: "default-testPvc1-source-pod"}, nil)
@@ -574,6 +693,139 @@ var _ = Describe("CloneSourcePodName", func() {
 	})
 })
 
+var _ = Describe("CloneFanOutSourcePodName", func() {
+	It("Should be shared by the targets of a fan-out group", func() {
+		pvc1 := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnCloneRequest: "default/test", cc.AnnCloneFanOutGroup: "vms"}, nil)
+		pvc2 := cc.CreatePvc("testPvc2", "default", map[string]string{cc.AnnCloneRequest: "default/test", cc.AnnCloneFanOutGroup: "vms"}, nil)
+		otherGroup := cc.CreatePvc("testPvc3", "default", map[string]string{cc.AnnCloneRequest: "default/test", cc.AnnCloneFanOutGroup: "other"}, nil)
+		otherSource := cc.CreatePvc("testPvc4", "default", map[string]string{cc.AnnCloneRequest: "default/test2", cc.AnnCloneFanOutGroup: "vms"}, nil)
+		Expect(cc.CreateCloneFanOutSourcePodName(pvc1)).To(Equal(cc.CreateCloneFanOutSourcePodName(pvc2)))
+		Expect(cc.CreateCloneFanOutSourcePodName(pvc1)).ToNot(Equal(cc.CreateCloneFanOutSourcePodName(otherGroup)))
+		Expect(cc.CreateCloneFanOutSourcePodName(pvc1)).ToNot(Equal(cc.CreateCloneFanOutSourcePodName(otherSource)))
+	})
+})
+
+var _ = Describe("MakeCloneSourcePodSpec", func() {
+	It("Should pass the path filter to a filesystem source pod", func() {
+		targetPvc := cc.CreatePvc("target", "default", map[string]string{
+			cc.AnnCloneRequest:      "default/source",
+			cc.AnnCloneSourcePod:    "default-target-source-pod",
+			cc.AnnCloneIncludePaths: `["datasets"]`,
+			cc.AnnCloneExcludePaths: `["*.tmp"]`,
+		}, nil)
+		sourcePvc := cc.CreatePvc("source", "default", map[string]string{}, nil)
+		pod := MakeCloneSourcePodSpec(corev1.PersistentVolumeFilesystem, testImage, "Always", "default/target", nil, []byte("ca"), targetPvc, sourcePvc, nil, &sdkapi.NodePlacement{})
+		Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: common.ClonerIncludePaths, Value: `["datasets"]`},
+			corev1.EnvVar{Name: common.ClonerExcludePaths, Value: `["*.tmp"]`},
+		))
+	})
+
+	It("Should only send the disk image of a filesystem source to a block target", func() {
+		targetPvc := cc.CreatePvc("target", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-target-source-pod",
+		}, nil)
+		volumeMode := corev1.PersistentVolumeBlock
+		targetPvc.Spec.VolumeMode = &volumeMode
+		sourcePvc := cc.CreatePvc("source", "default", map[string]string{}, nil)
+		pod := MakeCloneSourcePodSpec(corev1.PersistentVolumeFilesystem, testImage, "Always", "default/target", nil, []byte("ca"), targetPvc, sourcePvc, nil, &sdkapi.NodePlacement{})
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: common.ClonerIncludePaths, Value: `["disk.img"]`}))
+	})
+
+	It("Should expose the bandwidth limit annotation to the cloner", func() {
+		targetPvc := cc.CreatePvc("target", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-target-source-pod",
+		}, nil)
+		sourcePvc := cc.CreatePvc("source", "default", map[string]string{}, nil)
+		pod := MakeCloneSourcePodSpec(corev1.PersistentVolumeFilesystem, testImage, "Always", "default/target", nil, []byte("ca"), targetPvc, sourcePvc, nil, &sdkapi.NodePlacement{})
+		Expect(pod.Spec.Volumes).To(HaveLen(2))
+		Expect(pod.Spec.Volumes[1].Name).To(Equal(CloneLimitsVolName))
+		Expect(pod.Spec.Volumes[1].DownwardAPI).ToNot(BeNil())
+		Expect(pod.Spec.Volumes[1].DownwardAPI.Items[0].Path).To(Equal(common.ClonerBandwidthLimitFile))
+		Expect(pod.Spec.Volumes[1].DownwardAPI.Items[0].FieldRef.FieldPath).To(Equal("metadata.annotations['" + AnnCloneBandwidthLimit + "']"))
+		Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      CloneLimitsVolName,
+			MountPath: common.ClonerBandwidthLimitDir,
+			ReadOnly:  true,
+		}))
+	})
+})
+
+var _ = Describe("Clone bandwidth limit", func() {
+	var (
+		reconciler *CloneReconciler
+	)
+	AfterEach(func() {
+		if reconciler != nil {
+			close(reconciler.recorder.(*record.FakeRecorder).Events)
+			reconciler = nil
+		}
+	})
+
+	It("Should set the bandwidth limit of the source storage class on the source pod", func() {
+		testPvc := cc.CreatePvc("testPvc1", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-testPvc1-source-pod",
+		}, nil)
+		sourcePvc := cc.CreatePvcInStorageClass("source", "default", pointer.String("sc"), nil, nil, corev1.ClaimBound)
+		storageProfile := &cdiv1.StorageProfile{ObjectMeta: metav1.ObjectMeta{Name: "sc"}}
+		storageProfile.Status.CloneBandwidthLimit = resource.NewQuantity(1024*1024, resource.BinarySI)
+		reconciler = createCloneReconciler(testPvc, sourcePvc, cc.CreateStorageClass("sc", nil), storageProfile)
+		pod, err := reconciler.CreateCloneSourcePod(testImage, "Always", testPvc, nil, cloneLog)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Annotations[AnnCloneBandwidthLimit]).To(Equal("1048576"))
+	})
+
+	It("Should update the bandwidth limit of a running source pod", func() {
+		testPvc := cc.CreatePvc("testPvc1", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-testPvc1-source-pod",
+		}, nil)
+		sourcePvc := cc.CreatePvcInStorageClass("source", "default", pointer.String("sc"), nil, nil, corev1.ClaimBound)
+		storageProfile := &cdiv1.StorageProfile{ObjectMeta: metav1.ObjectMeta{Name: "sc"}}
+		reconciler = createCloneReconciler(testPvc, sourcePvc, cc.CreateStorageClass("sc", nil), storageProfile)
+		pod, err := reconciler.CreateCloneSourcePod(testImage, "Always", testPvc, nil, cloneLog)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Annotations).ToNot(HaveKey(AnnCloneBandwidthLimit))
+		pod.Status.Phase = corev1.PodRunning
+		Expect(reconciler.client.Status().Update(context.TODO(), pod)).To(Succeed())
+
+		By("Setting a limit on the storage profile")
+		storageProfile.Status.CloneBandwidthLimit = resource.NewQuantity(2048, resource.BinarySI)
+		Expect(reconciler.client.Update(context.TODO(), storageProfile)).To(Succeed())
+		Expect(reconciler.updateSourcePodBandwidthLimit(context.TODO(), pod, testPvc, cloneLog)).To(Succeed())
+		Expect(reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+		Expect(pod.Annotations[AnnCloneBandwidthLimit]).To(Equal("2048"))
+
+		By("Removing the limit")
+		storageProfile.Status.CloneBandwidthLimit = nil
+		Expect(reconciler.client.Update(context.TODO(), storageProfile)).To(Succeed())
+		Expect(reconciler.updateSourcePodBandwidthLimit(context.TODO(), pod, testPvc, cloneLog)).To(Succeed())
+		Expect(reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+		Expect(pod.Annotations).ToNot(HaveKey(AnnCloneBandwidthLimit))
+	})
+})
+
+var _ = Describe("Clone targets in progress", func() {
+	It("Should only return clone targets with a source pod", func() {
+		inProgress := cc.CreatePvc("in-progress", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-in-progress-source-pod",
+		}, nil)
+		done := cc.CreatePvc("done", "default", map[string]string{
+			cc.AnnCloneRequest:   "default/source",
+			cc.AnnCloneSourcePod: "default-done-source-pod",
+			cc.AnnCloneOf:        "true",
+		}, nil)
+		other := cc.CreatePvc("other", "default", nil, nil)
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(inProgress, done, other).
+			WithIndex(&corev1.PersistentVolumeClaim{}, cloneTargetInProgressField, indexCloneTargetInProgress).Build()
+		Expect(getCloneTargetsInProgress(cl)).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "in-progress"}}))
+	})
+})
+
 var _ = Describe("Update PVC", func() {
 	var (
 		reconciler *CloneReconciler
@@ -747,7 +999,8 @@ func createCloneReconciler(objects ...runtime.Object) *CloneReconciler {
 
 	rec := record.NewFakeRecorder(1)
 	// Create a fake client to mock API calls.
-	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithIndex(&corev1.PersistentVolumeClaim{}, cloneFanOutSourcePodField, indexCloneFanOutSourcePod).Build()
 
 	// Create a ReconcileMemcached object with the scheme and fake client.
 	return &CloneReconciler{
@@ -770,6 +1023,19 @@ func createCloneReconciler(objects ...runtime.Object) *CloneReconciler {
 	}
 }
 
+func createFanOutTargetPvc(name, groupSize string) *corev1.PersistentVolumeClaim {
+	pvc := cc.CreatePvc(name, "default", map[string]string{
+		cc.AnnCloneRequest:         "default/source",
+		cc.AnnPodReady:             "true",
+		cc.AnnOwnerUID:             "uid-" + name,
+		AnnUploadClientName:        "uploadclient",
+		cc.AnnCloneFanOutGroup:     "vms",
+		cc.AnnCloneFanOutGroupSize: groupSize,
+	}, nil)
+	pvc.Annotations[cc.AnnCloneSourcePod] = cc.CreateCloneFanOutSourcePodName(pvc)
+	return pvc
+}
+
 func createSourcePod(pvc *corev1.PersistentVolumeClaim, pvcUID string) *corev1.Pod {
 	_, _, sourcePvcName := ParseCloneRequestAnnotation(pvc)
 	podName := fmt.Sprintf("%s-%s-", common.ClonerSourcePodName, sourcePvcName)
@@ -69,12 +69,14 @@ type IsSourceClaimReadyArgs struct {
 	Target          client.Object
 	SourceNamespace string
 	SourceName      string
-	Client          client.Client
-	Log             logr.Logger
-	Recorder        record.EventRecorder
+	// AllowInUse skips the check for pods using the source PVC
+	AllowInUse bool
+	Client     client.Client
+	Log        logr.Logger
+	Recorder   record.EventRecorder
 }
 
-// IsSourceClaimReady checks that PVC exists, is bound, and is not being used
+// IsSourceClaimReady checks that PVC exists, is bound, and is not being used (unless AllowInUse is set)
 func IsSourceClaimReady(ctx context.Context, args *IsSourceClaimReadyArgs) (bool, error) {
 	claim := &corev1.PersistentVolumeClaim{}
 	exists, err := getResource(ctx, args.Client, args.SourceNamespace, args.SourceName, claim)
@@ -90,6 +92,10 @@ func IsSourceClaimReady(ctx context.Context, args *IsSourceClaimReadyArgs) (bool
 		return false, nil
 	}
 
+	if args.AllowInUse {
+		return cdiv1.IsPopulated(claim, dataVolumeGetter(ctx, args.Client))
+	}
+
 	pods, err := cc.GetPodsUsingPVCs(ctx, args.Client, args.SourceNamespace, sets.New(args.SourceName), true)
 	if err != nil {
 		return false, err
@@ -267,6 +273,21 @@ func GetCompatibleVolumeSnapshotClass(ctx context.Context, c client.Client, log
 	return cc.GetVolumeSnapshotClass(context.TODO(), c, targetClaim, *driver, snapshotClassName, log, recorder)
 }
 
+// IsSourceClaimInUse returns true if the source PVC is being written to by a pod
+func IsSourceClaimInUse(ctx context.Context, c client.Client, namespace, name string) (bool, error) {
+	pods, err := cc.GetPodsUsingPVCs(ctx, c, namespace, sets.New(name), true)
+	if err != nil {
+		return false, err
+	}
+
+	return len(pods) > 0, nil
+}
+
+// CloneSourceInUseSnapshotRequested returns true if the target claim allows cloning an in use source from a snapshot
+func CloneSourceInUseSnapshotRequested(targetClaim *corev1.PersistentVolumeClaim) bool {
+	return targetClaim.Annotations[cc.AnnCloneSourceInUseSnapshot] == "true"
+}
+
 // SameVolumeMode returns true if all pvcs have the same volume mode
 func SameVolumeMode(pvc1 *corev1.PersistentVolumeClaim, others ...*corev1.PersistentVolumeClaim) bool {
 	vm := util.ResolveVolumeMode(pvc1.Spec.VolumeMode)
@@ -68,6 +68,18 @@ const (
 
 	// MessageIncompatibleProvisioners reports that the provisioners are incompatible (message)
 	MessageIncompatibleProvisioners = "Provisioners are incompatible"
+
+	// ClonePathFilter reports that a path filtered clone requires host assisted clone (reason)
+	ClonePathFilter = "ClonePathFilter"
+
+	// MessageClonePathFilter reports that a path filtered clone requires host assisted clone (message)
+	MessageClonePathFilter = "Clone with a path filter requires host assisted clone"
+
+	// CloneFromSourceInUseSnapshot reports that an in use source is cloned from a temporary snapshot (reason)
+	CloneFromSourceInUseSnapshot = "CloneFromSourceInUseSnapshot"
+
+	// MessageCloneFromSourceInUseSnapshot reports that an in use source is cloned from a temporary snapshot (message)
+	MessageCloneFromSourceInUseSnapshot = "Source PVC %s is in use, cloning from a crash-consistent snapshot"
 )
 
 // Planner plans clone operations
@@ -315,6 +327,11 @@ func (p *Planner) computeStrategyForSourcePVC(ctx context.Context, args *ChooseS
 		return nil, err
 	}
 
+	if cc.HasClonePathFilter(args.TargetClaim) {
+		p.fallbackToHostAssisted(args.TargetClaim, res, ClonePathFilter, MessageClonePathFilter)
+		return res, nil
+	}
+
 	strategy := cdiv1.CloneStrategySnapshot
 	cs, err := GetGlobalCloneStrategyOverride(ctx, p.Client)
 	if err != nil {
@@ -502,6 +519,17 @@ func (p *Planner) fallbackToHostAssisted(targetClaim *corev1.PersistentVolumeCla
 }
 
 func (p *Planner) planHostAssistedFromPVC(ctx context.Context, args *PlanArgs) ([]Phase, error) {
+	vsc, err := p.getSourceInUseSnapshotClass(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if vsc != nil {
+		args.Log.V(3).Info("Planning host assisted clone from temporary snapshot of in use PVC")
+
+		return p.planHostAssistedFromSourceInUseSnapshot(ctx, args, *vsc)
+	}
+
 	desiredClaim := createDesiredClaim(args.DataSource.Namespace, args.TargetClaim)
 
 	hcp := &HostClonePhase{
@@ -535,8 +563,12 @@ func (p *Planner) planHostAssistedFromPVC(ctx context.Context, args *PlanArgs) (
 }
 
 func (p *Planner) planHostAssistedFromSnapshot(ctx context.Context, args *PlanArgs) ([]Phase, error) {
+	return p.planHostAssistedFromSnapshotName(ctx, args, args.DataSource.Spec.Source.Name)
+}
+
+func (p *Planner) planHostAssistedFromSnapshotName(ctx context.Context, args *PlanArgs, snapshotName string) ([]Phase, error) {
 	sourceSnapshot := &snapshotv1.VolumeSnapshot{}
-	exists, err := getResource(ctx, p.Client, args.DataSource.Namespace, args.DataSource.Spec.Source.Name, sourceSnapshot)
+	exists, err := getResource(ctx, p.Client, args.DataSource.Namespace, snapshotName, sourceSnapshot)
 	if err != nil {
 		return nil, err
 	}
@@ -551,7 +583,7 @@ func (p *Planner) planHostAssistedFromSnapshot(ctx context.Context, args *PlanAr
 	cfsp := &SnapshotClonePhase{
 		Owner:          args.TargetClaim,
 		Namespace:      args.DataSource.Namespace,
-		SourceName:     args.DataSource.Spec.Source.Name,
+		SourceName:     snapshotName,
 		DesiredClaim:   sourceClaimForDumbClone,
 		OwnershipLabel: p.OwnershipLabel,
 		Client:         p.Client,
@@ -602,6 +634,40 @@ func (p *Planner) planHostAssistedFromSnapshot(ctx context.Context, args *PlanAr
 	return []Phase{cfsp, pcp, hcp, rp}, nil
 }
 
+func (p *Planner) planHostAssistedFromSourceInUseSnapshot(ctx context.Context, args *PlanArgs, vsc string) ([]Phase, error) {
+	sp := &SnapshotPhase{
+		Owner:               args.TargetClaim,
+		SourceNamespace:     args.DataSource.Namespace,
+		SourceName:          args.DataSource.Spec.Source.Name,
+		TargetName:          tempSnapshotName(args.TargetClaim),
+		VolumeSnapshotClass: vsc,
+		OwnershipLabel:      p.OwnershipLabel,
+		AllowSourceInUse:    true,
+		WaitForReady:        true,
+		Client:              p.Client,
+		Log:                 args.Log,
+		Recorder:            p.Recorder,
+	}
+
+	snapshot := &snapshotv1.VolumeSnapshot{}
+	exists, err := getResource(ctx, p.Client, sp.SourceNamespace, sp.TargetName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rest of the plan needs the snapshot restore size and content
+	if !exists || !cc.IsSnapshotReady(snapshot) {
+		return []Phase{sp}, nil
+	}
+
+	phases, err := p.planHostAssistedFromSnapshotName(ctx, args, sp.TargetName)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Phase{sp}, phases...), nil
+}
+
 func (p *Planner) planSmartCloneFromSnapshot(ctx context.Context, args *PlanArgs) ([]Phase, error) {
 	sourceSnapshot := &snapshotv1.VolumeSnapshot{}
 	exists, err := getResource(ctx, p.Client, args.DataSource.Namespace, args.DataSource.Spec.Source.Name, sourceSnapshot)
@@ -673,9 +739,10 @@ func (p *Planner) planSnapshotFromPVC(ctx context.Context, args *PlanArgs) ([]Ph
 		Owner:               args.TargetClaim,
 		SourceNamespace:     args.DataSource.Namespace,
 		SourceName:          args.DataSource.Spec.Source.Name,
-		TargetName:          fmt.Sprintf("tmp-snapshot-%s", string(args.TargetClaim.UID)),
+		TargetName:          tempSnapshotName(args.TargetClaim),
 		VolumeSnapshotClass: *vsc,
 		OwnershipLabel:      p.OwnershipLabel,
+		AllowSourceInUse:    CloneSourceInUseSnapshotRequested(args.TargetClaim),
 		Client:              p.Client,
 		Log:                 args.Log,
 		Recorder:            p.Recorder,
@@ -719,6 +786,17 @@ func (p *Planner) planSnapshotFromPVC(ctx context.Context, args *PlanArgs) ([]Ph
 }
 
 func (p *Planner) planCSIClone(ctx context.Context, args *PlanArgs) ([]Phase, error) {
+	vsc, err := p.getSourceInUseSnapshotClass(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if vsc != nil {
+		args.Log.V(3).Info("Planning snapshot clone from in use PVC")
+
+		return p.planSnapshotFromPVC(ctx, args)
+	}
+
 	desiredClaim := createDesiredClaim(args.DataSource.Namespace, args.TargetClaim)
 	cp := &CSIClonePhase{
 		Owner:          args.TargetClaim,
@@ -756,6 +834,65 @@ func (p *Planner) planCSIClone(ctx context.Context, args *PlanArgs) ([]Phase, er
 	return []Phase{cp, pcp, rp}, nil
 }
 
+// getSourceInUseSnapshotClass returns the volumesnapshotclass to use when an in use source
+// should be cloned from a temporary snapshot, nil otherwise
+func (p *Planner) getSourceInUseSnapshotClass(ctx context.Context, args *PlanArgs) (*string, error) {
+	if !CloneSourceInUseSnapshotRequested(args.TargetClaim) {
+		return nil, nil
+	}
+
+	sourceClaim := &corev1.PersistentVolumeClaim{}
+	exists, err := getResource(ctx, p.Client, args.DataSource.Namespace, args.DataSource.Spec.Source.Name, sourceClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("source claim does not exist")
+	}
+
+	// once the temporary snapshot is taken keep using it, even if the source is no longer in use
+	snapshotExists, err := getResource(ctx, p.Client, args.DataSource.Namespace, tempSnapshotName(args.TargetClaim), &snapshotv1.VolumeSnapshot{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !snapshotExists {
+		inUse, err := IsSourceClaimInUse(ctx, p.Client, sourceClaim.Namespace, sourceClaim.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !inUse {
+			return nil, nil
+		}
+	}
+
+	vsc, err := GetCompatibleVolumeSnapshotClass(ctx, p.Client, args.Log, p.Recorder, sourceClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	if vsc == nil {
+		args.Log.V(3).Info("No volumesnapshotclass for in use source, waiting for it to be released")
+		return nil, nil
+	}
+
+	if err := p.watchSnapshots(ctx, args.Log); err != nil {
+		return nil, err
+	}
+
+	if !snapshotExists {
+		p.Recorder.Eventf(args.TargetClaim, corev1.EventTypeNormal, CloneFromSourceInUseSnapshot, MessageCloneFromSourceInUseSnapshot, sourceClaim.Name)
+	}
+
+	return vsc, nil
+}
+
+func tempSnapshotName(targetClaim *corev1.PersistentVolumeClaim) string {
+	return fmt.Sprintf("tmp-snapshot-%s", string(targetClaim.UID))
+}
+
 func createDesiredClaim(namespace string, targetClaim *corev1.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
 	targetCpy := targetClaim.DeepCopy()
 	desiredClaim := &corev1.PersistentVolumeClaim{
@@ -368,6 +368,25 @@ var _ = Describe("Planner test", func() {
 				Expect(csr.Strategy).To(Equal(cdiv1.CloneStrategySnapshot))
 			})
 
+			It("should return host assisted with path filter", func() {
+				target := createTargetClaim()
+				target.Annotations = map[string]string{
+					cc.AnnCloneIncludePaths: `["datasets"]`,
+				}
+				args := &ChooseStrategyArgs{
+					TargetClaim: target,
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(createStorageClass(), createSourceClaim(), createVolumeSnapshotClass(), createSourceVolume())
+				csr, err := planner.ChooseStrategy(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(csr).ToNot(BeNil())
+				Expect(csr.Strategy).To(Equal(cdiv1.CloneStrategyHostAssisted))
+				Expect(*csr.FallbackReason).To(Equal(MessageClonePathFilter))
+				expectEvent(planner, ClonePathFilter)
+			})
+
 			It("should return snapshot with volumesnapshotclass (no source vol)", func() {
 				args := &ChooseStrategyArgs{
 					TargetClaim: createTargetClaim(),
@@ -846,6 +865,161 @@ var _ = Describe("Planner test", func() {
 			validateRebindPhase(planner, args, plan[3])
 		})
 
+		Context("with source in use", func() {
+			createSourcePod := func() *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "vm-pod",
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "compute",
+								VolumeMounts: []corev1.VolumeMount{
+									{
+										Name:      "disk",
+										MountPath: "/disk",
+									},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "disk",
+								VolumeSource: corev1.VolumeSource{
+									PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+										ClaimName: sourceName,
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+
+			createInUseTargetClaim := func() *corev1.PersistentVolumeClaim {
+				target := createTargetClaim()
+				target.Annotations = map[string]string{
+					cc.AnnCloneSourceInUseSnapshot: "true",
+				}
+				return target
+			}
+
+			validateInUseSnapshotPhase := func(planner *Planner, args *PlanArgs, p Phase) {
+				validateSnapshotPhase(planner, args, p)
+				sp := p.(*SnapshotPhase)
+				Expect(sp.AllowSourceInUse).To(BeTrue())
+			}
+
+			It("should plan host assisted from source if not requested", func() {
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategyHostAssisted,
+					TargetClaim: createTargetClaim(),
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(cdiConfig, createStorageClass(), createVolumeSnapshotClass(), createSourceClaim(), createSourceVolume(), createSourcePod())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(2))
+				validateHostClonePhase(planner, args, plan[0])
+				validateRebindPhase(planner, args, plan[1])
+			})
+
+			It("should plan host assisted from source if no volumesnapshotclass", func() {
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategyHostAssisted,
+					TargetClaim: createInUseTargetClaim(),
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(cdiConfig, createStorageClass(), createSourceClaim(), createSourceVolume(), createSourcePod())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(2))
+				validateHostClonePhase(planner, args, plan[0])
+				validateRebindPhase(planner, args, plan[1])
+			})
+
+			It("should plan temporary snapshot for host assisted", func() {
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategyHostAssisted,
+					TargetClaim: createInUseTargetClaim(),
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(cdiConfig, createStorageClass(), createVolumeSnapshotClass(), createSourceClaim(), createSourceVolume(), createSourcePod())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(1))
+				validateInUseSnapshotPhase(planner, args, plan[0])
+				Expect(plan[0].(*SnapshotPhase).WaitForReady).To(BeTrue())
+				expectEvent(planner, CloneFromSourceInUseSnapshot)
+			})
+
+			It("should plan host assisted from ready temporary snapshot", func() {
+				target := createInUseTargetClaim()
+				snapshot := createSourceSnapshot(tmpSnapshotName(target.UID), "test-snapshot-content-name", "vsc")
+				snapshot.Status.ReadyToUse = pointer.Bool(true)
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategyHostAssisted,
+					TargetClaim: target,
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				// source no longer in use, keep using the snapshot
+				planner = createPlanner(cdiConfig, createStorageClass(), createVolumeSnapshotClass(), createSourceClaim(),
+					createSourceVolume(), snapshot, createDefaultVolumeSnapshotContent())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(5))
+				validateInUseSnapshotPhase(planner, args, plan[0])
+				scp := plan[1].(*SnapshotClonePhase)
+				Expect(scp.SourceName).To(Equal(tmpSnapshotName(target.UID)))
+				Expect(scp.DesiredClaim.Name).To(Equal(tmpSourceClaimName(target.UID)))
+				pcp := plan[2].(*PrepClaimPhase)
+				Expect(pcp.DesiredClaim.Name).To(Equal(tmpSourceClaimName(target.UID)))
+				hcp := plan[3].(*HostClonePhase)
+				Expect(hcp.SourceName).To(Equal(tmpSourceClaimName(target.UID)))
+				validateRebindPhase(planner, args, plan[4])
+			})
+
+			It("should allow in use source for snapshot", func() {
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategySnapshot,
+					TargetClaim: createInUseTargetClaim(),
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(cdiConfig, createStorageClass(), createVolumeSnapshotClass(), createSourceClaim(), createSourcePod())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(4))
+				validateInUseSnapshotPhase(planner, args, plan[0])
+				Expect(plan[0].(*SnapshotPhase).WaitForReady).To(BeFalse())
+				validateSnapshotClonePhase(planner, args, plan[1])
+			})
+
+			It("should plan snapshot instead of csi-clone", func() {
+				args := &PlanArgs{
+					Strategy:    cdiv1.CloneStrategyCsiClone,
+					TargetClaim: createInUseTargetClaim(),
+					DataSource:  createPVCDataSource(),
+					Log:         log,
+				}
+				planner = createPlanner(cdiConfig, createStorageClass(), createVolumeSnapshotClass(), createSourceClaim(), createSourceVolume(), createSourcePod())
+				plan, err := planner.Plan(context.Background(), args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan).To(HaveLen(4))
+				validateInUseSnapshotPhase(planner, args, plan[0])
+				validateSnapshotClonePhase(planner, args, plan[1])
+				validatePrepClaimPhase(planner, args, plan[2])
+				validateRebindPhase(planner, args, plan[3])
+				expectEvent(planner, CloneFromSourceInUseSnapshot)
+			})
+		})
+
 		It("should fail planning host-assisted clone from snapshot when no valid storage class for source PVC is found", func() {
 			source := createSourceSnapshot(sourceName, "test-snapshot-content-name", "vsc")
 			target := createTargetClaim()
@@ -10,6 +10,8 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 )
 
 // SnapshotPhaseName is the name of the snapshot phase
@@ -23,6 +25,8 @@ type SnapshotPhase struct {
 	TargetName          string
 	VolumeSnapshotClass string
 	OwnershipLabel      string
+	AllowSourceInUse    bool
+	WaitForReady        bool
 	Client              client.Client
 	Log                 logr.Logger
 	Recorder            record.EventRecorder
@@ -48,6 +52,7 @@ func (p *SnapshotPhase) Reconcile(ctx context.Context) (*reconcile.Result, error
 			Target:          p.Owner,
 			SourceNamespace: p.SourceNamespace,
 			SourceName:      p.SourceName,
+			AllowInUse:      p.AllowSourceInUse,
 			Client:          p.Client,
 			Log:             p.Log,
 			Recorder:        p.Recorder,
@@ -74,6 +79,10 @@ func (p *SnapshotPhase) Reconcile(ctx context.Context) (*reconcile.Result, error
 		return &reconcile.Result{}, nil
 	}
 
+	if p.WaitForReady && !cc.IsSnapshotReady(snapshot) {
+		return &reconcile.Result{}, nil
+	}
+
 	return nil, nil
 }
 
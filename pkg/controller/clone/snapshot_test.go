@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -138,6 +139,47 @@ var _ = Describe("SnapshotPhase test", func() {
 			Expect(*snapshot.Spec.VolumeSnapshotClassName).To(Equal(snapClass))
 		})
 
+		It("should create snapshot of in use source if allowed", func() {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "vm-pod",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "compute",
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "disk",
+									MountPath: "/disk",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "disk",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: sourceName,
+								},
+							},
+						},
+					},
+				},
+			}
+			p := createSnapshotPhase(sourceClaim(), pod)
+			p.AllowSourceInUse = true
+			result, err := p.Reconcile(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).ToNot(BeNil())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			snapshot := getSnapshot(p)
+			Expect(*snapshot.Spec.Source.PersistentVolumeClaimName).To(Equal(sourceName))
+		})
+
 		Context("with snapshot", func() {
 			createSnapshot := func() *snapshotv1.VolumeSnapshot {
 				return &snapshotv1.VolumeSnapshot{
@@ -173,6 +215,30 @@ var _ = Describe("SnapshotPhase test", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
+
+			It("should wait for snapshot to be ready if requested", func() {
+				t := metav1.Now()
+				s := createSnapshot()
+				s.Status.CreationTime = &t
+				p := createSnapshotPhase(s)
+				p.WaitForReady = true
+				result, err := p.Reconcile(context.Background())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).ToNot(BeNil())
+				Expect(result.RequeueAfter).To(BeZero())
+			})
+
+			It("succeed if snapshot ready and requested", func() {
+				t := metav1.Now()
+				s := createSnapshot()
+				s.Status.CreationTime = &t
+				s.Status.ReadyToUse = pointer.Bool(true)
+				p := createSnapshotPhase(s)
+				p.WaitForReady = true
+				result, err := p.Reconcile(context.Background())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
 		})
 	})
 })
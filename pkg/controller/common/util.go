@@ -20,7 +20,9 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -60,6 +62,7 @@ import (
 	featuregates "kubevirt.io/containerized-data-importer/pkg/feature-gates"
 	"kubevirt.io/containerized-data-importer/pkg/token"
 	"kubevirt.io/containerized-data-importer/pkg/util"
+	"kubevirt.io/containerized-data-importer/pkg/util/naming"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 	runtimecache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -118,6 +121,18 @@ const (
 	AnnPreallocationRequested = AnnAPIGroup + "/storage.preallocation.requested"
 	// AnnPreallocationApplied provides a const for PVC preallocation annotation
 	AnnPreallocationApplied = AnnAPIGroup + "/storage.preallocation"
+	// AnnSparsify asks the importer to deallocate the all-zero blocks of the target once the import is done
+	AnnSparsify = AnnAPIGroup + "/storage.import.sparsify"
+	// AnnSourceChecksum is the checksum of the http source, the import fails if the data doesn't match it
+	AnnSourceChecksum = AnnAPIGroup + "/storage.import.sourceChecksum"
+	// AnnSourceVersion is the digest of the http or s3 source version detected by a DataImportCron poll, the import fails if the source changed
+	AnnSourceVersion = AnnAPIGroup + "/storage.import.sourceVersion"
+	// AnnPreemptible allows the importer pod to be preempted by a higher priority PVC, restarting the import from the beginning
+	AnnPreemptible = AnnAPIGroup + "/storage.import.preemptible"
+	// AnnSparsifyUnmapped is the size of the zero ranges the importer deallocated when sparsifying the target
+	AnnSparsifyUnmapped = AnnAPIGroup + "/storage.import.sparsifyUnmapped"
+	// AnnSparsifyReclaimed is the allocated space the importer reclaimed when sparsifying a target file, unknown for block devices
+	AnnSparsifyReclaimed = AnnAPIGroup + "/storage.import.sparsifyReclaimed"
 
 	// AnnRunningCondition provides a const for the running condition
 	AnnRunningCondition = AnnAPIGroup + "/storage.condition.running"
@@ -149,6 +164,8 @@ const (
 
 	// AnnRequiresScratch provides a const for our PVC requires scratch annotation
 	AnnRequiresScratch = AnnAPIGroup + "/storage.import.requiresScratch"
+	// AnnScratchSizeEstimate is the size in bytes of the scratch space estimated by the importer, to size scratch PVCs
+	AnnScratchSizeEstimate = AnnAPIGroup + "/storage.import.scratchSizeEstimate"
 
 	// AnnContentType provides a const for the PVC content-type
 	AnnContentType = AnnAPIGroup + "/storage.contentType"
@@ -180,6 +197,27 @@ const (
 	AnnExtraHeaders = AnnAPIGroup + "/storage.import.extraHeaders"
 	// AnnSecretExtraHeaders provides a const for our PVC secretExtraHeaders annotation
 	AnnSecretExtraHeaders = AnnAPIGroup + "/storage.import.secretExtraHeaders"
+	// AnnImportSourceIdentity is the hashed identity of a DataVolume import source, used for import deduplication
+	AnnImportSourceIdentity = AnnAPIGroup + "/storage.import.sourceIdentity"
+	// AnnImportDeduplicatedFrom is the clone source of a DataVolume import satisfied by an already imported volume
+	AnnImportDeduplicatedFrom = AnnAPIGroup + "/storage.import.deduplicatedFrom"
+	// AnnRetryPolicy is the JSON retry policy of a DataVolume import
+	AnnRetryPolicy = AnnAPIGroup + "/storage.import.retryPolicy"
+	// AnnDryRun asks to validate a DataVolume import source without creating its PVC
+	AnnDryRun = AnnAPIGroup + "/storage.dryRun"
+	// AnnPreflightResult is the JSON result of a DataVolume dry run
+	AnnPreflightResult = AnnAPIGroup + "/storage.preflightResult"
+	// AnnRetriedPod is the UID of the last failed importer pod counted as a failed import attempt
+	AnnRetriedPod = AnnAPIGroup + "/storage.import.retriedPod"
+	// AnnImportFailed is set on a PVC whose import failed for good according to its retry policy
+	AnnImportFailed = AnnAPIGroup + "/storage.import.failed"
+
+	// AnnWorkerStorageClass is the storage class of the PVC a worker pod writes to, counted by the worker pod limits
+	AnnWorkerStorageClass = AnnAPIGroup + "/storage.worker.storageClass"
+	// AnnWorkerSourceHost is the source host an importer pod reads from, counted by the worker pod limits
+	AnnWorkerSourceHost = AnnAPIGroup + "/storage.worker.sourceHost"
+	// AnnWorkerPVCUID is the UID of the PVC a worker pod was admitted for, marking the pod as counted by the worker pod limits
+	AnnWorkerPVCUID = AnnAPIGroup + "/storage.worker.pvcUID"
 
 	// AnnCloneToken is the annotation containing the clone token
 	AnnCloneToken = AnnAPIGroup + "/storage.clone.token"
@@ -193,6 +231,16 @@ const (
 	AnnCloneType = AnnAPIGroup + "/cloneType"
 	// AnnCloneSourcePod name of the source clone pod
 	AnnCloneSourcePod = "cdi.kubevirt.io/storage.sourceClonePodName"
+	// AnnCloneSourceInUseSnapshot annotation allows cloning a source PVC that is in use from a temporary crash-consistent snapshot
+	AnnCloneSourceInUseSnapshot = AnnAPIGroup + "/storage.clone.sourceInUseSnapshot"
+	// AnnCloneIncludePaths is a JSON list of the source paths copied by a filesystem clone
+	AnnCloneIncludePaths = AnnAPIGroup + "/storage.clone.includePaths"
+	// AnnCloneExcludePaths is a JSON list of the source paths or patterns skipped by a filesystem clone
+	AnnCloneExcludePaths = AnnAPIGroup + "/storage.clone.excludePaths"
+	// AnnCloneFanOutGroup groups host assisted clones of the same source that are populated by a single source pod
+	AnnCloneFanOutGroup = AnnAPIGroup + "/storage.clone.fanOutGroup"
+	// AnnCloneFanOutGroupSize is the number of clone targets in the fan-out group to wait for before reading the source
+	AnnCloneFanOutGroupSize = AnnAPIGroup + "/storage.clone.fanOutGroupSize"
 
 	// AnnUploadRequest marks that a PVC should be made available for upload
 	AnnUploadRequest = AnnAPIGroup + "/storage.upload.target"
@@ -534,6 +582,40 @@ func GetPlatformDefaultStorageClass(ctx context.Context, storageClasses *storage
 	return &defaultClasses[0]
 }
 
+// GetCloneBandwidthLimit returns the rate in bytes per second at which host-assisted clones may read from the source PVC.
+// The limit of the source storage profile takes precedence over the CDIConfig one, 0 means not limited.
+func GetCloneBandwidthLimit(ctx context.Context, c client.Client, sourcePvc *corev1.PersistentVolumeClaim) (int64, error) {
+	sc, err := GetStorageClassByNameWithK8sFallback(ctx, c, sourcePvc.Spec.StorageClassName)
+	if err != nil {
+		return 0, err
+	}
+
+	if sc != nil {
+		storageProfile := &cdiv1.StorageProfile{}
+		if err := c.Get(ctx, types.NamespacedName{Name: sc.Name}, storageProfile); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return 0, err
+			}
+		} else if storageProfile.Status.CloneBandwidthLimit != nil {
+			return storageProfile.Status.CloneBandwidthLimit.Value(), nil
+		}
+	}
+
+	cdiConfig := &cdiv1.CDIConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: common.ConfigName}, cdiConfig); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	if cdiConfig.Spec.CloneBandwidthLimit != nil {
+		return cdiConfig.Spec.CloneBandwidthLimit.Value(), nil
+	}
+
+	return 0, nil
+}
+
 // GetFilesystemOverheadForStorageClass determines the filesystem overhead defined in CDIConfig for the storageClass.
 func GetFilesystemOverheadForStorageClass(ctx context.Context, client client.Client, storageClassName *string) (cdiv1.Percent, error) {
 	cdiConfig := &cdiv1.CDIConfig{}
@@ -999,6 +1081,35 @@ func AddLabel(obj metav1.Object, key, value string) {
 	obj.GetLabels()[key] = value
 }
 
+// AddClonePathFilterAnnotations adds the path filter of a filesystem clone to an object
+func AddClonePathFilterAnnotations(obj metav1.Object, filter *cdiv1.DataVolumeSourcePathFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if len(filter.Include) > 0 {
+		include, err := json.Marshal(filter.Include)
+		if err != nil {
+			return err
+		}
+		AddAnnotation(obj, AnnCloneIncludePaths, string(include))
+	}
+	if len(filter.Exclude) > 0 {
+		exclude, err := json.Marshal(filter.Exclude)
+		if err != nil {
+			return err
+		}
+		AddAnnotation(obj, AnnCloneExcludePaths, string(exclude))
+	}
+	return nil
+}
+
+// HasClonePathFilter returns true if the object requests a path filtered filesystem clone
+func HasClonePathFilter(obj metav1.Object) bool {
+	_, hasInclude := obj.GetAnnotations()[AnnCloneIncludePaths]
+	_, hasExclude := obj.GetAnnotations()[AnnCloneExcludePaths]
+	return hasInclude || hasExclude
+}
+
 // HandleFailedPod handles pod-creation errors and updates the pod's PVC without providing sensitive information
 func HandleFailedPod(err error, podName string, pvc *corev1.PersistentVolumeClaim, recorder record.EventRecorder, c client.Client) error {
 	if err == nil {
@@ -1099,6 +1210,50 @@ func CreateCloneSourcePodName(targetPvc *corev1.PersistentVolumeClaim) string {
 	return string(targetPvc.GetUID()) + common.ClonerSourcePodNameSuffix
 }
 
+// CreateCloneFanOutSourcePodName creates the name of the clone source pod shared by a fan-out group
+func CreateCloneFanOutSourcePodName(targetPvc *corev1.PersistentVolumeClaim) string {
+	key := fmt.Sprintf("%s/%s/%s", targetPvc.Namespace, targetPvc.Annotations[AnnCloneFanOutGroup], targetPvc.Annotations[AnnCloneRequest])
+	hash := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("fan-out-%x", hash[:8]) + common.ClonerSourcePodNameSuffix
+}
+
+// MaxCloneFanOutGroupSize is the largest number of clone targets populated by a single fan-out source pod
+const MaxCloneFanOutGroupSize = 50
+
+// ParseCloneFanOutGroupSize parses the AnnCloneFanOutGroupSize annotation, 0 if not set
+func ParseCloneFanOutGroupSize(annotations map[string]string) (int, error) {
+	val, ok := annotations[AnnCloneFanOutGroupSize]
+	if !ok {
+		return 0, nil
+	}
+	size, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing %s annotation", AnnCloneFanOutGroupSize)
+	}
+	if size < 1 || size > MaxCloneFanOutGroupSize {
+		return 0, errors.Errorf("%s annotation must be between 1 and %d", AnnCloneFanOutGroupSize, MaxCloneFanOutGroupSize)
+	}
+	return size, nil
+}
+
+// GetDataSourceCacheHash returns the hash of the DataSource remote source and cache storage, which its cache is keyed by
+func GetDataSourceCacheHash(dataSource *cdiv1.DataSource) string {
+	key, _ := json.Marshal(&cdiv1.DataSourceSpec{
+		Source: cdiv1.DataSourceSource{
+			HTTP:     dataSource.Spec.Source.HTTP,
+			Registry: dataSource.Spec.Source.Registry,
+		},
+		Cache: dataSource.Spec.Cache,
+	})
+	return fmt.Sprintf("%x", sha256.Sum256(key))
+}
+
+// GetDataSourceCacheName returns the name of the DataVolume and PVC caching the import of the DataSource remote source
+// in the CDI namespace, shared by the DataSources with the same remote source and cache storage
+func GetDataSourceCacheName(dataSource *cdiv1.DataSource) string {
+	return naming.GetResourceName(cdiv1.DataSourceCacheNamePrefix, GetDataSourceCacheHash(dataSource)[:16])
+}
+
 // IsPVCComplete returns true if a PVC is in 'Succeeded' phase, false if not
 func IsPVCComplete(pvc *corev1.PersistentVolumeClaim) bool {
 	if pvc != nil {
@@ -1533,6 +1688,66 @@ func IsImageStream(pvc *corev1.PersistentVolumeClaim) bool {
 	return pvc.Annotations[AnnRegistryImageStream] == "true"
 }
 
+// IsDryRun returns true if the DataVolume import should only be validated
+func IsDryRun(dv *cdiv1.DataVolume) bool {
+	return dv.Annotations[AnnDryRun] == "true"
+}
+
+const (
+	// PreflightPhaseRunning is the phase of a dry run whose pod is inspecting the source
+	PreflightPhaseRunning = "Running"
+	// PreflightPhaseSucceeded is the phase of a dry run whose source can be imported into the requested storage
+	PreflightPhaseSucceeded = "Succeeded"
+	// PreflightPhaseFailed is the phase of a dry run whose source can't be accessed, or doesn't fit in the requested storage
+	PreflightPhaseFailed = "Failed"
+)
+
+// PreflightResult is the result of a DataVolume dry run, saved as JSON in the AnnPreflightResult annotation
+type PreflightResult struct {
+	// Phase is Running, Succeeded or Failed
+	Phase string `json:"phase"`
+	// Format is the image format, like qcow2 or raw
+	Format string `json:"format,omitempty"`
+	// VirtualSize is the size of the disk in the image, 0 if it can't be known without reading the whole image
+	VirtualSize int64 `json:"virtualSize,omitempty"`
+	// RequiredSize is the storage needed for the image, including the filesystem overhead
+	RequiredSize int64 `json:"requiredSize,omitempty"`
+	// RequestedSize is the storage requested by the DataVolume
+	RequestedSize int64 `json:"requestedSize,omitempty"`
+	// Reason identifies the failure, like HTTPUnauthorized, TLSFailed or InsufficientStorage
+	Reason string `json:"reason,omitempty"`
+	// Message describes the failure
+	Message string `json:"message,omitempty"`
+}
+
+// GetPreflightResult returns the result of the dry run of a DataVolume, nil if it didn't start
+func GetPreflightResult(dv *cdiv1.DataVolume) (*PreflightResult, error) {
+	value, ok := dv.Annotations[AnnPreflightResult]
+	if !ok {
+		return nil, nil
+	}
+	result := &PreflightResult{}
+	if err := json.Unmarshal([]byte(value), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsSizeDetectable returns true if the virtual size of the DataVolume source can be read before importing it
+func IsSizeDetectable(spec *cdiv1.DataVolumeSpec) bool {
+	source := spec.Source
+	return source != nil && (source.HTTP != nil || source.S3 != nil || source.GCS != nil)
+}
+
+// NeedsSizeDetection returns true if the PVC size of an import DataVolume is detected from the virtual size of its source
+func NeedsSizeDetection(dv *cdiv1.DataVolume) bool {
+	if dv.Spec.Storage == nil || !IsSizeDetectable(&dv.Spec) {
+		return false
+	}
+	_, hasSize := dv.Spec.Storage.Resources.Requests[corev1.ResourceStorage]
+	return !hasSize
+}
+
 // ShouldIgnorePod checks if a pod should be ignored.
 // If this is a completed pod that was used for one checkpoint of a multi-stage import, it
 // should be ignored by pod lookups as long as the retainAfterCompletion annotation is set.
@@ -1632,6 +1847,9 @@ func UpdateHTTPAnnotations(annotations map[string]string, http *cdiv1.DataVolume
 	if http.CertConfigMap != "" {
 		annotations[AnnCertConfigMap] = http.CertConfigMap
 	}
+	if http.Checksum != "" {
+		annotations[AnnSourceChecksum] = http.Checksum
+	}
 	for index, header := range http.ExtraHeaders {
 		annotations[fmt.Sprintf("%s.%d", AnnExtraHeaders, index)] = header
 	}
@@ -2083,3 +2301,13 @@ func SetPvcAllowedAnnotations(obj metav1.Object, pvc *corev1.PersistentVolumeCla
 		}
 	}
 }
+
+// ParseWeekday parses a week day name, full or abbreviated, e.g. Monday or Mon, case insensitive
+func ParseWeekday(day string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(day, weekday.String()) || strings.EqualFold(day, weekday.String()[:3]) {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, errors.Errorf("illegal week day %q", day)
+}
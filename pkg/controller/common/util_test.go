@@ -8,9 +8,13 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
 )
 
 var _ = Describe("GetRequestedImageSize", func() {
@@ -124,6 +128,29 @@ var _ = Describe("GetStorageClassByName", func() {
 	)
 })
 
+var _ = Describe("GetCloneBandwidthLimit", func() {
+	createObjects := func(profileLimit, configLimit *resource.Quantity) []runtime.Object {
+		storageProfile := &cdiv1.StorageProfile{ObjectMeta: metav1.ObjectMeta{Name: "sc"}}
+		storageProfile.Status.CloneBandwidthLimit = profileLimit
+		cdiConfig := MakeEmptyCDIConfigSpec(common.ConfigName)
+		cdiConfig.Spec.CloneBandwidthLimit = configLimit
+		return []runtime.Object{CreateStorageClass("sc", nil), storageProfile, cdiConfig}
+	}
+
+	DescribeTable("Should return", func(profileLimit, configLimit *resource.Quantity, expected int64) {
+		client := CreateClient(createObjects(profileLimit, configLimit)...)
+		sourcePvc := CreatePvcInStorageClass("source", "default", pointer.String("sc"), nil, nil, v1.ClaimBound)
+		limit, err := GetCloneBandwidthLimit(context.Background(), client, sourcePvc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(limit).To(Equal(expected))
+	},
+		Entry("no limit", nil, nil, int64(0)),
+		Entry("the CDIConfig limit", nil, resource.NewQuantity(1024, resource.BinarySI), int64(1024)),
+		Entry("the StorageProfile limit", resource.NewQuantity(2048, resource.BinarySI), nil, int64(2048)),
+		Entry("the StorageProfile limit over the CDIConfig limit", resource.NewQuantity(2048, resource.BinarySI), resource.NewQuantity(1024, resource.BinarySI), int64(2048)),
+	)
+})
+
 var _ = Describe("Rebind", func() {
 	It("Should return error if PV doesn't exist", func() {
 		client := CreateClient()
@@ -246,3 +273,20 @@ func createPvcNoSize(name, ns string, annotations, labels map[string]string) *v1
 		},
 	}
 }
+
+var _ = Describe("ParseWeekday", func() {
+	DescribeTable("Should parse", func(day string, expected time.Weekday) {
+		weekday, err := ParseWeekday(day)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(weekday).To(Equal(expected))
+	},
+		Entry("full name", "Monday", time.Monday),
+		Entry("abbreviated name", "Sat", time.Saturday),
+		Entry("lower case name", "sunday", time.Sunday),
+	)
+
+	It("Should reject an illegal day", func() {
+		_, err := ParseWeekday("Funday")
+		Expect(err).To(HaveOccurred())
+	})
+})
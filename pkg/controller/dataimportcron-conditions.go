@@ -40,7 +40,10 @@ func updateDataImportCronCondition(cron *cdiv1.DataImportCron, conditionType cdi
 		if status != corev1.ConditionTrue {
 			gaugeVal = 1
 		}
-		DataImportCronOutdatedGauge.With(getPrometheusCronLabels(types.NamespacedName{Namespace: cron.Namespace, Name: cron.Name})).Set(gaugeVal)
+		cronKey := types.NamespacedName{Namespace: cron.Namespace, Name: cron.Name}
+		// The reason is a label, so the series of any previous reason is deleted, including one not yet persisted in the status
+		DataImportCronOutdatedGauge.DeletePartialMatch(getPrometheusCronLabels(cronKey))
+		DataImportCronOutdatedGauge.With(getPrometheusCronReasonLabels(cronKey, reason)).Set(gaugeVal)
 	}
 	if condition := FindDataImportCronConditionByType(cron, conditionType); condition != nil {
 		updateConditionState(&condition.ConditionState, status, message, reason)
@@ -82,3 +85,9 @@ func getPrometheusCronLabels(cron types.NamespacedName) prometheus.Labels {
 		prometheusCronNameLabel: cron.Name,
 	}
 }
+
+func getPrometheusCronReasonLabels(cron types.NamespacedName, reason string) prometheus.Labels {
+	labels := getPrometheusCronLabels(cron)
+	labels[prometheusReasonLabel] = reason
+	return labels
+}
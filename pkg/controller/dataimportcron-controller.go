@@ -27,7 +27,6 @@ import (
 
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/go-logr/logr"
-	"github.com/gorhill/cronexpr"
 	imagev1 "github.com/openshift/api/image/v1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -42,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
@@ -72,6 +72,7 @@ const (
 
 	prometheusNsLabel       = "ns"
 	prometheusCronNameLabel = "cron_name"
+	prometheusReasonLabel   = "reason"
 )
 
 var (
@@ -81,7 +82,7 @@ var (
 			Name: monitoring.MetricOptsList[monitoring.DataImportCronOutdated].Name,
 			Help: monitoring.MetricOptsList[monitoring.DataImportCronOutdated].Help,
 		},
-		[]string{prometheusNsLabel, prometheusCronNameLabel},
+		[]string{prometheusNsLabel, prometheusCronNameLabel, prometheusReasonLabel},
 	)
 )
 
@@ -96,6 +97,8 @@ type DataImportCronReconciler struct {
 	pullPolicy      string
 	cdiNamespace    string
 	installerLabels map[string]string
+	// importReservations holds the import slots taken under the cluster limit of concurrent cron imports
+	importReservations importReservations
 }
 
 const (
@@ -109,6 +112,8 @@ const (
 	AnnLastCronTime = cc.AnnAPIGroup + "/storage.import.lastCronTime"
 	// AnnLastUseTime is the PVC last use time stamp
 	AnnLastUseTime = cc.AnnAPIGroup + "/storage.import.lastUseTime"
+	// AnnSourceDigest is the digest of the DataImportCron import the DataSource points to
+	AnnSourceDigest = cc.AnnAPIGroup + "/storage.import.sourceDigest"
 	// AnnStorageClass is the cron DV's storage class
 	AnnStorageClass = cc.AnnAPIGroup + "/storage.import.storageClass"
 
@@ -178,7 +183,7 @@ func (r *DataImportCronReconciler) initCron(ctx context.Context, dataImportCron
 		}
 		return nil
 	}
-	if !isURLSource(dataImportCron) {
+	if !isPolledSource(dataImportCron) {
 		return nil
 	}
 	exists, err := r.cronJobExistsAndUpdated(ctx, dataImportCron)
@@ -276,11 +281,10 @@ func (r *DataImportCronReconciler) pollImageStreamDigest(ctx context.Context, da
 
 func (r *DataImportCronReconciler) setNextCronTime(dataImportCron *cdiv1.DataImportCron) (reconcile.Result, error) {
 	now := time.Now()
-	expr, err := cronexpr.Parse(dataImportCron.Spec.Schedule)
+	nextTime, err := getNextCronTime(dataImportCron, now)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	nextTime := expr.Next(now)
 	diffSec := time.Duration(nextTime.Sub(now).Seconds()) + 1
 	res := reconcile.Result{Requeue: true, RequeueAfter: diffSec * time.Second}
 	cc.AddAnnotation(dataImportCron, AnnNextCronTime, nextTime.Format(time.RFC3339))
@@ -305,6 +309,50 @@ func getCronRegistrySource(cron *cdiv1.DataImportCron) (*cdiv1.DataVolumeSourceR
 	return source.Registry, nil
 }
 
+// cronPollerSource is a DataImportCron source polled for updates by the source update poller
+type cronPollerSource struct {
+	sourceType    string
+	url           string
+	secretRef     string
+	certConfigMap string
+}
+
+// getCronPollerSource returns the registry URL, http or s3 source of the cron
+func getCronPollerSource(cron *cdiv1.DataImportCron) (*cronPollerSource, error) {
+	source := cron.Spec.Template.Spec.Source
+	switch {
+	case source == nil:
+	case source.Registry != nil && source.Registry.URL != nil:
+		return &cronPollerSource{
+			sourceType:    cc.SourceRegistry,
+			url:           *source.Registry.URL,
+			secretRef:     pointer.StringDeref(source.Registry.SecretRef, ""),
+			certConfigMap: pointer.StringDeref(source.Registry.CertConfigMap, ""),
+		}, nil
+	case source.HTTP != nil:
+		return &cronPollerSource{
+			sourceType:    cc.SourceHTTP,
+			url:           source.HTTP.URL,
+			secretRef:     source.HTTP.SecretRef,
+			certConfigMap: source.HTTP.CertConfigMap,
+		}, nil
+	case source.S3 != nil:
+		return &cronPollerSource{
+			sourceType:    cc.SourceS3,
+			url:           source.S3.URL,
+			secretRef:     source.S3.SecretRef,
+			certConfigMap: source.S3.CertConfigMap,
+		}, nil
+	}
+	return nil, errors.Errorf("No URL source in cron %s", cron.Name)
+}
+
+// isPolledSource returns true if the cron source is polled by the source update poller
+func isPolledSource(dataImportCron *cdiv1.DataImportCron) bool {
+	_, err := getCronPollerSource(dataImportCron)
+	return err == nil
+}
+
 func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *cdiv1.DataImportCron) (reconcile.Result, error) {
 	res := reconcile.Result{}
 
@@ -315,7 +363,11 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 
 	dataImportCronCopy := dataImportCron.DeepCopy()
 	imports := dataImportCron.Status.CurrentImports
+	if err := r.recordPollerFailures(ctx, dataImportCron); err != nil {
+		return res, err
+	}
 	importSucceeded := false
+	importValidationFailed := false
 
 	dataVolume := dataImportCron.Spec.Template
 	explicitScName := getStorageClassFromTemplate(&dataVolume)
@@ -340,6 +392,20 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 			if err := r.updateSource(ctx, dataImportCron, pvc); err != nil {
 				return err
 			}
+			// The DataSource is not updated until the import passes validation
+			state, err := r.validateImport(ctx, dataImportCron, pvc)
+			if err != nil {
+				return err
+			}
+			switch state {
+			case validationPending:
+				updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronProgressing, corev1.ConditionTrue, "Import is being validated", validating)
+				return nil
+			case validationFailedState:
+				importValidationFailed = true
+				updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronProgressing, corev1.ConditionFalse, "Import failed validation", validationFailed)
+				return nil
+			}
 		}
 		importSucceeded = true
 		if err := r.handleCronFormat(ctx, dataImportCron, pvc, format, desiredStorageClass); err != nil {
@@ -350,6 +416,7 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 	}
 
 	if dv != nil {
+		recordDataVolumeFailure(dataImportCron, dv)
 		switch dv.Status.Phase {
 		case cdiv1.Succeeded:
 			if err := handlePopulatedPvc(); err != nil {
@@ -394,6 +461,10 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 		return res, err
 	}
 
+	if err := r.updateStorageClassTargets(ctx, dataImportCron, format, desiredStorageClass); err != nil {
+		return res, err
+	}
+
 	// Skip if schedule is disabled
 	if isImageStreamSource(dataImportCron) && dataImportCron.Spec.Schedule != "" {
 		// We use the poll returned reconcile.Result for RequeueAfter if needed
@@ -402,10 +473,21 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 			return pollRes, err
 		}
 		res = pollRes
+	} else if isPolledSource(dataImportCron) && dataImportCron.Spec.Schedule != "" && getScheduleJitterDelay(dataImportCron) > 0 {
+		pollRes, err := r.startDelayedPoll(ctx, dataImportCron)
+		if err != nil {
+			return pollRes, err
+		}
+		res = pollRes
 	}
 
 	desiredDigest := dataImportCron.Annotations[AnnSourceDesiredDigest]
 	digestUpdated := desiredDigest != "" && (len(imports) == 0 || desiredDigest != imports[0].Digest)
+	if !digestUpdated {
+		if err := recordSkippedPoll(dataImportCron, desiredDigest); err != nil {
+			return res, err
+		}
+	}
 	if digestUpdated {
 		updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronUpToDate, corev1.ConditionFalse, "Source digest updated since last import", outdated)
 		if dv != nil {
@@ -413,8 +495,18 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 				return res, err
 			}
 		}
-		if importSucceeded || len(imports) == 0 {
-			if err := r.createImportDataVolume(ctx, dataImportCron); err != nil {
+		if importSucceeded || importValidationFailed || len(imports) == 0 {
+			// The import may wait for its window, or for other cron imports to complete
+			wait, msg, err := r.getImportQueueWait(ctx, dataImportCron)
+			if err != nil {
+				return res, err
+			}
+			if wait > 0 {
+				updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronProgressing, corev1.ConditionFalse, msg, importQueued)
+				if res.RequeueAfter == 0 || wait < res.RequeueAfter {
+					res = reconcile.Result{RequeueAfter: wait}
+				}
+			} else if err := r.createImportDataVolume(ctx, dataImportCron); err != nil {
 				return res, err
 			}
 		}
@@ -422,6 +514,8 @@ func (r *DataImportCronReconciler) update(ctx context.Context, dataImportCron *c
 		if err := r.updateDataImportCronSuccessCondition(ctx, dataImportCron, format, snapshot); err != nil {
 			return res, err
 		}
+	} else if importValidationFailed {
+		updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronUpToDate, corev1.ConditionFalse, "Latest import failed validation", validationFailed)
 	} else if len(imports) > 0 {
 		updateDataImportCronCondition(dataImportCron, cdiv1.DataImportCronUpToDate, corev1.ConditionFalse, "Import is progressing", inProgress)
 	} else {
@@ -504,7 +598,8 @@ func (r *DataImportCronReconciler) updateSource(ctx context.Context, cron *cdiv1
 func (r *DataImportCronReconciler) deleteErroneousDataVolume(ctx context.Context, cron *cdiv1.DataImportCron, dv *cdiv1.DataVolume) error {
 	log := r.log.WithValues("name", dv.Name).WithValues("uid", dv.UID)
 	if cond := cdv.FindConditionByType(cdiv1.DataVolumeRunning, dv.Status.Conditions); cond != nil {
-		if cond.Status == corev1.ConditionFalse && cond.Reason == common.GenericError {
+		// Structured errors are reported by their code, like a changed source which the next poll picks up the new version of
+		if cond.Status == corev1.ConditionFalse && (cond.Reason == common.GenericError || util.IsErrorCode(cond.Reason)) {
 			log.Info("Delete DataVolume and reset DesiredDigest due to error", "message", cond.Message)
 			// Unlabel the DV before deleting it, to eliminate reconcile before DIC is updated
 			dv.Labels[common.DataImportCronLabel] = ""
@@ -547,12 +642,22 @@ func (r *DataImportCronReconciler) updateImageStreamDesiredDigest(ctx context.Co
 }
 
 func (r *DataImportCronReconciler) updateDataSource(ctx context.Context, dataImportCron *cdiv1.DataImportCron, format cdiv1.DataImportCronSourceFormat) error {
+	sourcePVC := dataImportCron.Status.LastImportedPVC
+	digest := ""
+	if imports := dataImportCron.Status.CurrentImports; sourcePVC != nil && len(imports) > 0 && imports[0].DataVolumeName == sourcePVC.Name {
+		digest = imports[0].Digest
+	}
+	return r.updateManagedDataSource(ctx, dataImportCron, dataImportCron.Spec.ManagedDataSource, format, sourcePVC, digest)
+}
+
+// updateManagedDataSource creates the named DataSource managed by the cron if needed, and points it to sourcePVC
+func (r *DataImportCronReconciler) updateManagedDataSource(ctx context.Context, dataImportCron *cdiv1.DataImportCron, dataSourceName string,
+	format cdiv1.DataImportCronSourceFormat, sourcePVC *cdiv1.DataVolumeSourcePVC, digest string) error {
 	log := r.log.WithName("updateDataSource")
-	dataSourceName := dataImportCron.Spec.ManagedDataSource
 	dataSource := &cdiv1.DataSource{}
 	if err := r.client.Get(ctx, types.NamespacedName{Namespace: dataImportCron.Namespace, Name: dataSourceName}, dataSource); err != nil {
 		if k8serrors.IsNotFound(err) {
-			dataSource = r.newDataSource(dataImportCron)
+			dataSource = r.newDataSource(dataImportCron, dataSourceName)
 			if err := r.client.Create(ctx, dataSource); err != nil {
 				return err
 			}
@@ -574,8 +679,13 @@ func (r *DataImportCronReconciler) updateDataSource(ctx context.Context, dataImp
 
 	passCronLabelToDataSource(dataImportCron, dataSource, cc.LabelDynamicCredentialSupport)
 
-	sourcePVC := dataImportCron.Status.LastImportedPVC
-	populateDataSource(format, dataSource, sourcePVC)
+	// A pinned DataSource is rolled back by the user, so it is not updated to the last import
+	if dataSource.Spec.PinnedRevision == nil {
+		populateDataSource(format, dataSource, sourcePVC)
+		if digest != "" {
+			cc.AddAnnotation(dataSource, AnnSourceDigest, digest)
+		}
+	}
 
 	if !reflect.DeepEqual(dataSource, dataSourceCopy) {
 		if err := r.client.Update(ctx, dataSource); err != nil {
@@ -618,6 +728,11 @@ func updateDataImportCronOnSuccess(dataImportCron *cdiv1.DataImportCron) error {
 		dataImportCron.Status.LastImportedPVC = sourcePVC
 		now := metav1.Now()
 		dataImportCron.Status.LastImportTimestamp = &now
+		addDataImportCronRun(dataImportCron, cdiv1.DataImportCronRun{
+			Time:   now,
+			Digest: dataImportCron.Status.CurrentImports[0].Digest,
+			Action: cdiv1.DataImportCronRunImported,
+		})
 	}
 	return nil
 }
@@ -666,6 +781,7 @@ func (r *DataImportCronReconciler) createImportDataVolume(ctx context.Context, d
 
 	dv := r.newSourceDataVolume(dataImportCron, dvName)
 	if err := r.client.Create(ctx, dv); err != nil && !k8serrors.IsAlreadyExists(err) {
+		r.importReservations.release(client.ObjectKeyFromObject(dv))
 		return err
 	}
 
@@ -714,6 +830,12 @@ func (r *DataImportCronReconciler) handleSnapshot(ctx context.Context, dataImpor
 		},
 	}
 	r.setDataImportCronResourceLabels(dataImportCron, desiredSnapshot)
+	if storageClassLabel := pvc.Labels[common.DataImportCronStorageClassLabel]; storageClassLabel != "" {
+		desiredSnapshot.Labels[common.DataImportCronStorageClassLabel] = storageClassLabel
+	}
+	if identity := pvc.Labels[common.ImportSourceIdentityLabel]; identity != "" {
+		desiredSnapshot.Labels[common.ImportSourceIdentityLabel] = identity
+	}
 
 	currentSnapshot := &snapshotv1.VolumeSnapshot{}
 	if err := r.client.Get(ctx, client.ObjectKeyFromObject(desiredSnapshot), currentSnapshot); err != nil {
@@ -793,58 +915,70 @@ func (r *DataImportCronReconciler) garbageCollectOldImports(ctx context.Context,
 	if cron.Spec.GarbageCollect != nil && *cron.Spec.GarbageCollect != cdiv1.DataImportCronGarbageCollectOutdated {
 		return nil
 	}
-	selector, err := getSelector(map[string]string{common.DataImportCronLabel: cron.Name})
+	selector, err := getPrimaryImportsSelector(cron)
 	if err != nil {
 		return err
 	}
 
-	maxImports := defaultImportsToKeepPerCron
-
-	if cron.Spec.ImportsToKeep != nil && *cron.Spec.ImportsToKeep >= 0 {
-		maxImports = int(*cron.Spec.ImportsToKeep)
+	// Imports a DataSource points to, or which are the source of an in-flight clone, must be kept
+	protected, err := r.getProtectedImportSources(ctx, cron.Namespace)
+	if err != nil {
+		return err
 	}
 
-	if err := r.garbageCollectPVCs(ctx, cron.Namespace, cron.Name, selector, maxImports); err != nil {
+	if err := r.garbageCollectPVCs(ctx, cron, selector, newImportRetention(cron), protected); err != nil {
 		return err
 	}
-	if err := r.garbageCollectSnapshots(ctx, cron.Namespace, selector, maxImports); err != nil {
+	if err := r.garbageCollectSnapshots(ctx, cron.Namespace, selector, newImportRetention(cron), protected); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *DataImportCronReconciler) garbageCollectPVCs(ctx context.Context, namespace, cronName string, selector labels.Selector, maxImports int) error {
+func getImportsToKeep(cron *cdiv1.DataImportCron) int {
+	if cron.Spec.ImportsToKeep != nil && *cron.Spec.ImportsToKeep >= 0 {
+		return int(*cron.Spec.ImportsToKeep)
+	}
+	return defaultImportsToKeepPerCron
+}
+
+func (r *DataImportCronReconciler) garbageCollectPVCs(ctx context.Context, cron *cdiv1.DataImportCron, selector labels.Selector, retention *importRetention, protected sets.Set[string]) error {
 	pvcList := &corev1.PersistentVolumeClaimList{}
 
-	if err := r.client.List(ctx, pvcList, &client.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
+	if err := r.client.List(ctx, pvcList, &client.ListOptions{Namespace: cron.Namespace, LabelSelector: selector}); err != nil {
 		return err
 	}
-	if len(pvcList.Items) > maxImports {
-		sort.Slice(pvcList.Items, func(i, j int) bool {
-			return pvcList.Items[i].Annotations[AnnLastUseTime] > pvcList.Items[j].Annotations[AnnLastUseTime]
-		})
-		for _, pvc := range pvcList.Items[maxImports:] {
-			r.log.Info("Deleting dv/pvc", "name", pvc.Name, "pvc.uid", pvc.UID)
-			if err := r.deleteDvPvc(ctx, pvc.Name, pvc.Namespace); err != nil {
-				return err
-			}
+	sort.Slice(pvcList.Items, func(i, j int) bool {
+		return pvcList.Items[i].Annotations[AnnLastUseTime] > pvcList.Items[j].Annotations[AnnLastUseTime]
+	})
+	for _, pvc := range pvcList.Items {
+		if retention.keep(getLastUseTime(&pvc), getPvcImportSize(&pvc)) {
+			continue
+		}
+		if protected.Has(importSourceKey(pvcSourceKind, pvc.Name)) {
+			r.log.V(3).Info("Keeping dv/pvc in use", "name", pvc.Name)
+			continue
+		}
+		r.log.Info("Deleting dv/pvc", "name", pvc.Name, "pvc.uid", pvc.UID)
+		if err := r.deleteDvPvc(ctx, pvc.Name, pvc.Namespace); err != nil {
+			return err
 		}
 	}
 
 	dvList := &cdiv1.DataVolumeList{}
-	if err := r.client.List(ctx, dvList, &client.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
+	if err := r.client.List(ctx, dvList, &client.ListOptions{Namespace: cron.Namespace, LabelSelector: selector}); err != nil {
 		return err
 	}
 
-	if len(dvList.Items) > maxImports {
+	if len(dvList.Items) > retention.minImports {
 		for _, dv := range dvList.Items {
 			pvc := &corev1.PersistentVolumeClaim{}
-			if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: dv.Name}, pvc); err != nil {
+			if err := r.client.Get(ctx, types.NamespacedName{Namespace: cron.Namespace, Name: dv.Name}, pvc); err != nil {
 				return err
 			}
 
-			if pvc.Labels[common.DataImportCronLabel] != cronName {
+			if pvc.Labels[common.DataImportCronLabel] != cron.Name && !protected.Has(importSourceKey(pvcSourceKind, pvc.Name)) {
 				r.log.Info("Deleting old version dv/pvc", "name", pvc.Name, "pvc.uid", pvc.UID)
 				if err := r.deleteDvPvc(ctx, dv.Name, dv.Namespace); err != nil {
 					return err
@@ -870,7 +1004,7 @@ func (r *DataImportCronReconciler) deleteDvPvc(ctx context.Context, name, namesp
 	return nil
 }
 
-func (r *DataImportCronReconciler) garbageCollectSnapshots(ctx context.Context, namespace string, selector labels.Selector, maxImports int) error {
+func (r *DataImportCronReconciler) garbageCollectSnapshots(ctx context.Context, namespace string, selector labels.Selector, retention *importRetention, protected sets.Set[string]) error {
 	snapList := &snapshotv1.VolumeSnapshotList{}
 
 	if err := r.client.List(ctx, snapList, &client.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
@@ -879,15 +1013,20 @@ func (r *DataImportCronReconciler) garbageCollectSnapshots(ctx context.Context,
 		}
 		return err
 	}
-	if len(snapList.Items) > maxImports {
-		sort.Slice(snapList.Items, func(i, j int) bool {
-			return snapList.Items[i].Annotations[AnnLastUseTime] > snapList.Items[j].Annotations[AnnLastUseTime]
-		})
-		for _, snap := range snapList.Items[maxImports:] {
-			r.log.Info("Deleting snapshot", "name", snap.Name, "uid", snap.UID)
-			if err := r.client.Delete(ctx, &snap); err != nil && !k8serrors.IsNotFound(err) {
-				return err
-			}
+	sort.Slice(snapList.Items, func(i, j int) bool {
+		return snapList.Items[i].Annotations[AnnLastUseTime] > snapList.Items[j].Annotations[AnnLastUseTime]
+	})
+	for _, snap := range snapList.Items {
+		if retention.keep(getLastUseTime(&snap), getSnapshotImportSize(&snap)) {
+			continue
+		}
+		if protected.Has(importSourceKey(snapshotSourceKind, snap.Name)) {
+			r.log.V(3).Info("Keeping snapshot in use", "name", snap.Name)
+			continue
+		}
+		r.log.Info("Deleting snapshot", "name", snap.Name, "uid", snap.UID)
+		if err := r.client.Delete(ctx, &snap); err != nil && !k8serrors.IsNotFound(err) {
+			return err
 		}
 	}
 
@@ -896,7 +1035,7 @@ func (r *DataImportCronReconciler) garbageCollectSnapshots(ctx context.Context,
 
 func (r *DataImportCronReconciler) cleanup(ctx context.Context, cron types.NamespacedName) error {
 	// Don't keep alerting over a cron thats being deleted, will get set back to 1 again by reconcile loop if needed.
-	DataImportCronOutdatedGauge.With(getPrometheusCronLabels(cron)).Set(0)
+	DataImportCronOutdatedGauge.DeletePartialMatch(getPrometheusCronLabels(cron))
 	if err := r.deleteJobs(ctx, cron); err != nil {
 		return err
 	}
@@ -917,6 +1056,12 @@ func (r *DataImportCronReconciler) cleanup(ctx context.Context, cron types.Names
 	if err := r.client.DeleteAllOf(ctx, &snapshotv1.VolumeSnapshot{}, opts); cc.IgnoreIsNoMatchError(err) != nil {
 		return err
 	}
+	// Validation Jobs hold their PVC until they terminate
+	deletePropagationBackground := metav1.DeletePropagationBackground
+	opts.DeleteOptions.PropagationPolicy = &deletePropagationBackground
+	if err := r.client.DeleteAllOf(ctx, &batchv1.Job{}, opts); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -983,6 +1128,13 @@ func NewDataImportCronController(mgr manager.Manager, log logr.Logger, importerI
 }
 
 func addDataImportCronControllerWatches(mgr manager.Manager, c controller.Controller, log logr.Logger) error {
+	// Garbage collection looks up the sources protected in the cron namespace
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataSource{}, dataSourceSourceNamespaceField, indexDataSourceSourceNamespace); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataVolume{}, dvInProgressSourceNamespaceField, indexDvInProgressSourceNamespace); err != nil {
+		return err
+	}
 	if err := c.Watch(&source.Kind{Type: &cdiv1.DataImportCron{}}, &handler.EnqueueRequestForObject{}); err != nil {
 		return err
 	}
@@ -1015,7 +1167,7 @@ func addDataImportCronControllerWatches(mgr manager.Manager, c controller.Contro
 				c.GetLogger().Error(err, "Unable to get storage class", "templateSc", templateSc)
 				return
 			}
-			if templateSc.Name == scName {
+			if templateSc.Name == scName || isStorageClassTarget(&cron, scName) {
 				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: cron.Namespace, Name: cron.Name}})
 			}
 		}
@@ -1044,6 +1196,33 @@ func addDataImportCronControllerWatches(mgr manager.Manager, c controller.Contro
 		return err
 	}
 
+	mapJobToCron := func(obj client.Object) []reconcile.Request {
+		if isValidationJob(obj) {
+			return mapSourceObjectToCron(obj)
+		}
+		if cron, ok := getPollerJobCron(obj); ok {
+			return []reconcile.Request{{NamespacedName: cron}}
+		}
+		return nil
+	}
+	isPollerJobFailed := func(e event.UpdateEvent) bool {
+		_, ok := getPollerJobCron(e.ObjectNew)
+		jobOld, okOld := e.ObjectOld.(*batchv1.Job)
+		jobNew, okNew := e.ObjectNew.(*batchv1.Job)
+		return ok && okOld && okNew && !isJobConditionTrue(jobOld, batchv1.JobFailed) && isJobConditionTrue(jobNew, batchv1.JobFailed)
+	}
+
+	if err := c.Watch(&source.Kind{Type: &batchv1.Job{}},
+		handler.EnqueueRequestsFromMapFunc(mapJobToCron),
+		predicate.Funcs{
+			CreateFunc: func(event.CreateEvent) bool { return false },
+			UpdateFunc: func(e event.UpdateEvent) bool { return isValidationJob(e.ObjectNew) || isPollerJobFailed(e) },
+			DeleteFunc: func(e event.DeleteEvent) bool { return isValidationJob(e.Object) },
+		},
+	); err != nil {
+		return err
+	}
+
 	if err := c.Watch(&source.Kind{Type: &cdiv1.StorageProfile{}},
 		handler.EnqueueRequestsFromMapFunc(mapStorageProfileToCron),
 		predicate.Funcs{
@@ -1098,18 +1277,15 @@ func (r *DataImportCronReconciler) newCronJob(cron *cdiv1.DataImportCron) (*batc
 
 // InitPollerPodSpec inits poller PodSpec
 func InitPollerPodSpec(c client.Client, cron *cdiv1.DataImportCron, podSpec *corev1.PodSpec, image string, pullPolicy corev1.PullPolicy, log logr.Logger) error {
-	regSource, err := getCronRegistrySource(cron)
+	source, err := getCronPollerSource(cron)
 	if err != nil {
 		return err
 	}
-	if regSource.URL == nil {
-		return errors.Errorf("No URL source in cron %s", cron.Name)
-	}
 	cdiConfig := &cdiv1.CDIConfig{}
 	if err := c.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig); err != nil {
 		return err
 	}
-	insecureTLS, err := IsInsecureTLS(*regSource.URL, cdiConfig, log)
+	insecureTLS, err := IsInsecureTLS(source.url, cdiConfig, log)
 	if err != nil {
 		return err
 	}
@@ -1120,23 +1296,26 @@ func InitPollerPodSpec(c client.Client, cron *cdiv1.DataImportCron, podSpec *cor
 			"/usr/bin/cdi-source-update-poller",
 			"-ns", cron.Namespace,
 			"-cron", cron.Name,
-			"-url", *regSource.URL,
+			"-url", source.url,
+			"-source", source.sourceType,
 		},
 		ImagePullPolicy:          pullPolicy,
 		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
 		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 	}
+	if cron.Spec.ChecksumURL != nil && *cron.Spec.ChecksumURL != "" {
+		container.Command = append(container.Command, "-checksum-url", *cron.Spec.ChecksumURL)
+	}
 
 	var volumes []corev1.Volume
-	hasCertConfigMap := regSource.CertConfigMap != nil && *regSource.CertConfigMap != ""
-	if hasCertConfigMap {
+	if source.certConfigMap != "" {
 		vm := corev1.VolumeMount{
 			Name:      CertVolName,
 			MountPath: common.ImporterCertDir,
 		}
 		container.VolumeMounts = append(container.VolumeMounts, vm)
 		container.Command = append(container.Command, "-certdir", common.ImporterCertDir)
-		volumes = append(volumes, createConfigMapVolume(CertVolName, *regSource.CertConfigMap))
+		volumes = append(volumes, createConfigMapVolume(CertVolName, source.certConfigMap))
 	}
 
 	if volName, _ := GetImportProxyConfig(cdiConfig, common.ImportProxyConfigMapName); volName != "" {
@@ -1148,14 +1327,14 @@ func InitPollerPodSpec(c client.Client, cron *cdiv1.DataImportCron, podSpec *cor
 		volumes = append(volumes, createConfigMapVolume(ProxyCertVolName, volName))
 	}
 
-	if regSource.SecretRef != nil && *regSource.SecretRef != "" {
+	if source.secretRef != "" {
 		container.Env = append(container.Env,
 			corev1.EnvVar{
 				Name: common.ImporterAccessKeyID,
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: *regSource.SecretRef,
+							Name: source.secretRef,
 						},
 						Key: common.KeyAccess,
 					},
@@ -1166,7 +1345,7 @@ func InitPollerPodSpec(c client.Client, cron *cdiv1.DataImportCron, podSpec *cor
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: *regSource.SecretRef,
+							Name: source.secretRef,
 						},
 						Key: common.KeySecret,
 					},
@@ -1221,9 +1400,13 @@ func (r *DataImportCronReconciler) initCronJob(cron *cdiv1.DataImportCron, cronJ
 	cronJobSpec := &cronJob.Spec
 	cronJobSpec.Schedule = cron.Spec.Schedule
 	cronJobSpec.ConcurrencyPolicy = batchv1.ForbidConcurrent
+	// The CronJob can't delay its Jobs, the controller starts the polls delayed by the schedule jitter
+	cronJobSpec.Suspend = pointer.Bool(getScheduleJitterDelay(cron) > 0)
 	cronJobSpec.SuccessfulJobsHistoryLimit = pointer.Int32(1)
 	cronJobSpec.FailedJobsHistoryLimit = pointer.Int32(1)
 
+	// Label the poller Jobs, so their failures are watched and recorded in the cron history
+	cronJobSpec.JobTemplate.Labels = map[string]string{common.DataImportCronLabel: getCronJobLabelValue(cron.Namespace, cron.Name)}
 	jobSpec := &cronJobSpec.JobTemplate.Spec
 	jobSpec.BackoffLimit = pointer.Int32(2)
 	jobSpec.TTLSecondsAfterFinished = pointer.Int32(10)
@@ -1239,9 +1422,13 @@ func (r *DataImportCronReconciler) initCronJob(cron *cdiv1.DataImportCron, cronJ
 }
 
 func (r *DataImportCronReconciler) newInitialJob(cron *cdiv1.DataImportCron, cronJob *batchv1.CronJob) (*batchv1.Job, error) {
+	return r.newPollerJob(cron, cronJob, GetInitialJobName(cron))
+}
+
+func (r *DataImportCronReconciler) newPollerJob(cron *cdiv1.DataImportCron, cronJob *batchv1.CronJob, name string) (*batchv1.Job, error) {
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      GetInitialJobName(cron),
+			Name:      name,
 			Namespace: cronJob.Namespace,
 		},
 		Spec: cronJob.Spec.JobTemplate.Spec,
@@ -1268,12 +1455,20 @@ func (r *DataImportCronReconciler) newSourceDataVolume(cron *cdiv1.DataImportCro
 	dv := cron.Spec.Template.DeepCopy()
 	if isURLSource(cron) {
 		digestedURL = untagDigestedDockerURL(*dv.Spec.Source.Registry.URL + "@" + cron.Annotations[AnnSourceDesiredDigest])
+		dv.Spec.Source.Registry.URL = &digestedURL
 	} else if isImageStreamSource(cron) {
 		// No way to import image stream by name when we want specific digest, so we use its docker reference
 		digestedURL = "docker://" + cron.Annotations[AnnImageStreamDockerRef]
 		dv.Spec.Source.Registry.ImageStream = nil
+		dv.Spec.Source.Registry.URL = &digestedURL
+	}
+	// http and s3 sources are imported from the template URL, pinned to the polled version so a source
+	// updated after the poll fails the import instead of being stored under the old digest
+	if dv.Spec.Source != nil && dv.Spec.Source.HTTP != nil && cron.Spec.ChecksumURL != nil && *cron.Spec.ChecksumURL != "" {
+		dv.Spec.Source.HTTP.Checksum = cron.Annotations[AnnSourceDesiredDigest]
+	} else if dv.Spec.Source != nil && (dv.Spec.Source.HTTP != nil || dv.Spec.Source.S3 != nil) {
+		cc.AddAnnotation(dv, cc.AnnSourceVersion, cron.Annotations[AnnSourceDesiredDigest])
 	}
-	dv.Spec.Source.Registry.URL = &digestedURL
 	dv.Name = dataVolumeName
 	dv.Namespace = cron.Namespace
 	r.setDataImportCronResourceLabels(cron, dv)
@@ -1331,10 +1526,10 @@ func passCronLabelToDataSource(cron *cdiv1.DataImportCron, ds *cdiv1.DataSource,
 	}
 }
 
-func (r *DataImportCronReconciler) newDataSource(cron *cdiv1.DataImportCron) *cdiv1.DataSource {
+func (r *DataImportCronReconciler) newDataSource(cron *cdiv1.DataImportCron, name string) *cdiv1.DataSource {
 	dataSource := &cdiv1.DataSource{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      cron.Spec.ManagedDataSource,
+			Name:      name,
 			Namespace: cron.Namespace,
 		},
 	}
@@ -1366,6 +1561,11 @@ func GetInitialJobName(cron *cdiv1.DataImportCron) string {
 	return naming.GetResourceName("initial-job", GetCronJobName(cron))
 }
 
+// getDelayedPollJobName gets the name of the poller Job started for the poll delayed to pollTime, like the CronJob Job names
+func getDelayedPollJobName(cron *cdiv1.DataImportCron, pollTime time.Time) string {
+	return fmt.Sprintf("%s-%d", GetCronJobName(cron), pollTime.Unix()/60)
+}
+
 func getSelector(matchLabels map[string]string) (labels.Selector, error) {
 	return metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: matchLabels})
 }
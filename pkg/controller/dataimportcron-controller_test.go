@@ -29,15 +29,18 @@ import (
 	. "github.com/onsi/gomega"
 	imagev1 "github.com/openshift/api/image/v1"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
@@ -51,6 +54,7 @@ import (
 	"kubevirt.io/containerized-data-importer/pkg/common"
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 	cdv "kubevirt.io/containerized-data-importer/pkg/controller/datavolume"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
 var (
@@ -67,6 +71,8 @@ const (
 	testTag         = ":12.34_56-7890"
 	testDigest      = "sha256:68b44fc891f3fae6703d4b74bcc9b5f24df8d23f12e642805d1420cbe7a4be70"
 	testDockerRef   = "quay.io/kubevirt/blabla@" + testDigest
+	testHTTPURL     = "https://example.com/images/disk.qcow2"
+	testChecksumURL = "https://example.com/images/SHA256SUMS"
 	dataSourceName  = "test-datasource"
 	imageStreamName = "test-imagestream"
 	imageStreamTag  = "test-imagestream-tag"
@@ -292,6 +298,68 @@ var _ = Describe("All DataImportCron Tests", func() {
 			Expect(jobPodTemplateSpec.Volumes).To(BeEmpty())
 		})
 
+		DescribeTable("Should create a poller CronJob for", func(source *cdiv1.DataVolumeSource, checksumURL *string, expectedArgs []string) {
+			cron = newDataImportCron(cronName)
+			cron.Spec.Template.Spec.Source = source
+			cron.Spec.ChecksumURL = checksumURL
+			reconciler = createDataImportCronReconciler(cron)
+			_, err := reconciler.Reconcile(context.TODO(), cronReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			cronjob := &batchv1.CronJob{}
+			err = reconciler.client.Get(context.TODO(), cronJobKey(cron), cronjob)
+			Expect(err).ToNot(HaveOccurred())
+			containers := cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].Command).To(HaveLen(7 + len(expectedArgs)))
+			Expect(containers[0].Command[7:]).To(Equal(expectedArgs))
+			Expect(getEnvVar(containers[0].Env, common.ImporterAccessKeyID)).To(BeEmpty())
+		},
+			Entry("http source", &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: testHTTPURL}}, nil,
+				[]string{"-source", cc.SourceHTTP}),
+			Entry("http source with checksum file", &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: testHTTPURL}}, pointer.String(testChecksumURL),
+				[]string{"-source", cc.SourceHTTP, "-checksum-url", testChecksumURL}),
+			Entry("s3 source", &cdiv1.DataVolumeSource{S3: &cdiv1.DataVolumeSourceS3{URL: testHTTPURL}}, nil,
+				[]string{"-source", cc.SourceS3}),
+		)
+
+		DescribeTable("Should create DataVolume pinned to the polled version on AnnSourceDesiredDigest annotation update", func(source *cdiv1.DataVolumeSource, checksumURL *string, expectedChecksum, expectedVersion string) {
+			cron = newDataImportCron(cronName)
+			cron.Spec.Template.Spec.Source = source
+			cron.Spec.ChecksumURL = checksumURL
+			reconciler = createDataImportCronReconciler(cron)
+			_, err := reconciler.Reconcile(context.TODO(), cronReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = reconciler.client.Get(context.TODO(), cronKey, cron)
+			Expect(err).ToNot(HaveOccurred())
+			cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+			err = reconciler.client.Update(context.TODO(), cron)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = reconciler.Reconcile(context.TODO(), cronReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = reconciler.client.Get(context.TODO(), cronKey, cron)
+			Expect(err).ToNot(HaveOccurred())
+			imports := cron.Status.CurrentImports
+			Expect(imports).To(HaveLen(1))
+			Expect(imports[0].Digest).To(Equal(testDigest))
+
+			dv := &cdiv1.DataVolume{}
+			err = reconciler.client.Get(context.TODO(), dvKey(imports[0].DataVolumeName), dv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Spec.Source.Registry).To(BeNil())
+			if dv.Spec.Source.HTTP != nil {
+				Expect(dv.Spec.Source.HTTP.URL).To(Equal(testHTTPURL))
+				Expect(dv.Spec.Source.HTTP.Checksum).To(Equal(expectedChecksum))
+			}
+			Expect(dv.Annotations[cc.AnnSourceVersion]).To(Equal(expectedVersion))
+		},
+			Entry("http source", &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: testHTTPURL}}, nil, "", testDigest),
+			Entry("http source with checksum file", &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: testHTTPURL}}, pointer.String(testChecksumURL), testDigest, ""),
+			Entry("s3 source", &cdiv1.DataVolumeSource{S3: &cdiv1.DataVolumeSourceS3{URL: testHTTPURL}}, nil, "", testDigest),
+		)
+
 		It("Should update CronJob on reconcile", func() {
 			cron = newDataImportCron(cronName)
 			reconciler = createDataImportCronReconciler(cron)
@@ -464,6 +532,332 @@ var _ = Describe("All DataImportCron Tests", func() {
 			Entry("empty schedule", emptySchedule, "should succeed with an empty schedule"),
 		)
 
+		Context("Import validation", func() {
+			var (
+				pvc *corev1.PersistentVolumeClaim
+				job *batchv1.Job
+			)
+
+			reconcileAndGetCron := func() {
+				_, err := reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.client.Get(context.TODO(), cronKey, cron)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			verifyValidatedCondition := func(isValidated bool, reason string) {
+				cronCond := FindDataImportCronConditionByType(cron, cdiv1.DataImportCronValidated)
+				Expect(cronCond).ToNot(BeNil())
+				verifyConditionState(string(cdiv1.DataImportCronValidated), cronCond.ConditionState, isValidated, reason)
+			}
+
+			setJobCondition := func(conditionType batchv1.JobConditionType) {
+				job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+				err := reconciler.client.Status().Update(context.TODO(), job)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				cron = newDataImportCron(cronName)
+				cron.Spec.Validation = &cdiv1.DataImportCronValidation{
+					Image:   "quay.io/example/virt-inspector",
+					Command: []string{"virt-inspector", "-a", "$(" + ValidationDiskEnv + ")"},
+				}
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				cdiConfig := cc.MakeEmptyCDIConfigSpec(common.ConfigName)
+				cdiConfig.Spec.DataImportCronValidationImages = []string{cron.Spec.Validation.Image}
+				reconciler = createDataImportCronReconcilerWithoutConfig(cdiConfig, cron)
+				reconcileAndGetCron()
+
+				dv := &cdiv1.DataVolume{}
+				err := reconciler.client.Get(context.TODO(), dvKey(cron.Status.CurrentImports[0].DataVolumeName), dv)
+				Expect(err).ToNot(HaveOccurred())
+				dv.Status.Phase = cdiv1.Succeeded
+				err = reconciler.client.Update(context.TODO(), dv)
+				Expect(err).ToNot(HaveOccurred())
+				pvc = cc.CreatePvc(dv.Name, dv.Namespace, nil, nil)
+				err = reconciler.client.Create(context.TODO(), pvc)
+				Expect(err).ToNot(HaveOccurred())
+
+				reconcileAndGetCron()
+				verifyValidatedCondition(false, validating)
+				Expect(cron.Status.LastImportedPVC).To(BeNil())
+
+				job = &batchv1.Job{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Namespace: pvc.Namespace, Name: getValidationJobName(pvc)}, job)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Should run a validation Job mounting the imported PVC read-only", func() {
+				Expect(job.Labels[common.DataImportCronLabel]).To(Equal(cron.Name))
+				Expect(isValidationJob(job)).To(BeTrue())
+				podSpec := job.Spec.Template.Spec
+				Expect(podSpec.Volumes).To(HaveLen(1))
+				Expect(podSpec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvc.Name))
+				Expect(podSpec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+				container := podSpec.Containers[0]
+				Expect(container.Image).To(Equal(cron.Spec.Validation.Image))
+				Expect(container.VolumeMounts).To(HaveLen(1))
+				Expect(container.VolumeMounts[0].ReadOnly).To(BeTrue())
+				Expect(getEnvVar(container.Env, ValidationDiskEnv)).To(Equal(common.ImporterWritePath))
+				Expect(*podSpec.AutomountServiceAccountToken).To(BeFalse())
+				Expect(*container.SecurityContext.RunAsNonRoot).To(BeTrue())
+				Expect(*container.SecurityContext.AllowPrivilegeEscalation).To(BeFalse())
+			})
+
+			It("Should not run a validation Job with an image not allowed by the CDIConfig", func() {
+				err := reconciler.client.Delete(context.TODO(), job)
+				Expect(err).ToNot(HaveOccurred())
+				cdiConfig := &cdiv1.CDIConfig{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+				Expect(err).ToNot(HaveOccurred())
+				cdiConfig.Spec.DataImportCronValidationImages = []string{"quay.io/example/other"}
+				err = reconciler.client.Update(context.TODO(), cdiConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				reconcileAndGetCron()
+				verifyValidatedCondition(false, validationFailed)
+				Expect(cron.Status.LastImportedPVC).To(BeNil())
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Namespace: pvc.Namespace, Name: getValidationJobName(pvc)}, job)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("Should update DataSource only once the validation Job succeeds", func() {
+				setJobCondition(batchv1.JobComplete)
+				reconcileAndGetCron()
+				verifyValidatedCondition(true, validated)
+				Expect(cron.Status.LastImportedPVC).ToNot(BeNil())
+				Expect(cron.Status.LastImportedPVC.Name).To(Equal(pvc.Name))
+
+				err := reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pvc), pvc)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.Annotations[AnnImportValidated]).To(Equal("true"))
+				err = reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(job), job)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				dataSource = &cdiv1.DataSource{}
+				err = reconciler.client.Get(context.TODO(), dataSourceKey(cron), dataSource)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataSource.Spec.Source.PVC.Name).To(Equal(pvc.Name))
+			})
+
+			It("Should not update DataSource if the validation Job fails, and import the next digest", func() {
+				setJobCondition(batchv1.JobFailed)
+				reconcileAndGetCron()
+				verifyValidatedCondition(false, validationFailed)
+				Expect(cron.Status.LastImportedPVC).To(BeNil())
+				Expect(cron.Status.History).To(HaveLen(1))
+				Expect(cron.Status.History[0].Action).To(Equal(cdiv1.DataImportCronRunFailed))
+				Expect(cron.Status.History[0].Digest).To(Equal(testDigest))
+				cronCond := FindDataImportCronConditionByType(cron, cdiv1.DataImportCronUpToDate)
+				Expect(cronCond).ToNot(BeNil())
+				verifyConditionState(string(cdiv1.DataImportCronUpToDate), cronCond.ConditionState, false, validationFailed)
+
+				dataSource = &cdiv1.DataSource{}
+				err := reconciler.client.Get(context.TODO(), dataSourceKey(cron), dataSource)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataSource.Spec.Source.PVC).To(BeNil())
+
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, "sha256:0123456789ab"+testDigest[len("sha256:0123456789ab"):])
+				err = reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				Expect(cron.Status.CurrentImports).ToNot(BeEmpty())
+				Expect(cron.Status.CurrentImports[0].DataVolumeName).ToNot(Equal(pvc.Name))
+			})
+		})
+
+		Context("Run history", func() {
+			reconcileAndGetCron := func() {
+				_, err := reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.client.Get(context.TODO(), cronKey, cron)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			setLastCronTime := func(lastCronTime time.Time) {
+				cc.AddAnnotation(cron, AnnLastCronTime, lastCronTime.Format(time.RFC3339))
+				err := reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+			}
+
+			It("Should record imports and skipped polls", func() {
+				pollTime := time.Now().Truncate(time.Second)
+				cron = newDataImportCron(cronName)
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				cc.AddAnnotation(cron, AnnLastCronTime, pollTime.Format(time.RFC3339))
+				reconciler = createDataImportCronReconciler(cron)
+				reconcileAndGetCron()
+				Expect(cron.Status.History).To(BeEmpty())
+
+				dv := &cdiv1.DataVolume{}
+				err := reconciler.client.Get(context.TODO(), dvKey(cron.Status.CurrentImports[0].DataVolumeName), dv)
+				Expect(err).ToNot(HaveOccurred())
+				dv.Status.Phase = cdiv1.Succeeded
+				err = reconciler.client.Update(context.TODO(), dv)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				Expect(cron.Status.History).To(HaveLen(1))
+				Expect(cron.Status.History[0].Action).To(Equal(cdiv1.DataImportCronRunImported))
+				Expect(cron.Status.History[0].Digest).To(Equal(testDigest))
+
+				By("Polling the same digest")
+				setLastCronTime(pollTime.Add(time.Minute))
+				Expect(cron.Status.History).To(HaveLen(2))
+				Expect(cron.Status.History[0].Action).To(Equal(cdiv1.DataImportCronRunSkipped))
+				Expect(cron.Status.History[0].Digest).To(Equal(testDigest))
+				Expect(cron.Status.History[0].Time.Time).To(Equal(pollTime.Add(time.Minute)))
+
+				By("Polling the same digest again, only updating the last skipped run time")
+				setLastCronTime(pollTime.Add(2 * time.Minute))
+				Expect(cron.Status.History).To(HaveLen(2))
+				Expect(cron.Status.History[0].Time.Time).To(Equal(pollTime.Add(2 * time.Minute)))
+				Expect(cron.Status.History[1].Action).To(Equal(cdiv1.DataImportCronRunImported))
+			})
+
+			It("Should record importer errors", func() {
+				cron = newDataImportCron(cronName)
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				reconciler = createDataImportCronReconciler(cron)
+				reconcileAndGetCron()
+
+				dv := &cdiv1.DataVolume{}
+				err := reconciler.client.Get(context.TODO(), dvKey(cron.Status.CurrentImports[0].DataVolumeName), dv)
+				Expect(err).ToNot(HaveOccurred())
+				dv.Status.Phase = cdiv1.ImportInProgress
+				dv.Status.Conditions = []cdiv1.DataVolumeCondition{{
+					Type:              cdiv1.DataVolumeRunning,
+					Status:            corev1.ConditionFalse,
+					Reason:            common.GenericError,
+					Message:           "Unable to process data: manifest unknown",
+					LastHeartbeatTime: metav1.Now(),
+				}}
+				err = reconciler.client.Update(context.TODO(), dv)
+				Expect(err).ToNot(HaveOccurred())
+
+				for i := 0; i < 2; i++ {
+					reconcileAndGetCron()
+					Expect(cron.Status.History).To(HaveLen(1))
+					Expect(cron.Status.History[0].Action).To(Equal(cdiv1.DataImportCronRunFailed))
+					Expect(cron.Status.History[0].Digest).To(Equal(testDigest))
+					Expect(cron.Status.History[0].Message).To(Equal("Unable to process data: manifest unknown"))
+				}
+			})
+
+			DescribeTable("Should delete a DataVolume failed with a structured error once the new version is polled", func(reason, message string) {
+				cron = newDataImportCron(cronName)
+				cron.Spec.Template.Spec.Source = &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: testHTTPURL}}
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				reconciler = createDataImportCronReconciler(cron)
+				reconcileAndGetCron()
+
+				dv := &cdiv1.DataVolume{}
+				dvName := cron.Status.CurrentImports[0].DataVolumeName
+				err := reconciler.client.Get(context.TODO(), dvKey(dvName), dv)
+				Expect(err).ToNot(HaveOccurred())
+				dv.Status.Phase = cdiv1.ImportInProgress
+				dv.Status.Conditions = []cdiv1.DataVolumeCondition{{
+					Type:              cdiv1.DataVolumeRunning,
+					Status:            corev1.ConditionFalse,
+					Reason:            reason,
+					Message:           message,
+					LastHeartbeatTime: metav1.Now(),
+				}}
+				err = reconciler.client.Update(context.TODO(), dv)
+				Expect(err).ToNot(HaveOccurred())
+
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, "sha256:0123456789ab"+testDigest[len("sha256:0123456789ab"):])
+				err = reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+
+				err = reconciler.client.Get(context.TODO(), dvKey(dvName), dv)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			},
+				Entry("on a changed source", util.ErrorCodeSourceChanged, "Unable to process data: source changed since it was polled"),
+				Entry("on a missing source", util.ErrorCodeHTTPNotFound, "Unable to connect to http data source: expected status code 200, got 404. Status: 404 Not Found"),
+			)
+
+			It("Should record poller Job failures with the poller termination message", func() {
+				const pollerError = "Failed to get registry source digest: unauthorized"
+				cron = newDataImportCron(cronName)
+				reconciler = createDataImportCronReconciler(cron)
+				reconcileAndGetCron()
+
+				cronJob := &batchv1.CronJob{}
+				err := reconciler.client.Get(context.TODO(), cronJobKey(cron), cronJob)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cronJob.Spec.JobTemplate.Labels[common.DataImportCronLabel]).To(Equal(getCronJobLabelValue(cron.Namespace, cron.Name)))
+
+				job := &batchv1.Job{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: GetInitialJobName(cron), Namespace: reconciler.cdiNamespace}, job)
+				Expect(err).ToNot(HaveOccurred())
+				jobCron, ok := getPollerJobCron(job)
+				Expect(ok).To(BeTrue())
+				Expect(jobCron).To(Equal(cronKey))
+
+				job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Now()}}
+				err = reconciler.client.Status().Update(context.TODO(), job)
+				Expect(err).ToNot(HaveOccurred())
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      job.Name + "-pod",
+						Namespace: job.Namespace,
+						Labels:    map[string]string{jobNameLabel: job.Name},
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{
+							State: corev1.ContainerState{
+								Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: pollerError},
+							},
+						}},
+					},
+				}
+				err = reconciler.client.Create(context.TODO(), pod)
+				Expect(err).ToNot(HaveOccurred())
+
+				for i := 0; i < 2; i++ {
+					reconcileAndGetCron()
+					Expect(cron.Status.History).To(HaveLen(1))
+					Expect(cron.Status.History[0].Action).To(Equal(cdiv1.DataImportCronRunFailed))
+					Expect(cron.Status.History[0].Message).To(Equal(pollerError))
+				}
+			})
+
+			It("Should label the outdated metric with the UpToDate reason", func() {
+				cron = newDataImportCron(cronName)
+				reconciler = createDataImportCronReconciler(cron)
+				reconcileAndGetCron()
+				labels := getPrometheusCronReasonLabels(cronKey, noDigest)
+				Expect(testutil.ToFloat64(DataImportCronOutdatedGauge.With(labels))).To(Equal(float64(1)))
+
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				err := reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				Expect(DataImportCronOutdatedGauge.Delete(labels)).To(BeFalse())
+				labels = getPrometheusCronReasonLabels(cronKey, outdated)
+				Expect(testutil.ToFloat64(DataImportCronOutdatedGauge.With(labels))).To(Equal(float64(1)))
+
+				err = reconciler.cleanup(context.TODO(), cronKey)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(DataImportCronOutdatedGauge.Delete(labels)).To(BeFalse())
+			})
+
+			It("Should delete an outdated metric series whose reason was not persisted", func() {
+				cron = newDataImportCron(cronName)
+				staleLabels := getPrometheusCronReasonLabels(cronKey, inProgress)
+				DataImportCronOutdatedGauge.With(staleLabels).Set(1)
+
+				updateDataImportCronCondition(cron, cdiv1.DataImportCronUpToDate, corev1.ConditionFalse, "No digest", noDigest)
+				Expect(DataImportCronOutdatedGauge.Delete(staleLabels)).To(BeFalse())
+				labels := getPrometheusCronReasonLabels(cronKey, noDigest)
+				Expect(testutil.ToFloat64(DataImportCronOutdatedGauge.With(labels))).To(Equal(float64(1)))
+				DataImportCronOutdatedGauge.DeletePartialMatch(getPrometheusCronLabels(cronKey))
+			})
+		})
+
 		It("Should not create DV if PVC exists on DesiredDigest update; Should update DIC and DAS, and GC LRU PVCs", func() {
 			const nPVCs = 3
 			var (
@@ -543,6 +937,97 @@ var _ = Describe("All DataImportCron Tests", func() {
 			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
 		})
 
+		It("Should not update a pinned DataSource, and should not garbage collect its source", func() {
+			digests := []string{"sha256:" + strings.Repeat("0", 12), "sha256:" + strings.Repeat("1", 12)}
+			cron = newDataImportCron(cronName)
+			cron.Spec.ImportsToKeep = pointer.Int32(1)
+			reconciler = createDataImportCronReconciler(cron)
+			var pvcs []*corev1.PersistentVolumeClaim
+			for _, digest := range digests {
+				pvc := cc.CreatePvc(dataSourceName+"-"+strings.TrimPrefix(digest, "sha256:"), cron.Namespace, nil, nil)
+				err := reconciler.client.Create(context.TODO(), pvc)
+				Expect(err).ToNot(HaveOccurred())
+				pvcs = append(pvcs, pvc)
+			}
+
+			importDigest := func(digest string) {
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, digest)
+				err := reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.client.Get(context.TODO(), cronKey, cron)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			importDigest(digests[0])
+			dataSource = &cdiv1.DataSource{}
+			err := reconciler.client.Get(context.TODO(), dataSourceKey(cron), dataSource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataSource.Spec.Source.PVC.Name).To(Equal(pvcs[0].Name))
+			Expect(dataSource.Annotations[AnnSourceDigest]).To(Equal(digests[0]))
+
+			dataSource.Spec.PinnedRevision = pointer.Int64(1)
+			err = reconciler.client.Update(context.TODO(), dataSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			importDigest(digests[1])
+			Expect(cron.Status.LastImportedPVC.Name).To(Equal(pvcs[1].Name))
+			err = reconciler.client.Get(context.TODO(), dataSourceKey(cron), dataSource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataSource.Spec.Source.PVC.Name).To(Equal(pvcs[0].Name))
+			Expect(dataSource.Annotations[AnnSourceDigest]).To(Equal(digests[0]))
+
+			By("Verifying the pinned source was not garbage collected")
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = reconciler.client.Get(context.TODO(), dvKey(pvcs[0].Name), pvc)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should not garbage collect the source of a pinned revision", func() {
+			cron = newDataImportCron(cronName)
+			dataSource = &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{Name: dataSourceName, Namespace: cron.Namespace},
+				Spec: cdiv1.DataSourceSpec{
+					Source:         cdiv1.DataSourceSource{PVC: &cdiv1.DataVolumeSourcePVC{Name: "latest"}},
+					PinnedRevision: pointer.Int64(1),
+				},
+				Status: cdiv1.DataSourceStatus{
+					Revisions: []cdiv1.DataSourceRevision{{
+						Revision: 1,
+						Source:   cdiv1.DataSourceSource{PVC: &cdiv1.DataVolumeSourcePVC{Name: "pinned"}},
+					}},
+				},
+			}
+			reconciler = createDataImportCronReconciler(cron, dataSource)
+			protected, err := reconciler.getProtectedImportSources(context.TODO(), cron.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(protected.HasAll(importSourceKey(pvcSourceKind, "latest"), importSourceKey(pvcSourceKind, "pinned"))).To(BeTrue())
+		})
+
+		It("Should protect only the source kind a DataSource points to, in its namespace", func() {
+			cron = newDataImportCron(cronName)
+			snapshotDataSource := &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot-source", Namespace: cron.Namespace},
+				Spec: cdiv1.DataSourceSpec{
+					Source: cdiv1.DataSourceSource{Snapshot: &cdiv1.DataVolumeSourceSnapshot{Name: "import"}},
+				},
+			}
+			otherNamespaceDataSource := &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-source", Namespace: "other"},
+				Spec: cdiv1.DataSourceSpec{
+					Source: cdiv1.DataSourceSource{PVC: &cdiv1.DataVolumeSourcePVC{Name: "import"}},
+				},
+			}
+			reconciler = createDataImportCronReconciler(cron, snapshotDataSource, otherNamespaceDataSource)
+			protected, err := reconciler.getProtectedImportSources(context.TODO(), cron.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(protected.Has(importSourceKey(snapshotSourceKind, "import"))).To(BeTrue())
+			Expect(protected.Has(importSourceKey(pvcSourceKind, "import"))).To(BeFalse())
+		})
+
 		It("Should reconcile only if DataSource is not labeled by another existing DIC", func() {
 			cron = newDataImportCron(cronName)
 			reconciler = createDataImportCronReconciler(cron)
@@ -802,6 +1287,340 @@ var _ = Describe("All DataImportCron Tests", func() {
 			expectLabels(dataSource.Labels)
 		})
 
+		Context("Storage class targets", func() {
+			const targetStorageClassName = "target-sc"
+
+			BeforeEach(func() {
+				pvcFormat := cdiv1.DataImportCronSourceFormatPvc
+				newStorageProfile := func(name string) *cdiv1.StorageProfile {
+					return &cdiv1.StorageProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Status:     cdiv1.StorageProfileStatus{DataImportCronSourceFormat: &pvcFormat},
+					}
+				}
+				cron = newDataImportCron(cronName)
+				cron.Spec.StorageClassTargets = []cdiv1.DataImportCronStorageClassTarget{{StorageClassName: targetStorageClassName}}
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+				reconciler = createDataImportCronReconciler(cron,
+					cc.CreateStorageClass(storageClassName, map[string]string{cc.AnnDefaultStorageClass: "true"}),
+					cc.CreateStorageClass(targetStorageClassName, nil),
+					newStorageProfile(storageClassName),
+					newStorageProfile(targetStorageClassName))
+			})
+
+			reconcileAndGetCron := func() {
+				_, err := reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.client.Get(context.TODO(), cronKey, cron)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			succeedDataVolume := func(name string) *cdiv1.DataVolume {
+				dv := &cdiv1.DataVolume{}
+				err := reconciler.client.Get(context.TODO(), dvKey(name), dv)
+				Expect(err).ToNot(HaveOccurred())
+				dv.Status.Phase = cdiv1.Succeeded
+				err = reconciler.client.Update(context.TODO(), dv)
+				Expect(err).ToNot(HaveOccurred())
+				pvc := cc.CreatePvc(dv.Name, dv.Namespace, nil, dv.Labels)
+				err = reconciler.client.Create(context.TODO(), pvc)
+				Expect(err).ToNot(HaveOccurred())
+				return dv
+			}
+
+			It("Should clone the import to the storage class target, and update its DataSource", func() {
+				reconcileAndGetCron()
+				importName := cron.Status.CurrentImports[0].DataVolumeName
+				succeedDataVolume(importName)
+				reconcileAndGetCron()
+				Expect(cron.Status.LastImportedPVC.Name).To(Equal(importName))
+
+				copyName := getTargetCopyName(importName, targetStorageClassName)
+				dv := &cdiv1.DataVolume{}
+				err := reconciler.client.Get(context.TODO(), dvKey(copyName), dv)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dv.Spec.Source.PVC).ToNot(BeNil())
+				Expect(dv.Spec.Source.PVC.Name).To(Equal(importName))
+				Expect(*dv.Spec.Storage.StorageClassName).To(Equal(targetStorageClassName))
+				Expect(dv.Labels[common.DataImportCronLabel]).To(Equal(cron.Name))
+				Expect(dv.Labels[common.DataImportCronStorageClassLabel]).To(Equal(targetStorageClassName))
+
+				Expect(cron.Status.StorageClassTargets).To(HaveLen(1))
+				targetStatus := cron.Status.StorageClassTargets[0]
+				Expect(targetStatus.LastImportedPVC).To(BeNil())
+				Expect(targetStatus.ManagedDataSource).To(Equal(dataSourceName + "-" + targetStorageClassName))
+
+				succeedDataVolume(copyName)
+				reconcileAndGetCron()
+				targetStatus = cron.Status.StorageClassTargets[0]
+				Expect(targetStatus.LastImportedPVC).ToNot(BeNil())
+				Expect(targetStatus.LastImportedPVC.Name).To(Equal(copyName))
+				Expect(*targetStatus.SourceFormat).To(Equal(cdiv1.DataImportCronSourceFormatPvc))
+
+				dataSource = &cdiv1.DataSource{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Namespace: cron.Namespace, Name: targetStatus.ManagedDataSource}, dataSource)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataSource.Spec.Source.PVC.Name).To(Equal(copyName))
+				Expect(dataSource.Annotations[AnnSourceDigest]).To(Equal(testDigest))
+				Expect(dataSource.Labels[common.DataImportCronLabel]).To(Equal(cron.Name))
+
+				By("Verifying the primary DataSource still points to the import")
+				err = reconciler.client.Get(context.TODO(), dataSourceKey(cron), dataSource)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataSource.Spec.Source.PVC.Name).To(Equal(importName))
+			})
+
+			It("Should not garbage collect the storage class target copies with the primary imports", func() {
+				importsToKeep := int32(1)
+				cron.Spec.ImportsToKeep = &importsToKeep
+				err := reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				importName := cron.Status.CurrentImports[0].DataVolumeName
+				succeedDataVolume(importName)
+				reconcileAndGetCron()
+				copyName := getTargetCopyName(importName, targetStorageClassName)
+				succeedDataVolume(copyName)
+				reconcileAndGetCron()
+
+				err = reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				for _, name := range []string{importName, copyName} {
+					err = reconciler.client.Get(context.TODO(), dvKey(name), &corev1.PersistentVolumeClaim{})
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+		})
+
+		Context("Import scheduling", func() {
+			reconcileAndGetCron := func() reconcile.Result {
+				res, err := reconciler.Reconcile(context.TODO(), cronReq)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.client.Get(context.TODO(), cronKey, cron)
+				Expect(err).ToNot(HaveOccurred())
+				return res
+			}
+
+			verifyQueued := func(res reconcile.Result) {
+				Expect(cron.Status.CurrentImports).To(BeEmpty())
+				Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+				cronCond := FindDataImportCronConditionByType(cron, cdiv1.DataImportCronProgressing)
+				Expect(cronCond).ToNot(BeNil())
+				verifyConditionState(string(cdiv1.DataImportCronProgressing), cronCond.ConditionState, false, importQueued)
+			}
+
+			BeforeEach(func() {
+				cron = newDataImportCron(cronName)
+				cc.AddAnnotation(cron, AnnSourceDesiredDigest, testDigest)
+			})
+
+			It("Should start the polls delayed by the schedule jitter", func() {
+				cron.Spec.ScheduleJitter = &metav1.Duration{Duration: time.Hour}
+				reconciler = createDataImportCronReconciler(cron)
+				res := reconcileAndGetCron()
+				Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+
+				cronjob := &batchv1.CronJob{}
+				err := reconciler.client.Get(context.TODO(), cronJobKey(cron), cronjob)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cronjob.Spec.Suspend).To(HaveValue(BeTrue()))
+				Expect(cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Command).ToNot(ContainElement("-delay"))
+				nextTime, err := time.Parse(time.RFC3339, cron.Annotations[AnnNextCronTime])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nextTime).To(BeTemporally("~", time.Now().Add(res.RequeueAfter), 2*time.Second))
+
+				By("Skipping the poll while the initial poll is running")
+				pollTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+				cron.Annotations[AnnNextCronTime] = pollTime.Format(time.RFC3339)
+				Expect(reconciler.client.Update(context.TODO(), cron)).To(Succeed())
+				reconcileAndGetCron()
+				pollJobKey := types.NamespacedName{Namespace: reconciler.cdiNamespace, Name: getDelayedPollJobName(cron, pollTime)}
+				err = reconciler.client.Get(context.TODO(), pollJobKey, &batchv1.Job{})
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				By("Starting the poll once the previous one completed")
+				initialJob := &batchv1.Job{}
+				Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Namespace: reconciler.cdiNamespace, Name: GetInitialJobName(cron)}, initialJob)).To(Succeed())
+				initialJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+				Expect(reconciler.client.Status().Update(context.TODO(), initialJob)).To(Succeed())
+				cron.Annotations[AnnNextCronTime] = pollTime.Format(time.RFC3339)
+				Expect(reconciler.client.Update(context.TODO(), cron)).To(Succeed())
+				reconcileAndGetCron()
+				pollJob := &batchv1.Job{}
+				Expect(reconciler.client.Get(context.TODO(), pollJobKey, pollJob)).To(Succeed())
+				Expect(pollJob.Labels[common.DataImportCronLabel]).To(Equal(getCronJobLabelValue(cron.Namespace, cron.Name)))
+				Expect(pollJob.Spec.Template.Spec.Containers[0].Command).To(Equal(cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Command))
+			})
+
+			It("Should queue the import until the import window opens", func() {
+				now := time.Now().UTC()
+				cron.Spec.ImportWindow = &cdiv1.DataImportCronImportWindow{
+					Start: now.Add(2 * time.Hour).Format("15:04"),
+					End:   now.Add(3 * time.Hour).Format("15:04"),
+				}
+				reconciler = createDataImportCronReconciler(cron)
+				res := reconcileAndGetCron()
+				verifyQueued(res)
+				Expect(res.RequeueAfter).To(BeNumerically("<=", 2*time.Hour))
+
+				cron.Spec.ImportWindow.Start = now.Add(-time.Hour).Format("15:04")
+				err := reconciler.client.Update(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				Expect(cron.Status.CurrentImports).To(HaveLen(1))
+			})
+
+			It("Should queue the import while the maximum of concurrent cron imports are in progress", func() {
+				otherImport := &cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-import",
+						Namespace: "other-ns",
+						Labels:    map[string]string{common.DataImportCronLabel: "other-cron"},
+					},
+					Status: cdiv1.DataVolumeStatus{Phase: cdiv1.ImportInProgress},
+				}
+				reconciler = createDataImportCronReconciler(cron, otherImport)
+				cdiConfig := &cdiv1.CDIConfig{}
+				err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+				Expect(err).ToNot(HaveOccurred())
+				cdiConfig.Spec.DataImportCronMaxConcurrentImports = pointer.Int32(1)
+				err = reconciler.client.Update(context.TODO(), cdiConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				verifyQueued(reconcileAndGetCron())
+
+				otherImport.Status.Phase = cdiv1.Succeeded
+				err = reconciler.client.Update(context.TODO(), otherImport)
+				Expect(err).ToNot(HaveOccurred())
+				reconcileAndGetCron()
+				Expect(cron.Status.CurrentImports).To(HaveLen(1))
+			})
+		})
+
+		Context("Import retention", func() {
+			createImportPvcs := func(sizes ...string) []*corev1.PersistentVolumeClaim {
+				var pvcs []*corev1.PersistentVolumeClaim
+				for i, size := range sizes {
+					lastUse := time.Now().Add(-time.Duration(i) * 24 * time.Hour).UTC().Format(time.RFC3339Nano)
+					pvc := cc.CreatePvc(fmt.Sprintf("%s-%d", dataSourceName, i), cron.Namespace,
+						map[string]string{AnnLastUseTime: lastUse}, map[string]string{common.DataImportCronLabel: cron.Name})
+					pvc.Status.Capacity[corev1.ResourceStorage] = resource.MustParse(size)
+					err := reconciler.client.Create(context.TODO(), pvc)
+					Expect(err).ToNot(HaveOccurred())
+					pvcs = append(pvcs, pvc)
+				}
+				return pvcs
+			}
+
+			verifyKept := func(pvcs []*corev1.PersistentVolumeClaim, kept ...bool) {
+				for i, pvc := range pvcs {
+					err := reconciler.client.Get(context.TODO(), dvKey(pvc.Name), &corev1.PersistentVolumeClaim{})
+					if kept[i] {
+						Expect(err).ToNot(HaveOccurred(), pvc.Name)
+					} else {
+						Expect(k8serrors.IsNotFound(err)).To(BeTrue(), pvc.Name)
+					}
+				}
+			}
+
+			BeforeEach(func() {
+				cron = newDataImportCron(cronName)
+				cron.Spec.ImportsToKeep = pointer.Int32(1)
+			})
+
+			It("Should keep imports newer than ImportsMaxAge, but at least ImportsToKeep", func() {
+				cron.Spec.ImportsMaxAge = &metav1.Duration{Duration: 36 * time.Hour}
+				reconciler = createDataImportCronReconciler(cron)
+				pvcs := createImportPvcs("1Gi", "1Gi", "1Gi", "1Gi")
+
+				err := reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, true, false, false)
+
+				By("Keeping ImportsToKeep imports, even if they are older")
+				cron.Spec.ImportsMaxAge = &metav1.Duration{Duration: time.Minute}
+				err = reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, false, false, false)
+			})
+
+			It("Should keep imports within ImportsMaxTotalSize, but at least ImportsToKeep", func() {
+				maxTotalSize := resource.MustParse("10Gi")
+				cron.Spec.ImportsMaxTotalSize = &maxTotalSize
+				reconciler = createDataImportCronReconciler(cron)
+				pvcs := createImportPvcs("12Gi", "4Gi", "4Gi", "1Gi")
+
+				err := reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, false, false, false)
+
+				maxTotalSize = resource.MustParse("20Gi")
+				reconciler = createDataImportCronReconciler(cron)
+				pvcs = createImportPvcs("12Gi", "4Gi", "8Gi", "1Gi")
+
+				err = reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, true, false, true)
+			})
+
+			It("Should not garbage collect imports referenced by a DataSource or cloned", func() {
+				reconciler = createDataImportCronReconciler(cron)
+				pvcs := createImportPvcs("1Gi", "1Gi", "1Gi", "1Gi", "1Gi", "1Gi")
+
+				dataSource := &cdiv1.DataSource{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-datasource", Namespace: "other-ns"},
+					Spec: cdiv1.DataSourceSpec{
+						Source: cdiv1.DataSourceSource{
+							PVC: &cdiv1.DataVolumeSourcePVC{Namespace: cron.Namespace, Name: pvcs[1].Name},
+						},
+					},
+				}
+				cloneDv := &cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "clone-dv", Namespace: "other-ns"},
+					Spec: cdiv1.DataVolumeSpec{
+						Source: &cdiv1.DataVolumeSource{
+							PVC: &cdiv1.DataVolumeSourcePVC{Namespace: cron.Namespace, Name: pvcs[2].Name},
+						},
+					},
+					Status: cdiv1.DataVolumeStatus{Phase: cdiv1.CloneInProgress},
+				}
+				volumeCloneSource := &cdiv1.VolumeCloneSource{
+					ObjectMeta: metav1.ObjectMeta{Name: "clone-source", Namespace: cron.Namespace},
+					Spec: cdiv1.VolumeCloneSourceSpec{
+						Source: corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: pvcs[3].Name},
+					},
+				}
+				sourcePod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "clone-source-pod", Namespace: cron.Namespace},
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name: "source",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvcs[4].Name},
+							},
+						}},
+					},
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+				}
+				for _, obj := range []client.Object{dataSource, cloneDv, volumeCloneSource, sourcePod} {
+					err := reconciler.client.Create(context.TODO(), obj)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				err := reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, true, true, true, true, false)
+
+				By("Deleting the import once its clone succeeded")
+				cloneDv.Status.Phase = cdiv1.Succeeded
+				err = reconciler.client.Update(context.TODO(), cloneDv)
+				Expect(err).ToNot(HaveOccurred())
+				err = reconciler.garbageCollectOldImports(context.TODO(), cron)
+				Expect(err).ToNot(HaveOccurred())
+				verifyKept(pvcs, true, true, false, true, true, false)
+			})
+		})
+
 		Context("Snapshot source format", func() {
 			snapFormat := cdiv1.DataImportCronSourceFormatSnapshot
 
@@ -1108,7 +1927,10 @@ func createDataImportCronReconcilerWithoutConfig(objects ...runtime.Object) *Dat
 	_ = extv1.AddToScheme(s)
 	_ = snapshotv1.AddToScheme(s)
 
-	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithIndex(&cdiv1.DataSource{}, dataSourceSourceNamespaceField, indexDataSourceSourceNamespace).
+		WithIndex(&cdiv1.DataVolume{}, dvInProgressSourceNamespaceField, indexDvInProgressSourceNamespace).
+		Build()
 	rec := record.NewFakeRecorder(10)
 	r := &DataImportCronReconciler{
 		client:         cl,
@@ -1206,3 +2028,112 @@ func getEnvVar(env []corev1.EnvVar, name string) string {
 	}
 	return ""
 }
+
+var _ = Describe("DataImportCron scheduling", func() {
+	It("Should derive a fixed schedule jitter delay from the cron UID", func() {
+		cron := newDataImportCron(cronName)
+		Expect(getScheduleJitterDelay(cron)).To(BeZero())
+		cron.Spec.ScheduleJitter = &metav1.Duration{Duration: time.Hour}
+		delay := getScheduleJitterDelay(cron)
+		Expect(delay).To(BeNumerically("<", time.Hour))
+		Expect(getScheduleJitterDelay(cron)).To(Equal(delay))
+	})
+
+	It("Should not skip a scheduled poll which is still delayed by the jitter", func() {
+		cron := newDataImportCron(cronName)
+		cron.Spec.Schedule = "0 0 * * *"
+		cron.Spec.ScheduleJitter = &metav1.Duration{Duration: time.Hour}
+		delay := getScheduleJitterDelay(cron)
+		midnight := time.Date(2023, 6, 1, 0, 0, 0, 0, time.Local)
+		next, err := getNextCronTime(cron, midnight.Add(delay/2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(midnight.Add(delay)))
+		next, err = getNextCronTime(cron, midnight.Add(delay+time.Second))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(midnight.AddDate(0, 0, 1).Add(delay)))
+	})
+
+	// 2023-06-05 is a Monday
+	DescribeTable("Should compute the time until the import window opens", func(window cdiv1.DataImportCronImportWindow, now string, expected time.Duration) {
+		w, err := parseImportWindow(&window)
+		Expect(err).ToNot(HaveOccurred())
+		t, err := time.Parse(time.RFC3339, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.untilOpen(t)).To(Equal(expected))
+	},
+		Entry("inside the window", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "05:00"}, "2023-06-05T02:00:00Z", time.Duration(0)),
+		Entry("before the window", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "05:00"}, "2023-06-05T00:30:00Z", 30*time.Minute),
+		Entry("after the window", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "05:00"}, "2023-06-05T06:00:00Z", 19*time.Hour),
+		Entry("window ending the next day", cdiv1.DataImportCronImportWindow{Start: "22:00", End: "02:00"}, "2023-06-06T01:00:00Z", time.Duration(0)),
+		Entry("weekday window on saturday", cdiv1.DataImportCronImportWindow{Start: "01:00", End: "05:00", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}},
+			"2023-06-10T02:00:00Z", 47*time.Hour),
+	)
+
+	It("Should count reserved imports the cache doesn't list yet against the limit", func() {
+		now := time.Now()
+		first := types.NamespacedName{Namespace: "ns", Name: "first"}
+		second := types.NamespacedName{Namespace: "ns", Name: "second"}
+		none := sets.New[types.NamespacedName]()
+		q := &importReservations{}
+
+		count, reserved := q.reserve(first, 1, none, none, now)
+		Expect(reserved).To(BeTrue())
+		Expect(count).To(Equal(1))
+		_, reserved = q.reserve(second, 1, none, none, now)
+		Expect(reserved).To(BeFalse())
+
+		By("Dropping the reservation once the cache lists the import")
+		listed := sets.New(first)
+		_, reserved = q.reserve(second, 1, listed, listed, now)
+		Expect(reserved).To(BeFalse())
+		_, reserved = q.reserve(second, 1, listed, none, now)
+		Expect(reserved).To(BeTrue())
+
+		By("Dropping an expired reservation")
+		_, reserved = q.reserve(first, 1, none, none, now.Add(importReservationTimeout+time.Second))
+		Expect(reserved).To(BeTrue())
+	})
+})
+
+var _ = Describe("DataImportCron run history", func() {
+	base := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	newRun := func(minutes int, action cdiv1.DataImportCronRunAction, digest string) cdiv1.DataImportCronRun {
+		return cdiv1.DataImportCronRun{Time: metav1.NewTime(base.Add(time.Duration(minutes) * time.Minute)), Action: action, Digest: digest}
+	}
+
+	It("Should keep the history sorted by time, most recent first", func() {
+		cron := newDataImportCron(cronName)
+		addDataImportCronRun(cron, newRun(2, cdiv1.DataImportCronRunImported, "sha256:2"))
+		addDataImportCronRun(cron, newRun(1, cdiv1.DataImportCronRunFailed, ""))
+		addDataImportCronRun(cron, newRun(3, cdiv1.DataImportCronRunSkipped, "sha256:2"))
+		Expect(cron.Status.History).To(Equal([]cdiv1.DataImportCronRun{
+			newRun(3, cdiv1.DataImportCronRunSkipped, "sha256:2"),
+			newRun(2, cdiv1.DataImportCronRunImported, "sha256:2"),
+			newRun(1, cdiv1.DataImportCronRunFailed, ""),
+		}))
+	})
+
+	It("Should not add a run already in the history", func() {
+		cron := newDataImportCron(cronName)
+		addDataImportCronRun(cron, newRun(1, cdiv1.DataImportCronRunFailed, ""))
+		addDataImportCronRun(cron, newRun(2, cdiv1.DataImportCronRunImported, "sha256:2"))
+		addDataImportCronRun(cron, newRun(1, cdiv1.DataImportCronRunFailed, ""))
+		Expect(cron.Status.History).To(HaveLen(2))
+	})
+
+	It("Should merge a run repeating the most recent one", func() {
+		cron := newDataImportCron(cronName)
+		addDataImportCronRun(cron, newRun(1, cdiv1.DataImportCronRunSkipped, "sha256:1"))
+		addDataImportCronRun(cron, newRun(2, cdiv1.DataImportCronRunSkipped, "sha256:1"))
+		Expect(cron.Status.History).To(Equal([]cdiv1.DataImportCronRun{newRun(2, cdiv1.DataImportCronRunSkipped, "sha256:1")}))
+	})
+
+	It("Should bound the history", func() {
+		cron := newDataImportCron(cronName)
+		for i := 0; i < 2*dataImportCronHistoryLimit; i++ {
+			addDataImportCronRun(cron, newRun(i, cdiv1.DataImportCronRunImported, fmt.Sprintf("sha256:%d", i)))
+		}
+		Expect(cron.Status.History).To(HaveLen(dataImportCronHistoryLimit))
+		Expect(cron.Status.History[0].Digest).To(Equal(fmt.Sprintf("sha256:%d", 2*dataImportCronHistoryLimit-1)))
+	})
+})
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cdv "kubevirt.io/containerized-data-importer/pkg/controller/datavolume"
+)
+
+const (
+	// dataImportCronHistoryLimit is the number of runs kept in the DataImportCron status history
+	dataImportCronHistoryLimit = 10
+
+	// jobNameLabel is set by the Job controller on the Job pods
+	jobNameLabel = "job-name"
+)
+
+// addDataImportCronRun adds a run to the cron history, which is kept sorted by time, most recent first.
+// A run repeating the most recent one only updates its time, so repeated polls or failures don't evict past imports.
+func addDataImportCronRun(cron *cdiv1.DataImportCron, run cdiv1.DataImportCronRun) {
+	// The status is serialized with a second precision
+	run.Time = run.Time.Rfc3339Copy()
+	history := cron.Status.History
+	for i := range history {
+		if isSameDataImportCronRun(&history[i], &run) && history[i].Time.Equal(&run.Time) {
+			return
+		}
+	}
+	if len(history) > 0 && isSameDataImportCronRun(&history[0], &run) {
+		if history[0].Time.Before(&run.Time) {
+			history[0].Time = run.Time
+		}
+		return
+	}
+
+	idx := sort.Search(len(history), func(i int) bool { return history[i].Time.Before(&run.Time) })
+	history = append(history, cdiv1.DataImportCronRun{})
+	copy(history[idx+1:], history[idx:])
+	history[idx] = run
+	if len(history) > dataImportCronHistoryLimit {
+		history = history[:dataImportCronHistoryLimit]
+	}
+	cron.Status.History = history
+}
+
+func isSameDataImportCronRun(a, b *cdiv1.DataImportCronRun) bool {
+	return a.Action == b.Action && a.Digest == b.Digest && a.Message == b.Message
+}
+
+// recordSkippedPoll adds a skipped run for a new poll which found no digest update
+func recordSkippedPoll(cron *cdiv1.DataImportCron, digest string) error {
+	lastTimeStr := cron.Annotations[AnnLastCronTime]
+	if lastTimeStr == "" || digest == "" {
+		return nil
+	}
+	lastTime, err := time.Parse(time.RFC3339, lastTimeStr)
+	if err != nil {
+		return err
+	}
+	if ts := cron.Status.LastExecutionTimestamp; ts != nil && ts.Time.Equal(lastTime) {
+		return nil
+	}
+	addDataImportCronRun(cron, cdiv1.DataImportCronRun{
+		Time:   metav1.NewTime(lastTime),
+		Digest: digest,
+		Action: cdiv1.DataImportCronRunSkipped,
+	})
+	return nil
+}
+
+// recordDataVolumeFailure adds a failed run for an import DataVolume whose importer reported an error
+func recordDataVolumeFailure(cron *cdiv1.DataImportCron, dv *cdiv1.DataVolume) {
+	cond := cdv.FindConditionByType(cdiv1.DataVolumeRunning, dv.Status.Conditions)
+	if cond == nil || cond.Status != corev1.ConditionFalse || cond.Reason != common.GenericError {
+		return
+	}
+	addDataImportCronRun(cron, cdiv1.DataImportCronRun{
+		Time:    cond.LastHeartbeatTime,
+		Digest:  getCurrentImportDigest(cron),
+		Action:  cdiv1.DataImportCronRunFailed,
+		Message: cond.Message,
+	})
+}
+
+// recordPollerFailures adds a failed run for each failed poller Job of the cron
+func (r *DataImportCronReconciler) recordPollerFailures(ctx context.Context, cron *cdiv1.DataImportCron) error {
+	jobList := &batchv1.JobList{}
+	if err := r.client.List(ctx, jobList, client.InNamespace(r.cdiNamespace),
+		client.MatchingLabels{common.DataImportCronLabel: getCronJobLabelValue(cron.Namespace, cron.Name)}); err != nil {
+		return err
+	}
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		cond := getJobCondition(job, batchv1.JobFailed)
+		if cond == nil {
+			continue
+		}
+		msg, err := r.getJobFailureMessage(ctx, job, cond.Message)
+		if err != nil {
+			return err
+		}
+		addDataImportCronRun(cron, cdiv1.DataImportCronRun{
+			Time:    cond.LastTransitionTime,
+			Action:  cdiv1.DataImportCronRunFailed,
+			Message: msg,
+		})
+	}
+	return nil
+}
+
+// recordValidationFailure adds a failed run for a failed import validation Job
+func (r *DataImportCronReconciler) recordValidationFailure(ctx context.Context, cron *cdiv1.DataImportCron, job *batchv1.Job, defaultMsg string) error {
+	cond := getJobCondition(job, batchv1.JobFailed)
+	msg, err := r.getJobFailureMessage(ctx, job, defaultMsg)
+	if err != nil {
+		return err
+	}
+	addDataImportCronRun(cron, cdiv1.DataImportCronRun{
+		Time:    cond.LastTransitionTime,
+		Digest:  getCurrentImportDigest(cron),
+		Action:  cdiv1.DataImportCronRunFailed,
+		Message: msg,
+	})
+	return nil
+}
+
+// getJobFailureMessage returns the termination message of the last failed Job pod, or the default message
+func (r *DataImportCronReconciler) getJobFailureMessage(ctx context.Context, job *batchv1.Job, defaultMsg string) (string, error) {
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList, client.InNamespace(job.Namespace), client.MatchingLabels{jobNameLabel: job.Name}); err != nil {
+		return "", err
+	}
+	msg := defaultMsg
+	var lastFinished metav1.Time
+	for _, pod := range podList.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 || terminated.Message == "" || terminated.FinishedAt.Before(&lastFinished) {
+				continue
+			}
+			msg = terminated.Message
+			lastFinished = terminated.FinishedAt
+		}
+	}
+	return msg, nil
+}
+
+// getPollerJobCron returns the cron of a poller Job, unless the cron label value was truncated
+func getPollerJobCron(obj client.Object) (types.NamespacedName, bool) {
+	label := obj.GetLabels()[common.DataImportCronLabel]
+	if label == "" || isValidationJob(obj) || len(label) >= validation.DNS1035LabelMaxLength {
+		return types.NamespacedName{}, false
+	}
+	// Namespace names can't contain dots
+	namespace, name, ok := strings.Cut(label, ".")
+	return types.NamespacedName{Namespace: namespace, Name: name}, ok
+}
+
+func getCurrentImportDigest(cron *cdiv1.DataImportCron) string {
+	if imports := cron.Status.CurrentImports; len(imports) > 0 {
+		return imports[0].Digest
+	}
+	return ""
+}
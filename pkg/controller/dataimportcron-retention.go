@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+// importRetention decides which DataImportCron imports are kept by the garbage collection.
+// Imports are expected newest first. The first minImports are always kept, and when an age or size
+// policy is set, the following ones are kept as long as they are within the policy.
+type importRetention struct {
+	minImports   int
+	maxAge       *time.Duration
+	maxTotalSize *resource.Quantity
+	now          time.Time
+
+	count     int
+	totalSize resource.Quantity
+}
+
+func newImportRetention(cron *cdiv1.DataImportCron) *importRetention {
+	retention := &importRetention{
+		minImports:   getImportsToKeep(cron),
+		maxTotalSize: cron.Spec.ImportsMaxTotalSize,
+		now:          time.Now(),
+	}
+	if cron.Spec.ImportsMaxAge != nil {
+		retention.maxAge = &cron.Spec.ImportsMaxAge.Duration
+	}
+	return retention
+}
+
+// keep tells whether the next import, last used at lastUse and of the given size, is kept
+func (r *importRetention) keep(lastUse time.Time, size resource.Quantity) bool {
+	r.count++
+	if r.count > r.minImports {
+		if r.maxAge == nil && r.maxTotalSize == nil {
+			return false
+		}
+		if r.maxAge != nil && r.now.Sub(lastUse) > *r.maxAge {
+			return false
+		}
+		if r.maxTotalSize != nil {
+			totalSize := r.totalSize.DeepCopy()
+			totalSize.Add(size)
+			if totalSize.Cmp(*r.maxTotalSize) > 0 {
+				return false
+			}
+		}
+	}
+	r.totalSize.Add(size)
+	return true
+}
+
+// getLastUseTime returns the import last use time, falling back to its creation time
+func getLastUseTime(obj metav1.Object) time.Time {
+	if lastUse, err := time.Parse(time.RFC3339Nano, obj.GetAnnotations()[AnnLastUseTime]); err == nil {
+		return lastUse
+	}
+	return obj.GetCreationTimestamp().Time
+}
+
+func getPvcImportSize(pvc *corev1.PersistentVolumeClaim) resource.Quantity {
+	if size, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		return size
+	}
+	return pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+}
+
+func getSnapshotImportSize(snapshot *snapshotv1.VolumeSnapshot) resource.Quantity {
+	if snapshot.Status != nil && snapshot.Status.RestoreSize != nil {
+		return *snapshot.Status.RestoreSize
+	}
+	return resource.Quantity{}
+}
+
+const (
+	// dataSourceSourceNamespaceField indexes the DataSources by the namespaces of their sources
+	dataSourceSourceNamespaceField = "dataSourceSourceNamespace"
+	// dvInProgressSourceNamespaceField indexes the DataVolumes in progress by the namespace of their PVC or snapshot source
+	dvInProgressSourceNamespaceField = "dvInProgressSourceNamespace"
+
+	pvcSourceKind      = "PersistentVolumeClaim"
+	snapshotSourceKind = "VolumeSnapshot"
+)
+
+// importSourceKey keys a protected import source by kind and name, so a PVC and a snapshot with the same name are told apart
+func importSourceKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// indexDataSourceSourceNamespace indexes the namespaces of the DataSource spec source and clone source,
+// which differ for a pinned revision
+func indexDataSourceSourceNamespace(obj client.Object) []string {
+	dataSource := obj.(*cdiv1.DataSource)
+	namespaces := sets.New[string]()
+	for _, source := range []*cdiv1.DataSourceSource{&dataSource.Spec.Source, dataSource.GetCloneSource()} {
+		if source.PVC != nil {
+			namespaces.Insert(cc.GetNamespace(source.PVC.Namespace, dataSource.Namespace))
+		}
+		if source.Snapshot != nil {
+			namespaces.Insert(cc.GetNamespace(source.Snapshot.Namespace, dataSource.Namespace))
+		}
+	}
+	return sets.List(namespaces)
+}
+
+// indexDvInProgressSourceNamespace indexes the namespace of the PVC or snapshot source of a DataVolume in progress
+func indexDvInProgressSourceNamespace(obj client.Object) []string {
+	dv := obj.(*cdiv1.DataVolume)
+	if dv.Spec.Source == nil || dv.Status.Phase == cdiv1.Succeeded || dv.Status.Phase == cdiv1.Failed {
+		return nil
+	}
+	if pvc := dv.Spec.Source.PVC; pvc != nil {
+		return []string{cc.GetNamespace(pvc.Namespace, dv.Namespace)}
+	}
+	if snapshot := dv.Spec.Source.Snapshot; snapshot != nil {
+		return []string{cc.GetNamespace(snapshot.Namespace, dv.Namespace)}
+	}
+	return nil
+}
+
+// getProtectedImportSources returns the keys of the PVCs and snapshots in the namespace which must not be
+// garbage collected: the ones a DataSource points to, and the ones which are the source of an in-flight clone.
+func (r *DataImportCronReconciler) getProtectedImportSources(ctx context.Context, namespace string) (sets.Set[string], error) {
+	protected := sets.New[string]()
+	insertSource := func(ownerNamespace string, pvc *cdiv1.DataVolumeSourcePVC, snapshot *cdiv1.DataVolumeSourceSnapshot) {
+		if pvc != nil && cc.GetNamespace(pvc.Namespace, ownerNamespace) == namespace {
+			protected.Insert(importSourceKey(pvcSourceKind, pvc.Name))
+		}
+		if snapshot != nil && cc.GetNamespace(snapshot.Namespace, ownerNamespace) == namespace {
+			protected.Insert(importSourceKey(snapshotSourceKind, snapshot.Name))
+		}
+	}
+
+	dataSourceList := &cdiv1.DataSourceList{}
+	if err := r.client.List(ctx, dataSourceList, client.MatchingFields{dataSourceSourceNamespaceField: namespace}); err != nil {
+		return nil, err
+	}
+	for i := range dataSourceList.Items {
+		dataSource := &dataSourceList.Items[i]
+		// The source of a pinned revision is protected along with the latest import in the spec
+		for _, source := range []*cdiv1.DataSourceSource{&dataSource.Spec.Source, dataSource.GetCloneSource()} {
+			insertSource(dataSource.Namespace, source.PVC, source.Snapshot)
+		}
+	}
+
+	dvList := &cdiv1.DataVolumeList{}
+	if err := r.client.List(ctx, dvList, client.MatchingFields{dvInProgressSourceNamespaceField: namespace}); err != nil {
+		return nil, err
+	}
+	for _, dv := range dvList.Items {
+		insertSource(dv.Namespace, dv.Spec.Source.PVC, dv.Spec.Source.Snapshot)
+	}
+
+	// VolumeCloneSources are created in the source namespace for the duration of the clone
+	volumeCloneSourceList := &cdiv1.VolumeCloneSourceList{}
+	if err := r.client.List(ctx, volumeCloneSourceList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+	for _, volumeCloneSource := range volumeCloneSourceList.Items {
+		protected.Insert(importSourceKey(volumeCloneSource.Spec.Source.Kind, volumeCloneSource.Spec.Source.Name))
+	}
+
+	// Host assisted clone source pods, and any other running pod using the PVC
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				protected.Insert(importSourceKey(pvcSourceKind, volume.PersistentVolumeClaim.ClaimName))
+			}
+		}
+	}
+
+	return protected, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+const (
+	importQueued = "ImportQueued"
+
+	// importQueueRequeueInterval is how often a queued import checks again whether it may start
+	importQueueRequeueInterval = time.Minute
+
+	importWindowTimeFormat = "15:04"
+
+	// importReservationTimeout is how long a started import is counted before its DataVolume shows in the cache
+	importReservationTimeout = time.Minute
+)
+
+// importReservations are the cron import DataVolumes being created, which the informer cache may not show yet.
+// They are counted along with the cached imports in progress, so concurrent reconciles don't overshoot the limit.
+type importReservations struct {
+	lock sync.Mutex
+	dvs  map[types.NamespacedName]time.Time
+}
+
+// reserve reserves a slot for the import DataVolume unless max imports are in progress, and returns the number
+// of imports in progress. Reservations are dropped once the cache lists their DataVolume, or once they expire.
+func (q *importReservations) reserve(dvKey types.NamespacedName, max int, listed, inProgress sets.Set[types.NamespacedName], now time.Time) (int, bool) {
+	if q.dvs == nil {
+		q.dvs = map[types.NamespacedName]time.Time{}
+	}
+	counted := inProgress.Clone()
+	for key, reserved := range q.dvs {
+		if listed.Has(key) || now.Sub(reserved) > importReservationTimeout {
+			delete(q.dvs, key)
+			continue
+		}
+		counted.Insert(key)
+	}
+	if counted.Has(dvKey) {
+		return counted.Len(), true
+	}
+	if counted.Len() >= max {
+		return counted.Len(), false
+	}
+	q.dvs[dvKey] = now
+	return counted.Len() + 1, true
+}
+
+// release drops the reservation of an import DataVolume which failed to be created
+func (q *importReservations) release(dvKey types.NamespacedName) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.dvs, dvKey)
+}
+
+// getScheduleJitterDelay returns the fixed delay of the cron scheduled polls, derived from the cron UID
+func getScheduleJitterDelay(cron *cdiv1.DataImportCron) time.Duration {
+	jitter := cron.Spec.ScheduleJitter
+	if jitter == nil || jitter.Duration < time.Second {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(cron.UID))
+	return time.Duration(h.Sum32()%uint32(jitter.Duration/time.Second)) * time.Second
+}
+
+// getNextCronTime returns the next scheduled poll time after now, delayed by the cron jitter delay.
+// A poll due by the schedule but still delayed is not skipped.
+func getNextCronTime(cron *cdiv1.DataImportCron, now time.Time) (time.Time, error) {
+	expr, err := cronexpr.Parse(cron.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	delay := getScheduleJitterDelay(cron)
+	return expr.Next(now.Add(-delay)).Add(delay), nil
+}
+
+// startDelayedPoll starts the poller Job of a cron whose scheduled polls are delayed by its jitter once the poll is due,
+// unless its previous poll is still running, and requeues the cron for its next poll
+func (r *DataImportCronReconciler) startDelayedPoll(ctx context.Context, cron *cdiv1.DataImportCron) (reconcile.Result, error) {
+	if nextTimeStr := cron.Annotations[AnnNextCronTime]; nextTimeStr != "" {
+		nextTime, err := time.Parse(time.RFC3339, nextTimeStr)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if nextTime.Before(time.Now()) {
+			if err := r.createDelayedPollJob(ctx, cron, nextTime); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+	return r.setNextCronTime(cron)
+}
+
+func (r *DataImportCronReconciler) createDelayedPollJob(ctx context.Context, cron *cdiv1.DataImportCron, pollTime time.Time) error {
+	jobList := &batchv1.JobList{}
+	if err := r.client.List(ctx, jobList, client.InNamespace(r.cdiNamespace),
+		client.MatchingLabels{common.DataImportCronLabel: getCronJobLabelValue(cron.Namespace, cron.Name)}); err != nil {
+		return err
+	}
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if isValidationJob(job) {
+			continue
+		}
+		// Like the CronJob concurrency policy, the poll is skipped while the previous one runs
+		if !isJobConditionTrue(job, batchv1.JobComplete) && !isJobConditionTrue(job, batchv1.JobFailed) {
+			r.log.V(3).Info("Skipping delayed poll, previous poll still running", "cron", cron.Name, "job", job.Name)
+			return nil
+		}
+	}
+
+	cronJob, err := r.newCronJob(cron)
+	if err != nil {
+		return err
+	}
+	job, err := r.newPollerJob(cron, cronJob, getDelayedPollJobName(cron, pollTime))
+	if err != nil {
+		return err
+	}
+	if err := r.client.Create(ctx, job); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// importWindow is a parsed DataImportCronImportWindow
+type importWindow struct {
+	start    time.Duration
+	duration time.Duration
+	days     map[time.Weekday]bool
+}
+
+func parseImportWindow(window *cdiv1.DataImportCronImportWindow) (*importWindow, error) {
+	start, err := time.Parse(importWindowTimeFormat, window.Start)
+	if err != nil {
+		return nil, errors.Errorf("illegal import window start %q", window.Start)
+	}
+	end, err := time.Parse(importWindowTimeFormat, window.End)
+	if err != nil {
+		return nil, errors.Errorf("illegal import window end %q", window.End)
+	}
+	duration := end.Sub(start)
+	if duration <= 0 {
+		duration += 24 * time.Hour
+	}
+	w := &importWindow{
+		start:    time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		duration: duration,
+	}
+	if len(window.Days) > 0 {
+		w.days = map[time.Weekday]bool{}
+		for _, day := range window.Days {
+			weekday, err := cc.ParseWeekday(day)
+			if err != nil {
+				return nil, errors.Wrap(err, "illegal import window day")
+			}
+			w.days[weekday] = true
+		}
+	}
+	return w, nil
+}
+
+// untilOpen returns how long until the window opens, or zero if it is open at now
+func (w *importWindow) untilOpen(now time.Time) time.Duration {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	// A window which started yesterday may still be open
+	for day := -1; day <= 7; day++ {
+		start := midnight.AddDate(0, 0, day).Add(w.start)
+		if w.days != nil && !w.days[start.Weekday()] {
+			continue
+		}
+		if now.Before(start) {
+			return start.Sub(now)
+		}
+		if now.Before(start.Add(w.duration)) {
+			return 0
+		}
+	}
+	return 0
+}
+
+// getImportQueueWait returns how long the cron new import should wait before it is started, and the reason,
+// according to the cron import window and the cluster limit of concurrent cron imports
+func (r *DataImportCronReconciler) getImportQueueWait(ctx context.Context, cron *cdiv1.DataImportCron) (time.Duration, string, error) {
+	if cron.Spec.ImportWindow != nil {
+		window, err := parseImportWindow(cron.Spec.ImportWindow)
+		if err != nil {
+			return 0, "", err
+		}
+		if wait := window.untilOpen(time.Now()); wait > 0 {
+			return wait, fmt.Sprintf("Import is queued until the import window opens at %s", time.Now().Add(wait).UTC().Format(time.RFC3339)), nil
+		}
+	}
+
+	maxImports, err := r.getMaxConcurrentImports(ctx)
+	if err != nil || maxImports == nil {
+		return 0, "", err
+	}
+	dvName, err := createDvName(cron.Spec.ManagedDataSource, cron.Annotations[AnnSourceDesiredDigest])
+	if err != nil {
+		return 0, "", err
+	}
+	// The count and the reservation are atomic, so the limit holds across concurrent reconciles
+	r.importReservations.lock.Lock()
+	defer r.importReservations.lock.Unlock()
+	listed, inProgress, err := r.listImports(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	count, reserved := r.importReservations.reserve(types.NamespacedName{Namespace: cron.Namespace, Name: dvName}, int(*maxImports), listed, inProgress, time.Now())
+	if !reserved {
+		return importQueueRequeueInterval, fmt.Sprintf("Import is queued, %d of max %d DataImportCron imports in progress", count, *maxImports), nil
+	}
+	return 0, "", nil
+}
+
+func (r *DataImportCronReconciler) getMaxConcurrentImports(ctx context.Context) (*int32, error) {
+	cdiConfig := &cdiv1.CDIConfig{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: common.ConfigName}, cdiConfig); err != nil {
+		return nil, cc.IgnoreNotFound(err)
+	}
+	return cdiConfig.Spec.DataImportCronMaxConcurrentImports, nil
+}
+
+// listImports returns the DataImportCron import DataVolumes in the cluster, and the ones in progress
+func (r *DataImportCronReconciler) listImports(ctx context.Context) (sets.Set[types.NamespacedName], sets.Set[types.NamespacedName], error) {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: common.DataImportCronLabel, Operator: metav1.LabelSelectorOpExists},
+			{Key: common.DataImportCronStorageClassLabel, Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	dvList := &cdiv1.DataVolumeList{}
+	if err := r.client.List(ctx, dvList, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, nil, err
+	}
+	listed := sets.New[types.NamespacedName]()
+	inProgress := sets.New[types.NamespacedName]()
+	for _, dv := range dvList.Items {
+		key := types.NamespacedName{Namespace: dv.Namespace, Name: dv.Name}
+		listed.Insert(key)
+		if dv.Status.Phase != cdiv1.Succeeded && dv.Status.Phase != cdiv1.Failed {
+			inProgress.Insert(key)
+		}
+	}
+	return listed, inProgress, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util/naming"
+)
+
+// updateStorageClassTargets clones the last import to each of the cron storage class targets,
+// and updates the DataSource managed for each storage class
+func (r *DataImportCronReconciler) updateStorageClassTargets(ctx context.Context, cron *cdiv1.DataImportCron, format cdiv1.DataImportCronSourceFormat, desiredStorageClass *storagev1.StorageClass) error {
+	var statuses []cdiv1.DataImportCronStorageClassTargetStatus
+	for _, target := range cron.Spec.StorageClassTargets {
+		status := cdiv1.DataImportCronStorageClassTargetStatus{
+			StorageClassName:  target.StorageClassName,
+			ManagedDataSource: getTargetDataSourceName(cron, &target),
+		}
+		if prev := findStorageClassTargetStatus(cron, target.StorageClassName); prev != nil {
+			status.LastImportedPVC = prev.LastImportedPVC
+		}
+		if err := r.updateStorageClassTarget(ctx, cron, format, desiredStorageClass, &status); err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+	cron.Status.StorageClassTargets = statuses
+	return nil
+}
+
+func (r *DataImportCronReconciler) updateStorageClassTarget(ctx context.Context, cron *cdiv1.DataImportCron, format cdiv1.DataImportCronSourceFormat,
+	desiredStorageClass *storagev1.StorageClass, status *cdiv1.DataImportCronStorageClassTargetStatus) error {
+	log := r.log.WithValues("storageClass", status.StorageClassName)
+	lastImport := cron.Status.LastImportedPVC
+	digest := ""
+	if imports := cron.Status.CurrentImports; lastImport != nil && len(imports) > 0 && imports[0].DataVolumeName == lastImport.Name {
+		digest = imports[0].Digest
+	}
+
+	// The import is already in the storage class, so the target DataSource can point to it
+	if desiredStorageClass != nil && desiredStorageClass.Name == status.StorageClassName {
+		status.SourceFormat = &format
+		status.LastImportedPVC = lastImport
+		return r.updateManagedDataSource(ctx, cron, status.ManagedDataSource, format, lastImport, digest)
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: status.StorageClassName}, storageClass); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		log.Info("Storage class target not found")
+		return r.updateManagedDataSource(ctx, cron, status.ManagedDataSource, format, nil, "")
+	}
+	targetFormat, err := r.getSourceFormat(ctx, storageClass)
+	if err != nil {
+		return err
+	}
+	status.SourceFormat = &targetFormat
+
+	ready, err := r.isLastImportReady(ctx, cron, format)
+	if err != nil {
+		return err
+	}
+	if ready {
+		name := getTargetCopyName(lastImport.Name, storageClass.Name)
+		done, err := r.handleTargetCopy(ctx, cron, format, targetFormat, storageClass, name)
+		if err != nil {
+			return err
+		}
+		if done && (status.LastImportedPVC == nil || status.LastImportedPVC.Name != name) {
+			log.Info("Import copied to storage class target", "name", name)
+			status.LastImportedPVC = &cdiv1.DataVolumeSourcePVC{Namespace: cron.Namespace, Name: name}
+			if err := r.garbageCollectTargetCopies(ctx, cron, storageClass.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if status.LastImportedPVC == nil || lastImport == nil || status.LastImportedPVC.Name != getTargetCopyName(lastImport.Name, storageClass.Name) {
+		// The DataSource still points to an earlier copy, or to none
+		digest = ""
+	}
+	return r.updateManagedDataSource(ctx, cron, status.ManagedDataSource, targetFormat, status.LastImportedPVC, digest)
+}
+
+// handleTargetCopy clones the last import to the storage class target, and returns true once the copy is ready
+func (r *DataImportCronReconciler) handleTargetCopy(ctx context.Context, cron *cdiv1.DataImportCron, format, targetFormat cdiv1.DataImportCronSourceFormat,
+	storageClass *storagev1.StorageClass, name string) (bool, error) {
+	key := types.NamespacedName{Namespace: cron.Namespace, Name: name}
+	if targetFormat == cdiv1.DataImportCronSourceFormatSnapshot {
+		snapshot := &snapshotv1.VolumeSnapshot{}
+		if err := r.client.Get(ctx, key, snapshot); err != nil {
+			if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return false, err
+			}
+		} else {
+			if !cc.IsSnapshotReady(snapshot) {
+				// Snapshot update will trigger reconcile
+				return false, nil
+			}
+			// Snapshot is ready, clean up the cloned DV/PVC
+			return true, r.deleteDvPvc(ctx, name, cron.Namespace)
+		}
+	}
+
+	dv := &cdiv1.DataVolume{}
+	if err := r.client.Get(ctx, key, dv); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		dv = nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(ctx, key, pvc); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		pvc = nil
+	}
+
+	if dv == nil && pvc == nil {
+		dv = r.newTargetDataVolume(cron, format, storageClass.Name, name)
+		if err := r.client.Create(ctx, dv); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return false, err
+		}
+		return false, nil
+	}
+	if pvc == nil || (dv != nil && dv.Status.Phase != cdiv1.Succeeded) {
+		return false, nil
+	}
+
+	if err := r.updateSource(ctx, cron, pvc); err != nil {
+		return false, err
+	}
+	if targetFormat == cdiv1.DataImportCronSourceFormatSnapshot {
+		// Snapshot create/update will trigger reconcile
+		return false, r.handleSnapshot(ctx, cron, pvc, storageClass)
+	}
+	return true, nil
+}
+
+// isLastImportReady tells whether the last import can be cloned to the storage class targets
+func (r *DataImportCronReconciler) isLastImportReady(ctx context.Context, cron *cdiv1.DataImportCron, format cdiv1.DataImportCronSourceFormat) (bool, error) {
+	lastImport := cron.Status.LastImportedPVC
+	if lastImport == nil {
+		return false, nil
+	}
+	if format != cdiv1.DataImportCronSourceFormatSnapshot {
+		return true, nil
+	}
+	snapshot := &snapshotv1.VolumeSnapshot{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: lastImport.Namespace, Name: lastImport.Name}, snapshot); err != nil {
+		if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return cc.IsSnapshotReady(snapshot), nil
+}
+
+// newTargetDataVolume creates a DataVolume cloning the last import to the storage class target
+func (r *DataImportCronReconciler) newTargetDataVolume(cron *cdiv1.DataImportCron, format cdiv1.DataImportCronSourceFormat, storageClassName, name string) *cdiv1.DataVolume {
+	lastImport := cron.Status.LastImportedPVC
+	dv := cron.Spec.Template.DeepCopy()
+	dv.ObjectMeta = metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   cron.Namespace,
+		Labels:      dv.Labels,
+		Annotations: dv.Annotations,
+	}
+	if format == cdiv1.DataImportCronSourceFormatSnapshot {
+		dv.Spec.Source = &cdiv1.DataVolumeSource{
+			Snapshot: &cdiv1.DataVolumeSourceSnapshot{Namespace: lastImport.Namespace, Name: lastImport.Name},
+		}
+	} else {
+		dv.Spec.Source = &cdiv1.DataVolumeSource{
+			PVC: &cdiv1.DataVolumeSourcePVC{Namespace: lastImport.Namespace, Name: lastImport.Name},
+		}
+	}
+	if dv.Spec.PVC != nil {
+		dv.Spec.PVC.StorageClassName = &storageClassName
+	} else {
+		if dv.Spec.Storage == nil {
+			dv.Spec.Storage = &cdiv1.StorageSpec{}
+		}
+		dv.Spec.Storage.StorageClassName = &storageClassName
+	}
+	r.setDataImportCronResourceLabels(cron, dv)
+	dv.Labels[common.DataImportCronStorageClassLabel] = getStorageClassLabelValue(storageClassName)
+	cc.AddAnnotation(dv, cc.AnnImmediateBinding, "true")
+	passCronAnnotationToDv(cron, dv, cc.AnnPodRetainAfterCompletion)
+	return dv
+}
+
+// garbageCollectTargetCopies deletes the outdated copies in the storage class target, like garbageCollectOldImports
+func (r *DataImportCronReconciler) garbageCollectTargetCopies(ctx context.Context, cron *cdiv1.DataImportCron, storageClassName string) error {
+	if cron.Spec.GarbageCollect != nil && *cron.Spec.GarbageCollect != cdiv1.DataImportCronGarbageCollectOutdated {
+		return nil
+	}
+	selector, err := getSelector(map[string]string{
+		common.DataImportCronLabel:             cron.Name,
+		common.DataImportCronStorageClassLabel: getStorageClassLabelValue(storageClassName),
+	})
+	if err != nil {
+		return err
+	}
+	protected, err := r.getProtectedImportSources(ctx, cron.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := r.garbageCollectPVCs(ctx, cron, selector, newImportRetention(cron), protected); err != nil {
+		return err
+	}
+	return r.garbageCollectSnapshots(ctx, cron.Namespace, selector, newImportRetention(cron), protected)
+}
+
+// getPrimaryImportsSelector selects the cron imports, excluding their copies in the storage class targets
+func getPrimaryImportsSelector(cron *cdiv1.DataImportCron) (labels.Selector, error) {
+	return metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{common.DataImportCronLabel: cron.Name},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: common.DataImportCronStorageClassLabel, Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	})
+}
+
+func findStorageClassTargetStatus(cron *cdiv1.DataImportCron, storageClassName string) *cdiv1.DataImportCronStorageClassTargetStatus {
+	for i := range cron.Status.StorageClassTargets {
+		if cron.Status.StorageClassTargets[i].StorageClassName == storageClassName {
+			return &cron.Status.StorageClassTargets[i]
+		}
+	}
+	return nil
+}
+
+func getTargetDataSourceName(cron *cdiv1.DataImportCron, target *cdiv1.DataImportCronStorageClassTarget) string {
+	if target.ManagedDataSource != "" {
+		return target.ManagedDataSource
+	}
+	return naming.GetResourceName(cron.Spec.ManagedDataSource, target.StorageClassName)
+}
+
+func getTargetCopyName(importName, storageClassName string) string {
+	return naming.GetResourceName(importName, storageClassName)
+}
+
+func getStorageClassLabelValue(storageClassName string) string {
+	return naming.GetLabelNameFromResourceName(storageClassName)
+}
+
+// isStorageClassTarget tells whether the storage class is one of the cron storage class targets
+func isStorageClassTarget(cron *cdiv1.DataImportCron, storageClassName string) bool {
+	for _, target := range cron.Spec.StorageClassTargets {
+		if target.StorageClassName == storageClassName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util/naming"
+)
+
+const (
+	// AnnImportValidated marks a DataImportCron import PVC which passed the cron validation
+	AnnImportValidated = cc.AnnAPIGroup + "/storage.import.validated"
+
+	// ValidationDiskEnv is the env variable holding the disk image path in the validation container
+	ValidationDiskEnv = "CDI_VALIDATION_DISK"
+
+	validationContainerName = "validate"
+	validationVolumeName    = "cdi-data-vol"
+	validationJobSuffix     = "validation"
+
+	validated        = "Validated"
+	validating       = "ImportValidating"
+	validationFailed = "ValidationFailed"
+)
+
+// validationState is the result of the validation of a DataImportCron import
+type validationState int
+
+const (
+	validationPending validationState = iota
+	validationSucceeded
+	validationFailedState
+)
+
+// validateImport runs the cron validation Job against the imported PVC, and returns the validation state.
+// Only a validated import may be promoted to the managed DataSource.
+func (r *DataImportCronReconciler) validateImport(ctx context.Context, cron *cdiv1.DataImportCron, pvc *corev1.PersistentVolumeClaim) (validationState, error) {
+	if cron.Spec.Validation == nil {
+		return validationSucceeded, nil
+	}
+	if pvc.Annotations[AnnImportValidated] == "true" {
+		updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionTrue, "Import passed validation", validated)
+		return validationSucceeded, nil
+	}
+
+	job := &batchv1.Job{}
+	jobKey := types.NamespacedName{Namespace: pvc.Namespace, Name: getValidationJobName(pvc)}
+	if err := r.client.Get(ctx, jobKey, job); err != nil {
+		if cc.IgnoreNotFound(err) != nil {
+			return validationPending, err
+		}
+		cdiConfig := &cdiv1.CDIConfig{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: common.ConfigName}, cdiConfig); err != nil {
+			return validationPending, err
+		}
+		if err := checkValidationAllowed(cron.Spec.Validation, cdiConfig); err != nil {
+			updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionFalse, err.Error(), validationFailed)
+			return validationFailedState, nil
+		}
+		job, err := r.newValidationJob(cron, pvc)
+		if err != nil {
+			return validationPending, err
+		}
+		if err := r.client.Create(ctx, job); err != nil {
+			return validationPending, err
+		}
+		updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionFalse, "Import validation is progressing", validating)
+		return validationPending, nil
+	}
+
+	switch {
+	case isJobConditionTrue(job, batchv1.JobComplete):
+		r.log.Info("Import passed validation", "pvc", pvc.Name)
+		cc.AddAnnotation(pvc, AnnImportValidated, "true")
+		if err := r.client.Update(ctx, pvc); err != nil {
+			return validationPending, err
+		}
+		deletePropagationBackground := metav1.DeletePropagationBackground
+		if err := r.client.Delete(ctx, job, &client.DeleteOptions{PropagationPolicy: &deletePropagationBackground}); cc.IgnoreNotFound(err) != nil {
+			return validationPending, err
+		}
+		updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionTrue, "Import passed validation", validated)
+		return validationSucceeded, nil
+	case isJobConditionTrue(job, batchv1.JobFailed):
+		msg := fmt.Sprintf("Import %s failed validation, see Job %s", pvc.Name, job.Name)
+		updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionFalse, msg, validationFailed)
+		if err := r.recordValidationFailure(ctx, cron, job, msg); err != nil {
+			return validationPending, err
+		}
+		return validationFailedState, nil
+	}
+
+	updateDataImportCronCondition(cron, cdiv1.DataImportCronValidated, corev1.ConditionFalse, "Import validation is progressing", validating)
+	return validationPending, nil
+}
+
+// checkValidationAllowed fails unless the validation image is allowed by the CDIConfig. The validation Job is created
+// by CDI on behalf of the cron owner, so it may only run an image the cluster admin allowed, and may not read secrets.
+func checkValidationAllowed(validation *cdiv1.DataImportCronValidation, cdiConfig *cdiv1.CDIConfig) error {
+	if !sets.New(cdiConfig.Spec.DataImportCronValidationImages...).Has(validation.Image) {
+		return errors.Errorf("validation image %s is not allowed by the CDIConfig dataImportCronValidationImages", validation.Image)
+	}
+	for _, env := range validation.Env {
+		if env.ValueFrom != nil {
+			return errors.Errorf("validation env %s may not use valueFrom", env.Name)
+		}
+	}
+	return nil
+}
+
+func (r *DataImportCronReconciler) newValidationJob(cron *cdiv1.DataImportCron, pvc *corev1.PersistentVolumeClaim) (*batchv1.Job, error) {
+	validation := cron.Spec.Validation
+	backoffLimit := validation.BackoffLimit
+	if backoffLimit == nil {
+		backoffLimit = pointer.Int32(0)
+	}
+
+	container := corev1.Container{
+		Name:      validationContainerName,
+		Image:     validation.Image,
+		Command:   validation.Command,
+		Args:      validation.Args,
+		Env:       append([]corev1.EnvVar{}, validation.Env...),
+		Resources: validation.Resources,
+	}
+	diskPath := common.ImporterWritePath
+	if cc.GetVolumeMode(pvc) == corev1.PersistentVolumeBlock {
+		diskPath = common.WriteBlockPath
+		container.VolumeDevices = []corev1.VolumeDevice{{Name: validationVolumeName, DevicePath: common.WriteBlockPath}}
+	} else {
+		container.VolumeMounts = []corev1.VolumeMount{{Name: validationVolumeName, MountPath: common.ImporterVolumePath, ReadOnly: true}}
+	}
+	container.Env = append(container.Env, corev1.EnvVar{Name: ValidationDiskEnv, Value: diskPath})
+
+	imagePullSecrets, err := cc.GetImagePullSecrets(r.client)
+	if err != nil {
+		return nil, err
+	}
+	workloadNodePlacement, err := cc.GetWorkloadNodePlacement(context.TODO(), r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getValidationJobName(pvc),
+			Namespace: pvc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pvc, corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim")),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          backoffLimit,
+			ActiveDeadlineSeconds: validation.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					Containers:                   []corev1.Container{container},
+					AutomountServiceAccountToken: pointer.Bool(false),
+					ImagePullSecrets:             imagePullSecrets,
+					NodeSelector:                 workloadNodePlacement.NodeSelector,
+					Tolerations:                  workloadNodePlacement.Tolerations,
+					Affinity:                     workloadNodePlacement.Affinity,
+					PriorityClassName:            cc.GetPriorityClass(pvc),
+					Volumes: []corev1.Volume{{
+						Name: validationVolumeName,
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: pvc.Name,
+								ReadOnly:  true,
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	cc.SetRestrictedSecurityContext(&job.Spec.Template.Spec)
+	r.setDataImportCronResourceLabels(cron, job)
+	return job, nil
+}
+
+func getValidationJobName(pvc *corev1.PersistentVolumeClaim) string {
+	return naming.GetResourceName(pvc.Name, validationJobSuffix)
+}
+
+func isJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	return getJobCondition(job, conditionType) != nil
+}
+
+// getJobCondition returns the Job condition of the given type if it is true, or nil
+func getJobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// isValidationJob tells whether the Job is a DataImportCron import validation Job
+func isValidationJob(obj metav1.Object) bool {
+	owner := metav1.GetControllerOf(obj)
+	return obj.GetLabels()[common.DataImportCronLabel] != "" && owner != nil && owner.Kind == "PersistentVolumeClaim"
+}
@@ -26,12 +26,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -49,12 +52,26 @@ type DataSourceReconciler struct {
 	scheme          *runtime.Scheme
 	log             logr.Logger
 	installerLabels map[string]string
+	cdiNamespace    string
 }
 
 const (
 	ready                    = "Ready"
 	noSource                 = "NoSource"
+	notCached                = "NotCached"
+	cacheImportCreated       = "CacheImportCreated"
+	cacheConflict            = "CacheConflict"
+	revisionNotFound         = "RevisionNotFound"
 	dataSourceControllerName = "datasource-controller"
+
+	// maxDataSourceRevisions is the maximum number of revisions kept in the DataSource status
+	maxDataSourceRevisions = 10
+
+	dvSourceRefDataSourceField = "spec.sourceRef.dataSource"
+	dataSourceCacheField       = "spec.source.cache"
+
+	// AnnDataSourceCacheHash is the hash of the DataSource remote source and cache storage imported by the annotated cache DataVolume
+	AnnDataSourceCacheHash = cc.AnnAPIGroup + "/storage.dataSourceCacheHash"
 )
 
 // Reconcile loop for DataSourceReconciler
@@ -62,27 +79,38 @@ func (r *DataSourceReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 	dataSource := &cdiv1.DataSource{}
 	if err := r.client.Get(ctx, req.NamespacedName, dataSource); err != nil {
 		if k8serrors.IsNotFound(err) {
-			return reconcile.Result{}, nil
+			// The caches of a deleted DataSource may not be used anymore
+			return reconcile.Result{}, r.pruneDataSourceCaches(ctx)
 		}
 		return reconcile.Result{}, err
 	}
 	if err := r.update(ctx, dataSource); err != nil {
 		return reconcile.Result{}, err
 	}
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, r.pruneDataSourceCaches(ctx)
 }
 
 func (r *DataSourceReconciler) update(ctx context.Context, dataSource *cdiv1.DataSource) error {
-	if !reflect.DeepEqual(dataSource.Status.Source, dataSource.Spec.Source) {
-		dataSource.Spec.Source.DeepCopyInto(&dataSource.Status.Source)
+	pinned := r.handlePinnedRevision(dataSource)
+	source := r.getCloneSource(dataSource)
+	if !reflect.DeepEqual(dataSource.Status.Source, *source) {
+		source.DeepCopyInto(&dataSource.Status.Source)
 		dataSource.Status.Conditions = nil
+		updateDataSourceRevision(dataSource)
 	}
 	dataSourceCopy := dataSource.DeepCopy()
-	if sourcePVC := dataSource.Spec.Source.PVC; sourcePVC != nil {
+	if err := r.pruneDataSourceRevisions(ctx, dataSource); err != nil {
+		return err
+	}
+	if dataSource.Spec.Source.IsRemote() && !pinned {
+		if err := r.handleRemoteSource(ctx, dataSource); err != nil {
+			return err
+		}
+	} else if sourcePVC := dataSource.Status.Source.PVC; sourcePVC != nil {
 		if err := r.handlePvcSource(ctx, sourcePVC, dataSource); err != nil {
 			return err
 		}
-	} else if sourceSnapshot := dataSource.Spec.Source.Snapshot; sourceSnapshot != nil {
+	} else if sourceSnapshot := dataSource.Status.Source.Snapshot; sourceSnapshot != nil {
 		if err := r.handleSnapshotSource(ctx, sourceSnapshot, dataSource); err != nil {
 			return err
 		}
@@ -98,6 +126,108 @@ func (r *DataSourceReconciler) update(ctx context.Context, dataSource *cdiv1.Dat
 	return nil
 }
 
+// handlePinnedRevision tells whether the DataSource is pinned to a known revision. The spec source is left as is,
+// the source of the pinned revision is the clone source, recorded in the status.
+func (r *DataSourceReconciler) handlePinnedRevision(dataSource *cdiv1.DataSource) bool {
+	pinned := dataSource.Spec.PinnedRevision
+	if pinned == nil {
+		return false
+	}
+	if dataSource.GetPinnedRevision() == nil {
+		r.log.Info("Pinned revision not found", "name", dataSource.Name, "revision", *pinned)
+		r.recorder.Eventf(dataSource, corev1.EventTypeWarning, revisionNotFound, "Pinned revision %d not found", *pinned)
+		return false
+	}
+	return true
+}
+
+// getCloneSource returns the source cloned by the DataVolumes referencing the DataSource, which is the cache PVC
+// named after the remote source in the CDI namespace for a remote source
+func (r *DataSourceReconciler) getCloneSource(dataSource *cdiv1.DataSource) *cdiv1.DataSourceSource {
+	if dataSource.Spec.Source.IsRemote() && dataSource.GetPinnedRevision() == nil {
+		return &cdiv1.DataSourceSource{
+			PVC: &cdiv1.DataVolumeSourcePVC{
+				Namespace: r.cdiNamespace,
+				Name:      cc.GetDataSourceCacheName(dataSource),
+			},
+		}
+	}
+	return dataSource.GetCloneSource()
+}
+
+// updateDataSourceRevision records the new source in the DataSource revision history.
+// Switching back to a source from the history, like a rollback, reuses its revision.
+func updateDataSourceRevision(dataSource *cdiv1.DataSource) {
+	source := dataSource.Status.Source
+	if source.PVC == nil && source.Snapshot == nil {
+		return
+	}
+	for _, revision := range dataSource.Status.Revisions {
+		if reflect.DeepEqual(revision.Source, source) {
+			dataSource.Status.Revision = revision.Revision
+			return
+		}
+	}
+	var last int64
+	if n := len(dataSource.Status.Revisions); n > 0 {
+		last = dataSource.Status.Revisions[n-1].Revision
+	}
+	revision := cdiv1.DataSourceRevision{
+		Revision:  last + 1,
+		Digest:    dataSource.Annotations[AnnSourceDigest],
+		Timestamp: metav1.Now(),
+	}
+	source.DeepCopyInto(&revision.Source)
+	dataSource.Status.Revisions = append(dataSource.Status.Revisions, revision)
+	dataSource.Status.Revision = revision.Revision
+	if n := len(dataSource.Status.Revisions); n > maxDataSourceRevisions {
+		dataSource.Status.Revisions = dataSource.Status.Revisions[n-maxDataSourceRevisions:]
+	}
+}
+
+// pruneDataSourceRevisions removes the revisions whose source was deleted, e.g. garbage collected by the DataImportCron
+func (r *DataSourceReconciler) pruneDataSourceRevisions(ctx context.Context, dataSource *cdiv1.DataSource) error {
+	var revisions []cdiv1.DataSourceRevision
+	for _, revision := range dataSource.Status.Revisions {
+		if revision.Revision == dataSource.Status.Revision ||
+			(dataSource.Spec.PinnedRevision != nil && revision.Revision == *dataSource.Spec.PinnedRevision) {
+			revisions = append(revisions, revision)
+			continue
+		}
+		exists, err := r.revisionSourceExists(ctx, dataSource, &revision.Source)
+		if err != nil {
+			return err
+		}
+		if exists {
+			revisions = append(revisions, revision)
+		}
+	}
+	dataSource.Status.Revisions = revisions
+	return nil
+}
+
+func (r *DataSourceReconciler) revisionSourceExists(ctx context.Context, dataSource *cdiv1.DataSource, source *cdiv1.DataSourceSource) (bool, error) {
+	var key types.NamespacedName
+	var obj client.Object
+	switch {
+	case source.PVC != nil:
+		key = types.NamespacedName{Namespace: cc.GetNamespace(source.PVC.Namespace, dataSource.Namespace), Name: source.PVC.Name}
+		obj = &corev1.PersistentVolumeClaim{}
+	case source.Snapshot != nil:
+		key = types.NamespacedName{Namespace: cc.GetNamespace(source.Snapshot.Namespace, dataSource.Namespace), Name: source.Snapshot.Name}
+		obj = &snapshotv1.VolumeSnapshot{}
+	default:
+		return false, nil
+	}
+	if err := r.client.Get(ctx, key, obj); err != nil {
+		if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (r *DataSourceReconciler) handlePvcSource(ctx context.Context, sourcePVC *cdiv1.DataVolumeSourcePVC, dataSource *cdiv1.DataSource) error {
 	dv := &cdiv1.DataVolume{}
 	ns := cc.GetNamespace(sourcePVC.Namespace, dataSource.Namespace)
@@ -128,6 +258,180 @@ func (r *DataSourceReconciler) handlePvcSource(ctx context.Context, sourcePVC *c
 	return nil
 }
 
+// handleRemoteSource imports the DataSource remote source to its cache PVC once a DataVolume references the DataSource.
+// The cache is imported once in the CDI namespace for all the DataSources with the same remote source and cache storage.
+func (r *DataSourceReconciler) handleRemoteSource(ctx context.Context, dataSource *cdiv1.DataSource) error {
+	cacheKey := types.NamespacedName{Namespace: r.cdiNamespace, Name: cc.GetDataSourceCacheName(dataSource)}
+	cacheDv := &cdiv1.DataVolume{}
+	if err := r.client.Get(ctx, cacheKey, cacheDv); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		cacheDv = nil
+	}
+	if cacheDv != nil {
+		if !isDataSourceCache(cacheDv) || cacheDv.Annotations[AnnDataSourceCacheHash] != cc.GetDataSourceCacheHash(dataSource) {
+			r.cacheConflict(dataSource, "DataVolume", cacheKey.Name)
+			return nil
+		}
+		if cacheDv.DeletionTimestamp != nil {
+			updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, "Deleting the unused cache", notCached)
+			return nil
+		}
+		return r.handlePvcSource(ctx, dataSource.Status.Source.PVC, dataSource)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(ctx, cacheKey, pvc); err == nil {
+		// The PVC of a deleted cache DataVolume is garbage collected, anything else is not the DataSource cache
+		if owner := metav1.GetControllerOf(pvc); owner == nil || owner.Kind != "DataVolume" || !isDataSourceCache(pvc) {
+			r.cacheConflict(dataSource, "PersistentVolumeClaim", cacheKey.Name)
+			return nil
+		}
+		updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, "Deleting the unused cache", notCached)
+		return nil
+	} else if !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	if dataSource.Spec.Cache == nil {
+		updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, "Cache storage is not set", notCached)
+		return nil
+	}
+	dv, err := r.getReferencingDataVolume(ctx, dataSource)
+	if err != nil {
+		return err
+	}
+	if dv == nil {
+		updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, "Waiting for a DataVolume referencing the DataSource", notCached)
+		return nil
+	}
+	cacheDv = r.newCacheDataVolume(dataSource, cacheKey)
+	if err := r.client.Create(ctx, cacheDv); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	r.log.Info("Created cache import DataVolume", "name", cacheDv.Name, "namespace", cacheDv.Namespace)
+	r.recorder.Eventf(dataSource, corev1.EventTypeNormal, cacheImportCreated, "Importing the DataSource source to %s/%s, requested by DataVolume %s/%s", cacheDv.Namespace, cacheDv.Name, dv.Namespace, dv.Name)
+	updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, "Importing the DataSource source to the cache", cacheImportCreated)
+	return nil
+}
+
+func isDataSourceCache(obj metav1.Object) bool {
+	return obj.GetLabels()[common.DataSourceCacheLabel] == "true"
+}
+
+// cacheConflict reports an object which is not the DataSource cache, but has its name. It is never cloned
+// in place of the cache, so the DataSource cannot be ready until it is deleted.
+func (r *DataSourceReconciler) cacheConflict(dataSource *cdiv1.DataSource, kind, name string) {
+	msg := fmt.Sprintf("%s %s/%s is not the DataSource cache", kind, r.cdiNamespace, name)
+	if condition := FindDataSourceConditionByType(dataSource, cdiv1.DataSourceReady); condition == nil || condition.Reason != cacheConflict {
+		r.recorder.Event(dataSource, corev1.EventTypeWarning, cacheConflict, msg)
+	}
+	updateDataSourceCondition(dataSource, cdiv1.DataSourceReady, corev1.ConditionFalse, msg, cacheConflict)
+}
+
+// pruneDataSourceCaches deletes the caches which no DataSource imports to or clones, including as its pinned revision.
+// The caches are shared by the DataSources with the same remote source, so they are not owned by them.
+func (r *DataSourceReconciler) pruneDataSourceCaches(ctx context.Context) error {
+	dvList := &cdiv1.DataVolumeList{}
+	if err := r.client.List(ctx, dvList, client.InNamespace(r.cdiNamespace), client.MatchingLabels{common.DataSourceCacheLabel: "true"}); err != nil {
+		return err
+	}
+	for i := range dvList.Items {
+		cacheDv := &dvList.Items[i]
+		if cacheDv.DeletionTimestamp != nil {
+			continue
+		}
+		dsList := &cdiv1.DataSourceList{}
+		if err := r.client.List(ctx, dsList, client.MatchingFields{dataSourceCacheField: cacheDv.Name}); err != nil {
+			return err
+		}
+		if len(dsList.Items) > 0 {
+			continue
+		}
+		if err := r.client.Delete(ctx, cacheDv); cc.IgnoreNotFound(err) != nil {
+			return err
+		}
+		r.log.Info("Deleted unused DataSource cache", "name", cacheDv.Name, "namespace", cacheDv.Namespace)
+	}
+	return nil
+}
+
+// indexDataSourceCache indexes the DataSources by the caches they import to and clone
+func indexDataSourceCache(obj client.Object) []string {
+	dataSource := obj.(*cdiv1.DataSource)
+	var caches []string
+	if dataSource.Spec.Source.IsRemote() {
+		caches = append(caches, cc.GetDataSourceCacheName(dataSource))
+	}
+	if pvc := dataSource.GetCloneSource().PVC; pvc != nil && pvc.IsDataSourceCache() && (len(caches) == 0 || caches[0] != pvc.Name) {
+		caches = append(caches, pvc.Name)
+	}
+	return caches
+}
+
+// getReferencingDataVolume returns a DataVolume referencing the DataSource, or nil if there is none
+func (r *DataSourceReconciler) getReferencingDataVolume(ctx context.Context, dataSource *cdiv1.DataSource) (*cdiv1.DataVolume, error) {
+	dvList := &cdiv1.DataVolumeList{}
+	if err := r.client.List(ctx, dvList, client.MatchingFields{dvSourceRefDataSourceField: dataSource.Namespace + "/" + dataSource.Name}); err != nil {
+		return nil, err
+	}
+	for i := range dvList.Items {
+		if dv := &dvList.Items[i]; dv.DeletionTimestamp == nil {
+			return dv, nil
+		}
+	}
+	return nil, nil
+}
+
+func indexDvSourceRefDataSource(obj client.Object) []string {
+	dv := obj.(*cdiv1.DataVolume)
+	if sourceRef := dv.Spec.SourceRef; sourceRef != nil && sourceRef.Kind == cdiv1.DataVolumeDataSource {
+		return []string{getSourceRefNamespace(dv) + "/" + sourceRef.Name}
+	}
+	return nil
+}
+
+func getSourceRefNamespace(dv *cdiv1.DataVolume) string {
+	if ns := dv.Spec.SourceRef.Namespace; ns != nil && *ns != "" {
+		return *ns
+	}
+	return dv.Namespace
+}
+
+// newCacheDataVolume creates the DataVolume importing the DataSource remote source, with the DataSource cache storage
+func (r *DataSourceReconciler) newCacheDataVolume(dataSource *cdiv1.DataSource, cacheKey types.NamespacedName) *cdiv1.DataVolume {
+	cacheDv := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cacheKey.Name,
+			Namespace: cacheKey.Namespace,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				HTTP:     dataSource.Spec.Source.HTTP.DeepCopy(),
+				Registry: dataSource.Spec.Source.Registry.DeepCopy(),
+			},
+			Storage: &cdiv1.StorageSpec{
+				StorageClassName: dataSource.Spec.Cache.StorageClassName,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: dataSource.Spec.Cache.Size,
+					},
+				},
+			},
+		},
+	}
+	util.SetRecommendedLabels(cacheDv, r.installerLabels, common.CDIControllerName)
+	labels := cacheDv.GetLabels()
+	labels[common.DataSourceCacheLabel] = "true"
+	cacheDv.SetLabels(labels)
+	cc.AddAnnotation(cacheDv, AnnDataSourceCacheHash, cc.GetDataSourceCacheHash(dataSource))
+	cc.AddAnnotation(cacheDv, cc.AnnImmediateBinding, "true")
+	// The DataSources keep cloning the cache PVC, so it must not be garbage collected
+	cc.AddAnnotation(cacheDv, cc.AnnDeleteAfterCompletion, "false")
+	return cacheDv
+}
+
 func (r *DataSourceReconciler) handleSnapshotSource(ctx context.Context, sourceSnapshot *cdiv1.DataVolumeSourceSnapshot, dataSource *cdiv1.DataSource) error {
 	snapshot := &snapshotv1.VolumeSnapshot{}
 	ns := cc.GetNamespace(sourceSnapshot.Namespace, dataSource.Namespace)
@@ -174,6 +478,7 @@ func NewDataSourceController(mgr manager.Manager, log logr.Logger, installerLabe
 		scheme:          mgr.GetScheme(),
 		log:             log.WithName(dataSourceControllerName),
 		installerLabels: installerLabels,
+		cdiNamespace:    util.GetNamespace(),
 	}
 	DataSourceController, err := controller.New(dataSourceControllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: 3,
@@ -200,6 +505,13 @@ func addDataSourceControllerWatches(mgr manager.Manager, c controller.Controller
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataVolume{}, dvSourceRefDataSourceField, indexDvSourceRefDataSource); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataSource{}, dataSourceCacheField, indexDataSourceCache); err != nil {
+		return err
+	}
+
 	const dataSourcePvcField = "spec.source.pvc"
 	const dataSourceSnapshotField = "spec.source.snapshot"
 
@@ -221,7 +533,7 @@ func addDataSourceControllerWatches(mgr manager.Manager, c controller.Controller
 	}
 
 	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataSource{}, dataSourcePvcField, func(obj client.Object) []string {
-		if pvc := obj.(*cdiv1.DataSource).Spec.Source.PVC; pvc != nil {
+		if pvc := obj.(*cdiv1.DataSource).GetCloneSource().PVC; pvc != nil {
 			ns := cc.GetNamespace(pvc.Namespace, obj.GetNamespace())
 			return []string{getKey(ns, pvc.Name)}
 		}
@@ -230,7 +542,7 @@ func addDataSourceControllerWatches(mgr manager.Manager, c controller.Controller
 		return err
 	}
 	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &cdiv1.DataSource{}, dataSourceSnapshotField, func(obj client.Object) []string {
-		if snapshot := obj.(*cdiv1.DataSource).Spec.Source.Snapshot; snapshot != nil {
+		if snapshot := obj.(*cdiv1.DataSource).GetCloneSource().Snapshot; snapshot != nil {
 			ns := cc.GetNamespace(snapshot.Namespace, obj.GetNamespace())
 			return []string{getKey(ns, snapshot.Name)}
 		}
@@ -242,6 +554,10 @@ func addDataSourceControllerWatches(mgr manager.Manager, c controller.Controller
 	mapToDataSource := func(obj client.Object) (reqs []reconcile.Request) {
 		reqs = appendMatchingDataSourceRequests(dataSourcePvcField, obj, reqs)
 		reqs = appendMatchingDataSourceRequests(dataSourceSnapshotField, obj, reqs)
+		// A DataVolume referencing a remote DataSource may trigger its cache import
+		if dv, ok := obj.(*cdiv1.DataVolume); ok && dv.Spec.SourceRef != nil && dv.Spec.SourceRef.Kind == cdiv1.DataVolumeDataSource {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: getSourceRefNamespace(dv), Name: dv.Spec.SourceRef.Name}})
+		}
 		return
 	}
 
@@ -310,6 +626,9 @@ func sameSourceSpec(objOld, objNew client.Object) bool {
 	if !okOld || !okNew {
 		return false
 	}
+	if !reflect.DeepEqual(dsOld.Spec.PinnedRevision, dsNew.Spec.PinnedRevision) {
+		return false
+	}
 	if dsOld.Spec.Source.PVC != nil {
 		return reflect.DeepEqual(dsOld.Spec.Source.PVC, dsNew.Spec.Source.PVC)
 	}
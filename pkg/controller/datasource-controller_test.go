@@ -23,13 +23,19 @@ import (
 	. "github.com/onsi/gomega"
 
 	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	. "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -136,6 +142,215 @@ var _ = Describe("All DataSource Tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			verifyConditions("Source snapshot Deleted", false, NotFound)
 		})
+
+		It("Should record source revisions, and roll back to a pinned revision", func() {
+			setSourcePVC := func(name string) {
+				ds.Spec.Source.PVC = &cdiv1.DataVolumeSourcePVC{Namespace: metav1.NamespaceDefault, Name: name}
+				err := reconciler.client.Update(context.TODO(), ds)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			ds = createDataSource()
+			ds.Annotations = map[string]string{AnnSourceDigest: testDigest}
+			reconciler = createDataSourceReconciler(ds,
+				CreatePvc(pvcName+"-1", metav1.NamespaceDefault, nil, nil),
+				CreatePvc(pvcName+"-2", metav1.NamespaceDefault, nil, nil))
+			setSourcePVC(pvcName + "-1")
+			verifyConditions("First source", true, ready)
+			Expect(ds.Status.Revision).To(Equal(int64(1)))
+			Expect(ds.Status.Revisions).To(HaveLen(1))
+			Expect(ds.Status.Revisions[0].Source.PVC.Name).To(Equal(pvcName + "-1"))
+			Expect(ds.Status.Revisions[0].Digest).To(Equal(testDigest))
+
+			setSourcePVC(pvcName + "-2")
+			verifyConditions("Second source", true, ready)
+			Expect(ds.Status.Revision).To(Equal(int64(2)))
+			Expect(ds.Status.Revisions).To(HaveLen(2))
+
+			ds.Spec.PinnedRevision = pointer.Int64(1)
+			err := reconciler.client.Update(context.TODO(), ds)
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Pinned to first source", true, ready)
+			Expect(ds.Spec.Source.PVC.Name).To(Equal(pvcName + "-2"))
+			Expect(ds.GetCloneSource().PVC.Name).To(Equal(pvcName + "-1"))
+			Expect(ds.Status.Source.PVC.Name).To(Equal(pvcName + "-1"))
+			Expect(ds.Status.Revision).To(Equal(int64(1)))
+			Expect(ds.Status.Revisions).To(HaveLen(2))
+
+			err = reconciler.client.Delete(context.TODO(), CreatePvc(pvcName+"-2", metav1.NamespaceDefault, nil, nil))
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Second source deleted", true, ready)
+			Expect(ds.Status.Revisions).To(HaveLen(1))
+			Expect(ds.Status.Revisions[0].Revision).To(Equal(int64(1)))
+		})
+
+		createRemoteDataSource := func() *cdiv1.DataSource {
+			ds := createDataSource()
+			ds.UID = "ds-uid"
+			ds.Spec.Source.HTTP = &cdiv1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img"}
+			ds.Spec.Cache = &cdiv1.DataSourceCache{Size: resource.MustParse("5Gi"), StorageClassName: pointer.String("golden")}
+			return ds
+		}
+
+		createReferencingDataVolume := func() *cdiv1.DataVolume {
+			dv := NewImportDataVolume("test-dv")
+			dv.Spec.Source = nil
+			dv.Spec.PVC = nil
+			dv.Spec.SourceRef = &cdiv1.DataVolumeSourceRef{Kind: cdiv1.DataVolumeDataSource, Name: dsName}
+			dv.Spec.Storage = &cdiv1.StorageSpec{
+				StorageClassName: pointer.String("tenant"),
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("50Gi")},
+				},
+			}
+			return dv
+		}
+
+		getCacheKey := func(ds *cdiv1.DataSource) types.NamespacedName {
+			return types.NamespacedName{Namespace: reconciler.cdiNamespace, Name: GetDataSourceCacheName(ds)}
+		}
+
+		getCacheDv := func() *cdiv1.DataVolume {
+			cacheDv := &cdiv1.DataVolume{}
+			err := reconciler.client.Get(context.TODO(), getCacheKey(ds), cacheDv)
+			Expect(err).ToNot(HaveOccurred())
+			return cacheDv
+		}
+
+		expectNoCacheDv := func(key types.NamespacedName) {
+			err := reconciler.client.Get(context.TODO(), key, &cdiv1.DataVolume{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		}
+
+		It("Should import a remote source to the cache PVC once a DataVolume references the DataSource", func() {
+			ds = createRemoteDataSource()
+			reconciler = createDataSourceReconciler(ds)
+			verifyConditions("No referencing DV", false, notCached)
+			cacheKey := getCacheKey(ds)
+			Expect(cacheKey.Name).To(HavePrefix(cdiv1.DataSourceCacheNamePrefix + "-"))
+			Expect(ds.Status.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: cacheKey.Namespace, Name: cacheKey.Name}))
+
+			err := reconciler.client.Create(context.TODO(), createReferencingDataVolume())
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Cache import created", false, cacheImportCreated)
+
+			cacheDv := getCacheDv()
+			Expect(cacheDv.Spec.Source.HTTP.URL).To(Equal("http://example.com/disk.img"))
+			Expect(cacheDv.Spec.Storage.Resources.Requests.Storage().String()).To(Equal("5Gi"))
+			Expect(cacheDv.Spec.Storage.StorageClassName).To(Equal(pointer.String("golden")))
+			Expect(cacheDv.Labels[common.DataSourceCacheLabel]).To(Equal("true"))
+			Expect(cacheDv.Annotations[AnnDataSourceCacheHash]).To(Equal(GetDataSourceCacheHash(ds)))
+			Expect(cacheDv.OwnerReferences).To(BeEmpty())
+			event := <-reconciler.recorder.(*record.FakeRecorder).Events
+			Expect(event).To(ContainSubstring(cacheImportCreated))
+
+			cacheDv.Status.Phase = cdiv1.ImportInProgress
+			err = reconciler.client.Update(context.TODO(), cacheDv)
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Cache import in progress", false, string(cdiv1.ImportInProgress))
+
+			cacheDv.Status.Phase = cdiv1.Succeeded
+			err = reconciler.client.Update(context.TODO(), cacheDv)
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Cache import succeeded", true, ready)
+		})
+
+		It("Should not create the cache of a remote source without cache storage", func() {
+			ds = createRemoteDataSource()
+			ds.Spec.Cache = nil
+			reconciler = createDataSourceReconciler(ds, createReferencingDataVolume())
+			verifyConditions("No cache storage", false, notCached)
+			expectNoCacheDv(getCacheKey(ds))
+		})
+
+		It("Should not adopt a PVC with the cache name which is not the DataSource cache", func() {
+			ds = createRemoteDataSource()
+			pvc := CreatePvc(GetDataSourceCacheName(ds), util.GetNamespace(), nil, nil)
+			reconciler = createDataSourceReconciler(ds, pvc, createReferencingDataVolume())
+			verifyConditions("Cache conflict", false, cacheConflict)
+			event := <-reconciler.recorder.(*record.FakeRecorder).Events
+			Expect(event).To(ContainSubstring(cacheConflict))
+			expectNoCacheDv(getCacheKey(ds))
+		})
+
+		It("Should import a new cache and delete the unused one when the remote source changes", func() {
+			ds = createRemoteDataSource()
+			reconciler = createDataSourceReconciler(ds, createReferencingDataVolume())
+			verifyConditions("Cache import created", false, cacheImportCreated)
+			cacheDv := getCacheDv()
+			cacheDv.Status.Phase = cdiv1.Succeeded
+			err := reconciler.client.Update(context.TODO(), cacheDv)
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Cache import succeeded", true, ready)
+			oldCacheKey := getCacheKey(ds)
+
+			ds.Spec.Source.HTTP.URL = "http://example.com/disk-v2.img"
+			err = reconciler.client.Update(context.TODO(), ds)
+			Expect(err).ToNot(HaveOccurred())
+			verifyConditions("Cache reimport created", false, cacheImportCreated)
+			expectNoCacheDv(oldCacheKey)
+			cacheDv = getCacheDv()
+			Expect(cacheDv.Name).ToNot(Equal(oldCacheKey.Name))
+			Expect(cacheDv.Spec.Source.HTTP.URL).To(Equal("http://example.com/disk-v2.img"))
+			Expect(cacheDv.Annotations[AnnDataSourceCacheHash]).To(Equal(GetDataSourceCacheHash(ds)))
+			// The revision of the deleted cache is pruned
+			Expect(ds.Status.Revision).To(Equal(int64(2)))
+			Expect(ds.Status.Revisions).To(HaveLen(1))
+		})
+
+		It("Should share the cache between the DataSources with the same remote source", func() {
+			ds = createRemoteDataSource()
+			otherDs := createRemoteDataSource()
+			otherDs.Namespace = "tenant"
+			otherDs.UID = "other-ds-uid"
+			reconciler = createDataSourceReconciler(ds, otherDs, createReferencingDataVolume())
+			verifyConditions("Cache import created", false, cacheImportCreated)
+			Expect(getCacheKey(otherDs)).To(Equal(getCacheKey(ds)))
+
+			otherKey := types.NamespacedName{Namespace: otherDs.Namespace, Name: otherDs.Name}
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: otherKey})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconciler.client.Get(context.TODO(), otherKey, otherDs)).To(Succeed())
+			Expect(otherDs.Status.Source).To(Equal(ds.Status.Source))
+
+			By("Keeping the cache until no DataSource uses it")
+			Expect(reconciler.client.Delete(context.TODO(), ds)).To(Succeed())
+			_, err = reconciler.Reconcile(context.TODO(), dsReq)
+			Expect(err).ToNot(HaveOccurred())
+			getCacheDv()
+			Expect(reconciler.client.Delete(context.TODO(), otherDs)).To(Succeed())
+			_, err = reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: otherKey})
+			Expect(err).ToNot(HaveOccurred())
+			expectNoCacheDv(getCacheKey(otherDs))
+		})
+
+		It("Should keep cloning the cache of the pinned revision when the remote source changes", func() {
+			ds = createRemoteDataSource()
+			reconciler = createDataSourceReconciler(ds, createReferencingDataVolume())
+			verifyConditions("Cache import created", false, cacheImportCreated)
+			pinnedCacheKey := getCacheKey(ds)
+			ds.Spec.PinnedRevision = pointer.Int64(ds.Status.Revision)
+			ds.Spec.Source.HTTP.URL = "http://example.com/disk-v2.img"
+			Expect(reconciler.client.Update(context.TODO(), ds)).To(Succeed())
+			_, err := reconciler.Reconcile(context.TODO(), dsReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reconciler.client.Get(context.TODO(), dsKey, ds)).To(Succeed())
+			Expect(ds.GetCloneSource().PVC.Name).To(Equal(pinnedCacheKey.Name))
+			Expect(reconciler.client.Get(context.TODO(), pinnedCacheKey, &cdiv1.DataVolume{})).To(Succeed())
+			expectNoCacheDv(getCacheKey(ds))
+		})
+
+		It("Should not change the source when pinned to an unknown revision", func() {
+			ds = createDataSource()
+			ds.Spec.Source.PVC = &cdiv1.DataVolumeSourcePVC{Namespace: metav1.NamespaceDefault, Name: pvcName}
+			ds.Spec.PinnedRevision = pointer.Int64(5)
+			reconciler = createDataSourceReconciler(ds, CreatePvc(pvcName, metav1.NamespaceDefault, nil, nil))
+			verifyConditions("Pinned to unknown revision", true, ready)
+			Expect(ds.Spec.Source.PVC.Name).To(Equal(pvcName))
+			Expect(ds.Status.Revision).To(Equal(int64(1)))
+			event := <-reconciler.recorder.(*record.FakeRecorder).Events
+			Expect(event).To(ContainSubstring(revisionNotFound))
+		})
 	})
 })
 
@@ -143,11 +358,16 @@ func createDataSourceReconciler(objects ...runtime.Object) *DataSourceReconciler
 	s := scheme.Scheme
 	_ = cdiv1.AddToScheme(s)
 	_ = snapshotv1.AddToScheme(s)
-	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objects...).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objects...).
+		WithIndex(&cdiv1.DataVolume{}, dvSourceRefDataSourceField, indexDvSourceRefDataSource).
+		WithIndex(&cdiv1.DataSource{}, dataSourceCacheField, indexDataSourceCache).
+		Build()
 	r := &DataSourceReconciler{
-		client: cl,
-		scheme: s,
-		log:    cronLog,
+		client:       cl,
+		recorder:     record.NewFakeRecorder(10),
+		scheme:       s,
+		log:          cronLog,
+		cdiNamespace: util.GetNamespace(),
 	}
 	return r
 }
@@ -38,9 +38,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	"kubevirt.io/containerized-data-importer/pkg/controller/clone"
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 	"kubevirt.io/containerized-data-importer/pkg/controller/populators"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
 const (
@@ -176,7 +178,7 @@ func (r *CloneReconcilerBase) addVolumeCloneSourceWatch(datavolumeController con
 				r.log.Info("Failed to get DataVolume", "error", err)
 				return nil
 			}
-			if err := r.populateSourceIfSourceRef(dv); err != nil {
+			if err := r.populateCloneSource(dv); err != nil {
 				r.log.Info("Failed to check DataSource", "error", err)
 				return nil
 			}
@@ -203,6 +205,11 @@ func (r *CloneReconcilerBase) updatePVCForPopulation(dataVolume *cdiv1.DataVolum
 		_, _, sourcNamespace := cc.GetCloneSourceInfo(dataVolume)
 		cc.AddAnnotation(pvc, populators.AnnDataSourceNamespace, sourcNamespace)
 	}
+	if dataVolume.Spec.Source.PVC != nil {
+		if err := cc.AddClonePathFilterAnnotations(pvc, dataVolume.Spec.Source.PVC.PathFilter); err != nil {
+			return err
+		}
+	}
 	apiGroup := cc.AnnAPIGroup
 	pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
 		APIGroup: &apiGroup,
@@ -443,7 +450,7 @@ func (r *CloneReconcilerBase) updateStatusPhaseForPopulator(pvc *corev1.Persiste
 }
 
 func (r *CloneReconcilerBase) updateStatusPhase(pvc *corev1.PersistentVolumeClaim, dataVolumeCopy *cdiv1.DataVolume, event *Event) error {
-	if err := r.populateSourceIfSourceRef(dataVolumeCopy); err != nil {
+	if err := r.populateCloneSource(dataVolumeCopy); err != nil {
 		return err
 	}
 	_, sourceName, sourceNamespace := cc.GetCloneSourceInfo(dataVolumeCopy)
@@ -494,8 +501,15 @@ func (r *CloneReconcilerBase) updateStatusPhase(pvc *corev1.PersistentVolumeClai
 }
 
 // If SourceRef is set, populate spec.Source with data from the DataSource
-// Note that when the controller actually updates the DV (updateDataVolume), we nil out spec.Source when SourceRef is set
-func (r *CloneReconcilerBase) populateSourceIfSourceRef(dv *cdiv1.DataVolume) error {
+// If the DV is a deduplicated import, populate spec.Source with the clone source while keeping the import source
+// Note that when the controller actually updates the DV (updateDataVolume), we nil out spec.Source when SourceRef is set,
+// and drop the clone source of a deduplicated import
+func (r *CloneReconcilerBase) populateCloneSource(dv *cdiv1.DataVolume) error {
+	if source := getDeduplicatedImportSource(dv); source != nil && dv.Spec.Source != nil {
+		dv.Spec.Source.PVC = source.PVC
+		dv.Spec.Source.Snapshot = source.Snapshot
+		return nil
+	}
 	if dv.Spec.SourceRef == nil {
 		return nil
 	}
@@ -510,9 +524,44 @@ func (r *CloneReconcilerBase) populateSourceIfSourceRef(dv *cdiv1.DataVolume) er
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: dv.Spec.SourceRef.Name, Namespace: ns}, dataSource); err != nil {
 		return err
 	}
+	source := dataSource.GetCloneSource()
+	if source.PVC != nil && source.PVC.IsDataSourceCache() {
+		if err := r.verifyDataSourceCache(source.PVC); err != nil {
+			return err
+		}
+	}
 	dv.Spec.Source = &cdiv1.DataVolumeSource{
-		PVC:      dataSource.Spec.Source.PVC,
-		Snapshot: dataSource.Spec.Source.Snapshot,
+		PVC:      source.PVC,
+		Snapshot: source.Snapshot,
+	}
+	return nil
+}
+
+// verifyDataSourceCache makes sure a PVC named like a DataSource cache is imported by the cache DataVolume in the CDI
+// namespace, since cloning it is authorized by the permissions on the DataSource namespace
+func (r *CloneReconcilerBase) verifyDataSourceCache(source *cdiv1.DataVolumeSourcePVC) error {
+	key := types.NamespacedName{Namespace: source.Namespace, Name: source.Name}
+	if key.Namespace != util.GetNamespace() {
+		return errors.Errorf("PersistentVolumeClaim %s/%s is not a DataSource cache", key.Namespace, key.Name)
+	}
+	cacheDv := &cdiv1.DataVolume{}
+	if err := r.client.Get(context.TODO(), key, cacheDv); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		cacheDv = nil
+	} else if cacheDv.Labels[common.DataSourceCacheLabel] != "true" {
+		return errors.Errorf("DataVolume %s/%s is not a DataSource cache", key.Namespace, key.Name)
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(context.TODO(), key, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cacheDv == nil || !metav1.IsControlledBy(pvc, cacheDv) {
+		return errors.Errorf("PersistentVolumeClaim %s/%s is not a DataSource cache", key.Namespace, key.Name)
 	}
 	return nil
 }
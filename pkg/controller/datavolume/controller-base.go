@@ -344,6 +344,12 @@ func getDataVolumeOp(log logr.Logger, dv *cdiv1.DataVolume, client client.Client
 	if dv.Spec.SourceRef != nil {
 		return getSourceRefOp(log, dv, client)
 	}
+	if source := getDeduplicatedImportSource(dv); source != nil {
+		if source.Snapshot != nil {
+			return dataVolumeSnapshotClone
+		}
+		return dataVolumePvcClone
+	}
 	if src != nil && src.PVC != nil {
 		return dataVolumePvcClone
 	}
@@ -378,10 +384,11 @@ func getSourceRefOp(log logr.Logger, dv *cdiv1.DataVolume, client client.Client)
 		return dataVolumeNop
 	}
 
+	source := dataSource.GetCloneSource()
 	switch {
-	case dataSource.Spec.Source.PVC != nil:
+	case source.PVC != nil:
 		return dataVolumePvcClone
-	case dataSource.Spec.Source.Snapshot != nil:
+	case source.Snapshot != nil:
 		return dataVolumeSnapshotClone
 	default:
 		return dataVolumeNop
@@ -1080,14 +1087,22 @@ func (r *ReconcilerBase) newPersistentVolumeClaim(dataVolume *cdiv1.DataVolume,
 func (r *ReconcilerBase) updateDataVolume(dv *cdiv1.DataVolume) error {
 	// Restore so we don't nil out the dv that is being worked on
 	var sourceCopy *cdiv1.DataVolumeSource
+	isDeduplicatedImport := getDeduplicatedImportSource(dv) != nil && dv.Spec.Source != nil
 
 	if dv.Spec.SourceRef != nil || dvUsesVolumePopulator(dv) {
 		sourceCopy = dv.Spec.Source
 		dv.Spec.Source = nil
+	} else if isDeduplicatedImport {
+		// The clone source of a deduplicated import is only populated in memory
+		sourceCopy = dv.Spec.Source
+		importSource := *sourceCopy
+		importSource.PVC = nil
+		importSource.Snapshot = nil
+		dv.Spec.Source = &importSource
 	}
 
 	err := r.client.Update(context.TODO(), dv)
-	if dv.Spec.SourceRef != nil || dvUsesVolumePopulator(dv) {
+	if dv.Spec.SourceRef != nil || dvUsesVolumePopulator(dv) || isDeduplicatedImport {
 		dv.Spec.Source = sourceCopy
 	}
 	return err
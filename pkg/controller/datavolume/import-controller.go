@@ -18,6 +18,7 @@ package datavolume
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -130,6 +131,9 @@ func (r *ImportReconciler) updatePVCForPopulation(dataVolume *cdiv1.DataVolume,
 	if err := cc.AddImmediateBindingAnnotationIfWFFCDisabled(pvc, r.featureGates); err != nil {
 		return err
 	}
+	if err := setRetryPolicyAnnotation(dataVolume, pvc); err != nil {
+		return err
+	}
 	apiGroup := cc.AnnAPIGroup
 	pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
 		APIGroup: &apiGroup,
@@ -142,6 +146,10 @@ func (r *ImportReconciler) updatePVCForPopulation(dataVolume *cdiv1.DataVolume,
 func (r *ImportReconciler) updateAnnotations(dataVolume *cdiv1.DataVolume, pvc *corev1.PersistentVolumeClaim) error {
 	annotations := pvc.Annotations
 
+	if err := setRetryPolicyAnnotation(dataVolume, pvc); err != nil {
+		return err
+	}
+
 	if checkpoint := cc.GetNextCheckpoint(pvc, r.getCheckpointArgs(dataVolume)); checkpoint != nil {
 		annotations[cc.AnnCurrentCheckpoint] = checkpoint.Current
 		annotations[cc.AnnPreviousCheckpoint] = checkpoint.Previous
@@ -184,6 +192,19 @@ func (r *ImportReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	return r.reconcile(ctx, req, r)
 }
 
+// setRetryPolicyAnnotation passes the DataVolume retry policy to the import controller
+func setRetryPolicyAnnotation(dataVolume *cdiv1.DataVolume, pvc *corev1.PersistentVolumeClaim) error {
+	if dataVolume.Spec.RetryPolicy == nil {
+		return nil
+	}
+	policy, err := json.Marshal(dataVolume.Spec.RetryPolicy)
+	if err != nil {
+		return err
+	}
+	cc.AddAnnotation(pvc, cc.AnnRetryPolicy, string(policy))
+	return nil
+}
+
 func (r *ImportReconciler) sync(log logr.Logger, req reconcile.Request) (dvSyncResult, error) {
 	syncState, err := r.syncImport(log, req)
 	if err == nil {
@@ -198,6 +219,18 @@ func (r *ImportReconciler) syncImport(log logr.Logger, req reconcile.Request) (d
 		return syncState, syncErr
 	}
 
+	if dryRun, err := r.syncDryRun(&syncState); err != nil || dryRun {
+		return syncState, err
+	}
+
+	if detecting, err := r.syncSizeDetection(&syncState); err != nil || detecting {
+		return syncState, err
+	}
+
+	if deduplicated, err := r.deduplicateImport(&syncState); err != nil || deduplicated {
+		return syncState, err
+	}
+
 	pvcModifier := r.updateAnnotations
 	if syncState.usePopulator {
 		if syncState.dvMutated.Status.Phase != cdiv1.Succeeded {
@@ -217,6 +250,9 @@ func (r *ImportReconciler) syncImport(log logr.Logger, req reconcile.Request) (d
 		r.setVddkAnnotations(&syncState)
 		syncErr = cc.MaybeSetPvcMultiStageAnnotation(syncState.pvc, r.getCheckpointArgs(syncState.dvMutated))
 	}
+	if syncErr == nil {
+		syncErr = r.recordImportSourceIdentity(&syncState)
+	}
 	return syncState, syncErr
 }
 
@@ -285,6 +321,10 @@ func (r *ImportReconciler) updateStatusPhase(pvc *corev1.PersistentVolumeClaim,
 		event.reason = ImportInProgress
 		event.message = fmt.Sprintf(MessageImportInProgress, pvc.Name)
 	case string(corev1.PodFailed):
+		if pvc.Annotations[cc.AnnImportFailed] == "true" {
+			// The retry policy gave up on the import
+			dataVolumeCopy.Status.Phase = cdiv1.Failed
+		}
 		event.eventType = corev1.EventTypeWarning
 		event.reason = ImportFailed
 		event.message = fmt.Sprintf(MessageImportFailed, pvc.Name)
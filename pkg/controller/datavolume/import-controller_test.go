@@ -184,6 +184,20 @@ var _ = Describe("All DataVolume Tests", func() {
 			Expect(pvc.Labels[common.KubePersistentVolumeFillingUpSuppressLabelKey]).To(Equal(common.KubePersistentVolumeFillingUpSuppressLabelValue))
 		})
 
+		It("Should not create a PVC for a dry-run import DV", func() {
+			dv := NewImportDataVolume("test-dv")
+			dv.Annotations = map[string]string{AnnDryRun: "true"}
+			reconciler = createImportReconciler(dv)
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+			Expect(err).ToNot(HaveOccurred())
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, &corev1.PersistentVolumeClaim{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			dv = &cdiv1.DataVolume{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, dv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Status.Phase).To(Equal(cdiv1.Pending))
+		})
+
 		It("Should fail if dv source not import when use populators", func() {
 			scName := "testSC"
 			sc := CreateStorageClassWithProvisioner(scName, map[string]string{AnnDefaultStorageClass: "true"}, map[string]string{}, "csi-plugin")
@@ -350,6 +364,7 @@ var _ = Describe("All DataVolume Tests", func() {
 
 		It("Should fail on missing size, without storageClass", func() {
 			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
+			importDataVolume.Spec.Source = &cdiv1.DataVolumeSource{Registry: &cdiv1.DataVolumeSourceRegistry{}}
 			// spec with accessMode/VolumeMode so storageprofile is not needed
 			importDataVolume.Spec.Storage = createStorageSpec()
 			importDataVolume.Spec.Storage.Resources = corev1.ResourceRequirements{}
@@ -364,6 +379,7 @@ var _ = Describe("All DataVolume Tests", func() {
 		It("Should fail on missing size, with StorageClass", func() {
 			storageClassName := "defaultSc"
 			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
+			importDataVolume.Spec.Source = &cdiv1.DataVolumeSource{Registry: &cdiv1.DataVolumeSourceRegistry{}}
 			// spec with accessMode/VolumeMode so storageprofile is not needed
 			importDataVolume.Spec.Storage = createStorageSpec()
 			importDataVolume.Spec.Storage.Resources = corev1.ResourceRequirements{}
@@ -377,6 +393,56 @@ var _ = Describe("All DataVolume Tests", func() {
 			Expect(err.Error()).To(ContainSubstring("missing storage size"))
 		})
 
+		It("Should wait for the size detection of an import DV without size", func() {
+			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
+			importDataVolume.Spec.Storage = createStorageSpec()
+			importDataVolume.Spec.Storage.Resources = corev1.ResourceRequirements{}
+			defaultStorageClass := CreateStorageClass("defaultSc", map[string]string{AnnDefaultStorageClass: "true"})
+			reconciler = createImportReconciler(defaultStorageClass, importDataVolume)
+
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+			Expect(err).ToNot(HaveOccurred())
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, &corev1.PersistentVolumeClaim{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			dv := &cdiv1.DataVolume{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, dv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Status.Phase).To(Equal(cdiv1.Pending))
+			Expect(<-reconciler.recorder.(*record.FakeRecorder).Events).To(ContainSubstring(ImportSizeDetection))
+		})
+
+		It("Should size the PVC of an import DV without size from the detected virtual size", func() {
+			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
+			importDataVolume.Annotations = map[string]string{AnnPreflightResult: `{"phase":"Succeeded","format":"qcow2","virtualSize":1073741824}`}
+			importDataVolume.Spec.Storage = createStorageSpec()
+			importDataVolume.Spec.Storage.Resources = corev1.ResourceRequirements{}
+			defaultStorageClass := CreateStorageClass("defaultSc", map[string]string{AnnDefaultStorageClass: "true"})
+			reconciler = createImportReconciler(defaultStorageClass, importDataVolume)
+
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+			Expect(err).ToNot(HaveOccurred())
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*pvc.Spec.VolumeMode).To(Equal(BlockMode))
+			Expect(pvc.Spec.Resources.Requests.Storage().Value()).To(Equal(int64(1073741824)))
+		})
+
+		It("Should not create the PVC of an import DV whose size can't be detected", func() {
+			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
+			importDataVolume.Annotations = map[string]string{AnnPreflightResult: `{"phase":"Failed","reason":"HTTPNotFound","message":"Unable to connect to http data source"}`}
+			importDataVolume.Spec.Storage = createStorageSpec()
+			importDataVolume.Spec.Storage.Resources = corev1.ResourceRequirements{}
+			defaultStorageClass := CreateStorageClass("defaultSc", map[string]string{AnnDefaultStorageClass: "true"})
+			reconciler = createImportReconciler(defaultStorageClass, importDataVolume)
+
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+			Expect(err).ToNot(HaveOccurred())
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, &corev1.PersistentVolumeClaim{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(<-reconciler.recorder.(*record.FakeRecorder).Events).To(ContainSubstring("Unable to connect to http data source"))
+		})
+
 		DescribeTable("Should set params on a PVC from storageProfile when import DV has no accessMode and no volume mode", func(contentType cdiv1.DataVolumeContentType) {
 			scName := "testStorageClass"
 			importDataVolume := newImportDataVolumeWithPvc("test-dv", nil)
@@ -656,6 +722,21 @@ var _ = Describe("All DataVolume Tests", func() {
 			Expect(pvc.Labels["test"]).To(Equal("test-label"))
 		})
 
+		It("Should pass the retry policy from DV to created PVC", func() {
+			dv := NewImportDataVolume("test-dv")
+			dv.Spec.RetryPolicy = &cdiv1.RetryPolicy{
+				MaxAttempts: pointer.Int32(3),
+				RetryOn:     []cdiv1.ImportErrorClass{cdiv1.ImportErrorNetwork},
+			}
+			reconciler = createImportReconciler(dv)
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+			Expect(err).ToNot(HaveOccurred())
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.GetAnnotations()[AnnRetryPolicy]).To(Equal(`{"maxAttempts":3,"retryOn":["Network"]}`))
+		})
+
 		It("Should pass annotation from DV with S3 source to created a PVC on a DV", func() {
 			dv := newS3ImportDataVolume("test-dv")
 			dv.SetAnnotations(make(map[string]string))
@@ -968,6 +1049,167 @@ var _ = Describe("All DataVolume Tests", func() {
 		})
 	})
 
+	var _ = Describe("Import deduplication", func() {
+		const registryURL = "docker://registry.example.com/disk@sha256:0123456789abcdef"
+
+		var (
+			sc        *storagev1.StorageClass
+			csiDriver *storagev1.CSIDriver
+		)
+
+		BeforeEach(func() {
+			sc = CreateStorageClassWithProvisioner(testStorageClass, map[string]string{AnnDefaultStorageClass: "true"}, map[string]string{}, "csi-plugin")
+			csiDriver = &storagev1.CSIDriver{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "csi-plugin",
+				},
+			}
+		})
+
+		newRegistryImportDataVolume := func(name string) *cdiv1.DataVolume {
+			dv := NewImportDataVolume(name)
+			dv.Spec.Source = &cdiv1.DataVolumeSource{
+				Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String(registryURL)},
+			}
+			dv.Spec.PVC.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1G")}
+			return dv
+		}
+
+		reconcileDataVolume := func(dv *cdiv1.DataVolume) *cdiv1.DataVolume {
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: dv.Name, Namespace: dv.Namespace}})
+			Expect(err).ToNot(HaveOccurred())
+			dv = &cdiv1.DataVolume{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, dv)
+			Expect(err).ToNot(HaveOccurred())
+			return dv
+		}
+
+		newImportedSnapshot := func(name, namespace, cronName, identity string) *snapshotv1.VolumeSnapshot {
+			size := resource.MustParse("1G")
+			return &snapshotv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels: map[string]string{
+						common.DataImportCronLabel:       cronName,
+						common.ImportSourceIdentityLabel: identity,
+					},
+				},
+				Spec: snapshotv1.VolumeSnapshotSpec{
+					Source: snapshotv1.VolumeSnapshotSource{PersistentVolumeClaimName: pointer.String(name)},
+				},
+				Status: &snapshotv1.VolumeSnapshotStatus{
+					ReadyToUse:  pointer.Bool(true),
+					RestoreSize: &size,
+				},
+			}
+		}
+
+		newDataImportCron := func(name, namespace string) *cdiv1.DataImportCron {
+			return &cdiv1.DataImportCron{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		}
+
+		It("Should clone an already imported DataImportCron snapshot with the same source identity instead of importing", func() {
+			dv := newRegistryImportDataVolume("test-dv")
+			identity := getImportSourceIdentity(dv)
+			Expect(identity).ToNot(BeEmpty())
+			imported := newImportedSnapshot("imported", metav1.NamespaceDefault, "cron", identity)
+			reconciler = createImportReconcilerWithDeduplication(dv, sc, csiDriver, imported, newDataImportCron("cron", metav1.NamespaceDefault))
+
+			dv = reconcileDataVolume(dv)
+			Expect(dv.Annotations[AnnImportSourceIdentity]).To(Equal(identity))
+			Expect(dv.Annotations).ToNot(HaveKey(AnnExtendedCloneToken))
+			Expect(dv.Spec.Source.Registry).ToNot(BeNil())
+			Expect(dv.Spec.Source.Snapshot).To(BeNil())
+			source := getDeduplicatedImportSource(dv)
+			Expect(source).ToNot(BeNil())
+			Expect(source.Snapshot).To(Equal(&cdiv1.DataVolumeSourceSnapshot{Namespace: metav1.NamespaceDefault, Name: "imported"}))
+			Expect(getDataVolumeOp(dvImportLog, dv, reconciler.client)).To(Equal(dataVolumeSnapshotClone))
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			event := <-reconciler.recorder.(*record.FakeRecorder).Events
+			Expect(event).To(ContainSubstring(ImportDeduplicated))
+		})
+
+		DescribeTable("Should import when no trusted imported snapshot with the same source identity exists", func(modify func(*snapshotv1.VolumeSnapshot), objs ...runtime.Object) {
+			dv := newRegistryImportDataVolume("test-dv")
+			imported := newImportedSnapshot("imported", metav1.NamespaceDefault, "cron", getImportSourceIdentity(dv))
+			modify(imported)
+			reconciler = createImportReconcilerWithDeduplication(append(objs, dv, sc, csiDriver, imported)...)
+
+			dv = reconcileDataVolume(dv)
+			Expect(dv.Annotations[AnnImportSourceIdentity]).ToNot(BeEmpty())
+			Expect(getDeduplicatedImportSource(dv)).To(BeNil())
+			pvc := &corev1.PersistentVolumeClaim{}
+			err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Spec.DataSourceRef.Kind).To(Equal(cdiv1.VolumeImportSourceRef))
+		},
+			Entry("in another namespace", func(snapshot *snapshotv1.VolumeSnapshot) {
+				snapshot.Namespace = "other-ns"
+			}, newDataImportCron("cron", "other-ns")),
+			Entry("without DataImportCron", func(snapshot *snapshotv1.VolumeSnapshot) {}),
+			Entry("not taken from the DataImportCron import", func(snapshot *snapshotv1.VolumeSnapshot) {
+				snapshot.Spec.Source.PersistentVolumeClaimName = pointer.String("user-pvc")
+			}, newDataImportCron("cron", metav1.NamespaceDefault)),
+			Entry("not ready", func(snapshot *snapshotv1.VolumeSnapshot) {
+				snapshot.Status.ReadyToUse = pointer.Bool(false)
+			}, newDataImportCron("cron", metav1.NamespaceDefault)),
+			Entry("imported with a different content type", func(snapshot *snapshotv1.VolumeSnapshot) {
+				dv := newRegistryImportDataVolume("other-dv")
+				dv.Spec.ContentType = cdiv1.DataVolumeArchive
+				snapshot.Labels[common.ImportSourceIdentityLabel] = getImportSourceIdentity(dv)
+			}, newDataImportCron("cron", metav1.NamespaceDefault)),
+		)
+
+		DescribeTable("Should add the PVC of a succeeded import to the deduplication index", func(cronName string, expectLabel bool) {
+			dv := newRegistryImportDataVolume("test-dv")
+			if cronName != "" {
+				dv.Labels = map[string]string{common.DataImportCronLabel: cronName}
+			}
+			reconciler = createImportReconcilerWithDeduplication(dv, sc, csiDriver)
+			dv = reconcileDataVolume(dv)
+			identity := dv.Annotations[AnnImportSourceIdentity]
+			Expect(identity).ToNot(BeEmpty())
+
+			dv.Status.Phase = cdiv1.Succeeded
+			Expect(reconciler.client.Update(context.TODO(), dv)).To(Succeed())
+			reconcileDataVolume(dv)
+			pvc := &corev1.PersistentVolumeClaim{}
+			err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+			Expect(err).ToNot(HaveOccurred())
+			if expectLabel {
+				Expect(pvc.Labels[common.ImportSourceIdentityLabel]).To(Equal(identity))
+			} else {
+				Expect(pvc.Labels).ToNot(HaveKey(common.ImportSourceIdentityLabel))
+			}
+		},
+			Entry("only when imported by a DataImportCron", "cron", true),
+			Entry("not when imported by a user", "", false),
+		)
+
+		DescribeTable("Should identify import sources", func(modify func(*cdiv1.DataVolume), expectIdentity bool) {
+			dv := NewImportDataVolume("test-dv")
+			modify(dv)
+			Expect(getImportSourceIdentity(dv) != "").To(Equal(expectIdentity))
+		},
+			Entry("http without checksum", func(dv *cdiv1.DataVolume) {}, false),
+			Entry("http with checksum", func(dv *cdiv1.DataVolume) { dv.Spec.Source.HTTP.Checksum = "sha256:0123abcd" }, true),
+			Entry("http with credentials", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source.HTTP.Checksum = "sha256:0123abcd"
+				dv.Spec.Source.HTTP.SecretRef = "secret"
+			}, false),
+			Entry("registry with digest", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source = &cdiv1.DataVolumeSource{Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String(registryURL)}}
+			}, true),
+			Entry("registry with tag", func(dv *cdiv1.DataVolume) {
+				dv.Spec.Source = &cdiv1.DataVolumeSource{Registry: &cdiv1.DataVolumeSourceRegistry{URL: pointer.String("docker://registry.example.com/disk:latest")}}
+			}, false),
+		)
+	})
+
 	var _ = Describe("Reconcile Datavolume status", func() {
 		DescribeTable("if no pvc exists", func(current, expected cdiv1.DataVolumePhase) {
 			reconciler = createImportReconciler(NewImportDataVolume("test-dv"))
@@ -1407,6 +1649,7 @@ var _ = Describe("All DataVolume Tests", func() {
 			Entry("should switch to scheduled for import", NewImportDataVolume("test-dv"), cdiv1.Pending, cdiv1.ImportScheduled, corev1.ClaimBound, corev1.PodPending, AnnImportPod, "Import into test-dv scheduled", AnnPriorityClassName, "p0"),
 			Entry("should switch to inprogress for import", NewImportDataVolume("test-dv"), cdiv1.Pending, cdiv1.ImportInProgress, corev1.ClaimBound, corev1.PodRunning, AnnImportPod, "Import into test-dv in progress", AnnPriorityClassName, "p0"),
 			Entry("should stay the same for import after pod fails", NewImportDataVolume("test-dv"), cdiv1.Pending, cdiv1.ImportScheduled, corev1.ClaimBound, corev1.PodFailed, AnnImportPod, "Failed to import into PVC test-dv", AnnPriorityClassName, "p0"),
+			Entry("should switch to failed when the import failed for good", NewImportDataVolume("test-dv"), cdiv1.ImportScheduled, cdiv1.Failed, corev1.ClaimBound, corev1.PodFailed, AnnImportPod, "Failed to import into PVC test-dv", AnnImportFailed, "true"),
 			Entry("should switch to failed on claim lost for impot", NewImportDataVolume("test-dv"), cdiv1.Pending, cdiv1.Failed, corev1.ClaimLost, corev1.PodFailed, AnnImportPod, "PVC test-dv lost", AnnPriorityClassName, "p0"),
 			Entry("should switch to succeeded for import", NewImportDataVolume("test-dv"), cdiv1.Pending, cdiv1.Succeeded, corev1.ClaimBound, corev1.PodSucceeded, AnnImportPod, "Successfully imported into PVC test-dv", AnnPriorityClassName, "p0"),
 			Entry("should switch to scheduled for blank", newBlankImageDataVolume("test-dv"), cdiv1.Pending, cdiv1.ImportScheduled, corev1.ClaimBound, corev1.PodPending, AnnImportPod, "Import into test-dv scheduled", AnnPriorityClassName, "p0-upload"),
@@ -1855,6 +2098,20 @@ func createImportReconcilerWFFCDisabled(objects ...runtime.Object) *ImportReconc
 	return createImportReconcilerWithoutConfig(objs...)
 }
 
+func createImportReconcilerWithDeduplication(objects ...runtime.Object) *ImportReconciler {
+	cdiConfig := MakeEmptyCDIConfigSpec(common.ConfigName)
+	cdiConfig.Status = cdiv1.CDIConfigStatus{
+		ScratchSpaceStorageClass: testStorageClass,
+	}
+	cdiConfig.Spec.FeatureGates = []string{featuregates.ImportDeduplication}
+
+	objs := []runtime.Object{}
+	objs = append(objs, objects...)
+	objs = append(objs, cdiConfig)
+
+	return createImportReconcilerWithoutConfig(objs...)
+}
+
 func createImportReconciler(objects ...runtime.Object) *ImportReconciler {
 	cdiConfig := MakeEmptyCDIConfigSpec(common.ConfigName)
 	cdiConfig.Status = cdiv1.CDIConfigStatus{
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datavolume
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+const (
+	// ImportDeduplicated provides a const to indicate an import is satisfied by cloning an already imported volume
+	ImportDeduplicated = "ImportDeduplicated"
+
+	// MessageImportDeduplicated provides a const to form import deduplicated message
+	MessageImportDeduplicated = "Import satisfied by cloning already imported %s %s/%s"
+)
+
+// deduplicateImport switches a new import DataVolume to a clone of an already imported volume
+// with the same source identity, returns true if the DataVolume was switched
+func (r *ImportReconciler) deduplicateImport(syncState *dvSyncState) (bool, error) {
+	dv := syncState.dvMutated
+	if syncState.pvc != nil || !syncState.usePopulator || dv.Status.Phase == cdiv1.Succeeded || len(dv.Spec.Checkpoints) > 0 {
+		return false, nil
+	}
+	enabled, err := r.featureGates.ImportDeduplicationEnabled()
+	if err != nil || !enabled {
+		return false, err
+	}
+
+	identity, ok := dv.Annotations[cc.AnnImportSourceIdentity]
+	if !ok {
+		identity = getImportSourceIdentity(dv)
+		cc.AddAnnotation(dv, cc.AnnImportSourceIdentity, identity)
+	}
+	if identity == "" {
+		return false, nil
+	}
+
+	source, err := r.getImportedSource(dv, identity)
+	if err != nil || source == nil {
+		return false, err
+	}
+	sourceBytes, err := json.Marshal(source)
+	if err != nil {
+		return false, err
+	}
+	cc.AddAnnotation(dv, cc.AnnImportDeduplicatedFrom, string(sourceBytes))
+
+	sourceKind, sourceName, sourceNamespace := cc.GetCloneSourceInfo(&cdiv1.DataVolume{Spec: cdiv1.DataVolumeSpec{Source: source}})
+	r.recorder.Eventf(dv, corev1.EventTypeNormal, ImportDeduplicated, MessageImportDeduplicated, sourceKind, sourceNamespace, sourceName)
+	return true, nil
+}
+
+// recordImportSourceIdentity labels the PVC of a succeeded DataImportCron import with its source identity,
+// so the DataImportCron snapshot of the PVC inherits it and is added to the deduplication index
+func (r *ImportReconciler) recordImportSourceIdentity(syncState *dvSyncState) error {
+	pvc := syncState.pvc
+	dv := syncState.dvMutated
+	identity := dv.Annotations[cc.AnnImportSourceIdentity]
+	if pvc == nil || identity == "" || dv.Status.Phase != cdiv1.Succeeded || dv.Labels[common.DataImportCronLabel] == "" ||
+		pvc.Labels[common.ImportSourceIdentityLabel] == identity {
+		return nil
+	}
+	if pvc.Labels == nil {
+		pvc.Labels = make(map[string]string)
+	}
+	pvc.Labels[common.ImportSourceIdentityLabel] = identity
+	return r.updatePVC(pvc)
+}
+
+// getImportSourceIdentity returns the hashed identity of the DataVolume import source, or an empty string if it has none.
+// Only sources imported without credentials have an identity, so deduplication never exposes private content.
+func getImportSourceIdentity(dv *cdiv1.DataVolume) string {
+	var identity string
+	if registry := dv.Spec.Source.Registry; registry != nil {
+		if registry.URL == nil || registry.SecretRef != nil || registry.CertConfigMap != nil {
+			return ""
+		}
+		// The digest identifies the image content regardless of the registry serving it
+		idx := strings.LastIndex(*registry.URL, "@sha256:")
+		if idx < 0 {
+			return ""
+		}
+		identity = "registry:" + (*registry.URL)[idx+1:]
+	} else if http := dv.Spec.Source.HTTP; http != nil {
+		// The controller never requests the source itself, only the checksum identifies the content at the URL
+		if http.Checksum == "" || http.SecretRef != "" || http.CertConfigMap != "" ||
+			len(http.ExtraHeaders) > 0 || len(http.SecretExtraHeaders) > 0 {
+			return ""
+		}
+		identity = "http:" + http.URL + "#" + http.Checksum
+	} else {
+		return ""
+	}
+
+	contentType := dv.Spec.ContentType
+	if contentType == "" {
+		contentType = cdiv1.DataVolumeKubeVirt
+	}
+	hash := sha256.Sum256([]byte(string(contentType) + "/" + identity))
+	// Label values are limited to 63 characters
+	return hex.EncodeToString(hash[:16])
+}
+
+// getImportedSource looks up the deduplication index for a DataImportCron snapshot in the DataVolume namespace
+// with the given source identity, which the DataVolume can be cloned from.
+// Deduplication never crosses namespaces, so it can't grant access to volumes the DataVolume owner can't clone.
+func (r *ImportReconciler) getImportedSource(dv *cdiv1.DataVolume, identity string) (*cdiv1.DataVolumeSource, error) {
+	snapshots := &snapshotv1.VolumeSnapshotList{}
+	if err := r.client.List(context.TODO(), snapshots, client.InNamespace(dv.Namespace),
+		client.MatchingLabels{common.ImportSourceIdentityLabel: identity}); err != nil {
+		return nil, cc.IgnoreIsNoMatchError(err)
+	}
+	items := snapshots.Items
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].CreationTimestamp.Equal(&items[j].CreationTimestamp) {
+			return items[i].CreationTimestamp.Before(&items[j].CreationTimestamp)
+		}
+		return items[i].Name < items[j].Name
+	})
+	for i := range items {
+		snapshot := &items[i]
+		if snapshot.DeletionTimestamp != nil || !cc.IsSnapshotReady(snapshot) || cc.ValidateSnapshotClone(snapshot, &dv.Spec) != nil {
+			continue
+		}
+		trusted, err := r.isDataImportCronSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if !trusted {
+			continue
+		}
+		return &cdiv1.DataVolumeSource{
+			Snapshot: &cdiv1.DataVolumeSourceSnapshot{Namespace: snapshot.Namespace, Name: snapshot.Name},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// isDataImportCronSnapshot returns true if the snapshot was taken by a DataImportCron of its namespace from one of its
+// imports, the only volumes whose content is known to match their source identity, as they are never written to
+func (r *ImportReconciler) isDataImportCronSnapshot(snapshot *snapshotv1.VolumeSnapshot) (bool, error) {
+	cronName := snapshot.Labels[common.DataImportCronLabel]
+	sourcePvcName := snapshot.Spec.Source.PersistentVolumeClaimName
+	if cronName == "" || sourcePvcName == nil || *sourcePvcName != snapshot.Name {
+		return false, nil
+	}
+	cron := &cdiv1.DataImportCron{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: snapshot.Namespace, Name: cronName}, cron); err != nil {
+		return false, cc.IgnoreNotFound(err)
+	}
+	return true, nil
+}
+
+// getDeduplicatedImportSource returns the clone source of a deduplicated import DataVolume, or nil
+func getDeduplicatedImportSource(dv *cdiv1.DataVolume) *cdiv1.DataVolumeSource {
+	sourceJSON, ok := dv.Annotations[cc.AnnImportDeduplicatedFrom]
+	if !ok {
+		return nil
+	}
+	source := &cdiv1.DataVolumeSource{}
+	if err := json.Unmarshal([]byte(sourceJSON), source); err != nil {
+		return nil
+	}
+	// Deduplication never crosses namespaces, the annotation can't be used to skip the clone authorization
+	switch {
+	case source.Snapshot != nil && (source.Snapshot.Namespace == "" || source.Snapshot.Namespace == dv.Namespace):
+		return source
+	case source.PVC != nil && (source.PVC.Namespace == "" || source.PVC.Namespace == dv.Namespace):
+		return source
+	}
+	return nil
+}
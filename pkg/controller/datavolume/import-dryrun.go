@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datavolume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+const (
+	// ImportDryRun provides a const to indicate an import is only validated
+	ImportDryRun = "ImportDryRun"
+
+	// MessageImportDryRun provides a const to form import dry run message
+	MessageImportDryRun = "Dry run of the import into %s, no PVC is created"
+
+	// ImportSizeDetection provides a const to indicate the PVC size of an import is detected from its source
+	ImportSizeDetection = "ImportSizeDetection"
+	// ImportSizeDetectionFailed provides a const to indicate the PVC size of an import can't be detected from its source
+	ImportSizeDetectionFailed = "ImportSizeDetectionFailed"
+
+	// MessageImportSizeDetection provides a const to form import size detection message
+	MessageImportSizeDetection = "Detecting the virtual size of the image to import into %s"
+	// MessageImportSizeDetectionFailed provides a const to form import size detection failure message
+	MessageImportSizeDetectionFailed = "Unable to detect the virtual size of the image to import into %s, set the storage size of the DataVolume: %s"
+)
+
+// syncDryRun keeps a dry-run DataVolume pending instead of creating its PVC,
+// returns true if the DataVolume is a dry run
+func (r *ImportReconciler) syncDryRun(syncState *dvSyncState) (bool, error) {
+	dv := syncState.dvMutated
+	if syncState.pvc != nil || !cc.IsDryRun(dv) {
+		return false, nil
+	}
+	err := r.syncDataVolumeStatusPhaseWithEvent(syncState, cdiv1.Pending, nil,
+		Event{corev1.EventTypeNormal, ImportDryRun, fmt.Sprintf(MessageImportDryRun, dv.Name)})
+	return true, err
+}
+
+// syncSizeDetection sizes the PVC of an import DataVolume without a storage size from the virtual size of its
+// source, returns true while the PVC can't be created yet
+func (r *ImportReconciler) syncSizeDetection(syncState *dvSyncState) (bool, error) {
+	dv := syncState.dvMutated
+	if syncState.pvc != nil || !cc.NeedsSizeDetection(dv) {
+		return false, nil
+	}
+	result, err := cc.GetPreflightResult(dv)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case result == nil, result.Phase == cc.PreflightPhaseRunning:
+		return true, r.syncDataVolumeStatusPhaseWithEvent(syncState, cdiv1.Pending, nil,
+			Event{corev1.EventTypeNormal, ImportSizeDetection, fmt.Sprintf(MessageImportSizeDetection, dv.Name)})
+	case result.Phase == cc.PreflightPhaseFailed:
+		return true, r.syncDataVolumeStatusPhaseWithEvent(syncState, cdiv1.Pending, nil,
+			Event{corev1.EventTypeWarning, ImportSizeDetectionFailed, fmt.Sprintf(MessageImportSizeDetectionFailed, dv.Name, result.Message)})
+	case result.VirtualSize == 0:
+		message := "the virtual size of a compressed or archived image is only known once it is imported"
+		return true, r.syncDataVolumeStatusPhaseWithEvent(syncState, cdiv1.Pending, nil,
+			Event{corev1.EventTypeWarning, ImportSizeDetectionFailed, fmt.Sprintf(MessageImportSizeDetectionFailed, dv.Name, message)})
+	}
+
+	// Parse size into a 'Quantity' struct and, if needed, inflate it with filesystem overhead
+	targetCapacity, err := cc.InflateSizeWithOverhead(context.TODO(), r.client, result.VirtualSize, syncState.pvcSpec)
+	if err != nil {
+		return false, err
+	}
+	if syncState.pvcSpec.Resources.Requests == nil {
+		syncState.pvcSpec.Resources.Requests = corev1.ResourceList{}
+	}
+	syncState.pvcSpec.Resources.Requests[corev1.ResourceStorage] = targetCapacity
+	return false, nil
+}
+
+// RenderImportPVC renders the PVC an import DataVolume would create, without creating it
+func RenderImportPVC(c client.Client, recorder record.EventRecorder, log logr.Logger, dv *cdiv1.DataVolume) (*corev1.PersistentVolumeClaim, error) {
+	r := &ImportReconciler{
+		ReconcilerBase: ReconcilerBase{
+			client:   c,
+			recorder: recorder,
+			log:      log,
+		},
+	}
+	pvcSpec, err := renderPvcSpec(c, recorder, log, dv, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.newPersistentVolumeClaim(dv, pvcSpec, dv.Namespace, dv.Name, r.updateAnnotations)
+}
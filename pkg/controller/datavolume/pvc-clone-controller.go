@@ -181,7 +181,7 @@ func (r *PvcCloneReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 
 func (r *PvcCloneReconciler) prepare(syncState *dvSyncState) error {
 	dv := syncState.dvMutated
-	if err := r.populateSourceIfSourceRef(dv); err != nil {
+	if err := r.populateCloneSource(dv); err != nil {
 		return err
 	}
 	return nil
@@ -189,7 +189,7 @@ func (r *PvcCloneReconciler) prepare(syncState *dvSyncState) error {
 
 func (r *PvcCloneReconciler) cleanup(syncState *dvSyncState) error {
 	dv := syncState.dvMutated
-	if err := r.populateSourceIfSourceRef(dv); err != nil {
+	if err := r.populateCloneSource(dv); err != nil {
 		return err
 	}
 
@@ -232,7 +232,7 @@ func (r *PvcCloneReconciler) updateAnnotations(dataVolume *cdiv1.DataVolume, pvc
 		sourceNamespace = dataVolume.Namespace
 	}
 	pvc.Annotations[cc.AnnCloneRequest] = sourceNamespace + "/" + dataVolume.Spec.Source.PVC.Name
-	return nil
+	return cc.AddClonePathFilterAnnotations(pvc, dataVolume.Spec.Source.PVC.PathFilter)
 }
 
 func (r *PvcCloneReconciler) sync(log logr.Logger, req reconcile.Request) (dvSyncResult, error) {
@@ -417,6 +417,9 @@ func (r *PvcCloneReconciler) validateCloneAndSourcePVC(syncState *dvSyncState, l
 	}
 
 	err = cc.ValidateClone(sourcePvc, &datavolume.Spec)
+	if err == nil {
+		err = validateClonePathFilterVolumeMode(datavolume, syncState.pvcSpec)
+	}
 	if err != nil {
 		r.recorder.Event(datavolume, corev1.EventTypeWarning, CloneValidationFailed, MessageCloneValidationFailed)
 		return false, err
@@ -425,6 +428,18 @@ func (r *PvcCloneReconciler) validateCloneAndSourcePVC(syncState *dvSyncState, l
 	return true, nil
 }
 
+// validateClonePathFilterVolumeMode rejects a path filter on a block target, the webhook only sees the volume mode
+// of the DataVolume spec while the rendered spec also has the defaulted one
+func validateClonePathFilterVolumeMode(dv *cdiv1.DataVolume, pvcSpec *corev1.PersistentVolumeClaimSpec) error {
+	if dv.Spec.Source.PVC == nil || dv.Spec.Source.PVC.PathFilter == nil || pvcSpec == nil {
+		return nil
+	}
+	if pvcSpec.VolumeMode != nil && *pvcSpec.VolumeMode == corev1.PersistentVolumeBlock {
+		return errors.New("a clone path filter is only supported with a filesystem target")
+	}
+	return nil
+}
+
 // isSourceReadyToClone handles the reconciling process of a clone when the source PVC is not ready
 func (r *PvcCloneReconciler) isSourceReadyToClone(datavolume *cdiv1.DataVolume) (bool, error) {
 	// TODO preper const
@@ -49,6 +49,7 @@ import (
 	"kubevirt.io/containerized-data-importer/pkg/controller/populators"
 	featuregates "kubevirt.io/containerized-data-importer/pkg/feature-gates"
 	"kubevirt.io/containerized-data-importer/pkg/token"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
 var (
@@ -158,6 +159,52 @@ var _ = Describe("All DataVolume Tests", func() {
 				Entry("with different namespace", "source-ns"),
 			)
 
+			It("should add path filter annotations to PVC", func() {
+				dv := newCloneDataVolume("test-dv")
+				dv.Annotations[AnnExtendedCloneToken] = "foobar"
+				dv.Spec.ContentType = cdiv1.DataVolumeArchive
+				dv.Spec.Source.PVC.PathFilter = &cdiv1.DataVolumeSourcePathFilter{
+					Include: []string{"datasets"},
+					Exclude: []string{"*.tmp"},
+				}
+				srcPvc := CreatePvcInStorageClass("test", metav1.NamespaceDefault, &scName, map[string]string{AnnContentType: string(cdiv1.DataVolumeArchive)}, nil, corev1.ClaimBound)
+				reconciler = createCloneReconcilerWFFCDisabled(storageClass, csiDriver, dv, srcPvc)
+				_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+				Expect(err).ToNot(HaveOccurred())
+				pvc := &corev1.PersistentVolumeClaim{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.Annotations[AnnCloneIncludePaths]).To(Equal(`["datasets"]`))
+				Expect(pvc.Annotations[AnnCloneExcludePaths]).To(Equal(`["*.tmp"]`))
+			})
+
+			It("should create a filesystem target for a path filter when the storage profile defaults to block", func() {
+				dv := newCloneDataVolume("test-dv")
+				dv.Annotations[AnnExtendedCloneToken] = "foobar"
+				dv.Spec.ContentType = cdiv1.DataVolumeArchive
+				dv.Spec.Source.PVC.PathFilter = &cdiv1.DataVolumeSourcePathFilter{
+					Include: []string{"datasets"},
+				}
+				dv.Spec.PVC = nil
+				dv.Spec.Storage = &cdiv1.StorageSpec{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: resource.MustParse("1G"),
+						},
+					},
+				}
+				srcPvc := CreatePvcInStorageClass("test", metav1.NamespaceDefault, &scName, map[string]string{AnnContentType: string(cdiv1.DataVolumeArchive)}, nil, corev1.ClaimBound)
+				storageProfile := createStorageProfile(scName, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, BlockMode)
+				reconciler = createCloneReconcilerWFFCDisabled(storageClass, storageProfile, csiDriver, dv, srcPvc)
+				_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+				Expect(err).ToNot(HaveOccurred())
+				pvc := &corev1.PersistentVolumeClaim{}
+				err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*pvc.Spec.VolumeMode).To(Equal(corev1.PersistentVolumeFilesystem))
+				Expect(pvc.Annotations[AnnCloneIncludePaths]).To(Equal(`["datasets"]`))
+			})
+
 			It("should add cloneType annotation", func() {
 				dv := newCloneDataVolume("test-dv")
 				anno := map[string]string{
@@ -456,6 +503,25 @@ var _ = Describe("All DataVolume Tests", func() {
 			Expect(done).To(BeTrue())
 		})
 
+		It("Validate clone with a path filter fails on a block target", func() {
+			dv := newCloneDataVolume("test-dv")
+			dv.Spec.ContentType = cdiv1.DataVolumeArchive
+			dv.Spec.Source.PVC.PathFilter = &cdiv1.DataVolumeSourcePathFilter{
+				Include: []string{"datasets"},
+			}
+			pvc := CreatePvcInStorageClass("test", metav1.NamespaceDefault, &scName, map[string]string{AnnContentType: string(cdiv1.DataVolumeArchive)}, nil, corev1.ClaimBound)
+			reconciler = createCloneReconciler(dv, pvc, sc)
+
+			state := syncState(dv)
+			blockMode := corev1.PersistentVolumeBlock
+			state.pvcSpec = dv.Spec.PVC.DeepCopy()
+			state.pvcSpec.VolumeMode = &blockMode
+			done, err := reconciler.validateCloneAndSourcePVC(state, reconciler.log)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only supported with a filesystem target"))
+			Expect(done).To(BeFalse())
+		})
+
 		It("Validate clone already populated without source completes", func() {
 			dv := newCloneDataVolume("test-dv")
 			storageProfile := createStorageProfile(scName, nil, FilesystemMode)
@@ -749,6 +815,64 @@ var _ = Describe("All DataVolume Tests", func() {
 			Entry("hostAssited with empty size and 'Block' volume mode", cdiv1.CloneStrategyHostAssisted, BlockMode),
 		)
 	})
+
+	var _ = Describe("Clone from a remote DataSource cache", func() {
+		cacheName := cdiv1.DataSourceCacheNamePrefix + "-0123456789abcdef"
+		cacheNamespace := util.GetNamespace()
+		dataSource := &cdiv1.DataSource{
+			TypeMeta:   metav1.TypeMeta{APIVersion: cdiv1.SchemeGroupVersion.String(), Kind: "DataSource"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ds", Namespace: metav1.NamespaceDefault, UID: "ds-uid"},
+			Spec: cdiv1.DataSourceSpec{
+				Source: cdiv1.DataSourceSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://example.com/disk.img"}},
+			},
+			Status: cdiv1.DataSourceStatus{
+				Source: cdiv1.DataSourceSource{PVC: &cdiv1.DataVolumeSourcePVC{Namespace: cacheNamespace, Name: cacheName}},
+			},
+		}
+
+		newCacheDv := func(labeled bool) *cdiv1.DataVolume {
+			dv := NewImportDataVolume(cacheName)
+			dv.Namespace = cacheNamespace
+			dv.UID = "cache-dv-uid"
+			if labeled {
+				dv.Labels = map[string]string{common.DataSourceCacheLabel: "true"}
+			}
+			return dv
+		}
+
+		newCachePvc := func(owner *cdiv1.DataVolume) *corev1.PersistentVolumeClaim {
+			pvc := CreatePvc(cacheName, cacheNamespace, nil, nil)
+			if owner != nil {
+				pvc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, cdiv1.SchemeGroupVersion.WithKind("DataVolume"))}
+			}
+			return pvc
+		}
+
+		labeledCacheDv := newCacheDv(true)
+
+		DescribeTable("should clone only the cache imported in the CDI namespace", func(cacheNamespace string, objects []runtime.Object, expectErr bool) {
+			ds := dataSource.DeepCopy()
+			ds.Status.Source.PVC.Namespace = cacheNamespace
+			dv := newCloneDataVolume("test-dv")
+			dv.Spec.Source = nil
+			dv.Spec.SourceRef = &cdiv1.DataVolumeSourceRef{Kind: cdiv1.DataVolumeDataSource, Name: ds.Name}
+			reconciler := createCloneReconciler(append([]runtime.Object{ds, dv}, objects...)...)
+			err := reconciler.populateCloneSource(dv)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Spec.Source.PVC.Name).To(Equal(cacheName))
+		},
+			Entry("not imported yet", cacheNamespace, nil, false),
+			Entry("imported by the cache DataVolume", cacheNamespace, []runtime.Object{labeledCacheDv, newCachePvc(labeledCacheDv)}, false),
+			Entry("a PVC named like a cache in another namespace", metav1.NamespaceDefault, nil, true),
+			Entry("a PVC with the cache name", cacheNamespace, []runtime.Object{newCachePvc(nil)}, true),
+			Entry("a DataVolume with the cache name", cacheNamespace, []runtime.Object{newCacheDv(false)}, true),
+			Entry("a PVC with the cache name not imported by the cache DataVolume", cacheNamespace, []runtime.Object{newCacheDv(true), newCachePvc(nil)}, true),
+		)
+	})
 })
 
 func createCloneReconcilerWFFCDisabled(objects ...runtime.Object) *PvcCloneReconciler {
@@ -144,7 +144,7 @@ func (r *SnapshotCloneReconciler) Reconcile(ctx context.Context, req reconcile.R
 
 func (r *SnapshotCloneReconciler) prepare(syncState *dvSyncState) error {
 	dv := syncState.dvMutated
-	if err := r.populateSourceIfSourceRef(dv); err != nil {
+	if err := r.populateCloneSource(dv); err != nil {
 		return err
 	}
 
@@ -479,7 +479,7 @@ func getTempHostAssistedSourcePvcName(dv *cdiv1.DataVolume) string {
 
 func (r *SnapshotCloneReconciler) cleanup(syncState *dvSyncState) error {
 	dv := syncState.dvMutated
-	if err := r.populateSourceIfSourceRef(dv); err != nil {
+	if err := r.populateCloneSource(dv); err != nil {
 		return err
 	}
 
@@ -305,6 +305,41 @@ var _ = Describe("All DataVolume Tests", func() {
 					Entry("with different namespace", "source-ns"),
 				)
 
+				It("should clone the snapshot of a deduplicated import without persisting it", func() {
+					dv := NewImportDataVolume("test-dv")
+					dv.Spec.PVC.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1G")}
+					dv.UID = types.UID("uid")
+					dv.Annotations = map[string]string{
+						AnnImportDeduplicatedFrom: `{"snapshot":{"namespace":"default","name":"test-snap"}}`,
+					}
+					snapshot := createSnapshotInVolumeSnapshotClass("test-snap", dv.Namespace, &expectedSnapshotClass, nil, nil, true)
+					reconciler = createSnapshotCloneReconcilerWFFCDisabled(storageClass, csiDriver, dv, snapshot)
+					Expect(getDataVolumeOp(reconciler.log, dv, reconciler.client)).To(Equal(dataVolumeSnapshotClone))
+					_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}})
+					Expect(err).ToNot(HaveOccurred())
+					dv = &cdiv1.DataVolume{}
+					err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, dv)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dv.Spec.Source.HTTP).ToNot(BeNil())
+					Expect(dv.Spec.Source.Snapshot).To(BeNil())
+					pvc := &corev1.PersistentVolumeClaim{}
+					err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "test-dv", Namespace: metav1.NamespaceDefault}, pvc)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pvc.Spec.DataSourceRef.Kind).To(Equal(cdiv1.VolumeCloneSourceRef))
+					vcs := &cdiv1.VolumeCloneSource{}
+					err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: volumeCloneSourceName(dv), Namespace: dv.Namespace}, vcs)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vcs.Spec.Source.Name).To(Equal(snapshot.Name))
+				})
+
+				It("should not clone the source of a deduplicated import from another namespace", func() {
+					dv := NewImportDataVolume("test-dv")
+					dv.Annotations = map[string]string{
+						AnnImportDeduplicatedFrom: `{"snapshot":{"namespace":"source-ns","name":"test-snap"}}`,
+					}
+					Expect(getDeduplicatedImportSource(dv)).To(BeNil())
+				})
+
 				It("should handle size omitted", func() {
 					dv := newCloneFromSnapshotDataVolume("test-dv")
 					vm := corev1.PersistentVolumeFilesystem
@@ -299,9 +299,9 @@ func resolveVolumeSize(c client.Client, dvSpec cdiv1.DataVolumeSpec, pvcSpec *v1
 	requestedSize, found := dvSpec.Storage.Resources.Requests[v1.ResourceStorage]
 
 	if !found {
-		// Storage size can be empty when cloning
+		// Storage size can be empty when cloning, or when importing a source whose virtual size can be detected
 		isClone := dvSpec.Source.PVC != nil || dvSpec.Source.Snapshot != nil
-		if isClone {
+		if isClone || cc.IsSizeDetectable(&dvSpec) {
 			return &requestedSize, nil
 		}
 		return nil, errors.Errorf("Datavolume Spec is not valid - missing storage size")
@@ -48,13 +48,24 @@ var _ = Describe("resolveVolumeSize", func() {
 		Expect(requestedVolumeSize.IsZero()).To(BeTrue())
 	})
 
-	It("Should return error after trying to create a DataVolume with empty storage size and http source", func() {
+	It("Should return empty volume size with http source, whose size is detected", func() {
 		httpSource := &cdiv1.DataVolumeSource{
 			HTTP: &cdiv1.DataVolumeSourceHTTP{},
 		}
 		storageSpec := &cdiv1.StorageSpec{}
 		dv := createDataVolumeWithStorageAPI("testDV", "testNamespace", httpSource, storageSpec)
 		requestedVolumeSize, err := resolveVolumeSize(client, dv.Spec, pvcSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requestedVolumeSize.IsZero()).To(BeTrue())
+	})
+
+	It("Should return error after trying to create a DataVolume with empty storage size and registry source", func() {
+		registrySource := &cdiv1.DataVolumeSource{
+			Registry: &cdiv1.DataVolumeSourceRegistry{},
+		}
+		storageSpec := &cdiv1.StorageSpec{}
+		dv := createDataVolumeWithStorageAPI("testDV", "testNamespace", registrySource, storageSpec)
+		requestedVolumeSize, err := resolveVolumeSize(client, dv.Spec, pvcSpec)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("Datavolume Spec is not valid - missing storage size"))
 		Expect(requestedVolumeSize).To(BeNil())
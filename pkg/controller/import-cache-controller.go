@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/operator"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+const (
+	importCacheControllerName = "import-cache-controller"
+	importCacheVolumeName     = "cache"
+)
+
+var defaultImportCacheSizeLimit = resource.MustParse("10Gi")
+
+// ImportCacheReconciler deploys the node-local import cache, a DaemonSet serving the importer pods of its node
+// through a Service routing to node-local endpoints only, while the CDIConfig enables it
+type ImportCacheReconciler struct {
+	client          client.Client
+	uncachedClient  client.Client
+	scheme          *runtime.Scheme
+	log             logr.Logger
+	image           string
+	pullPolicy      string
+	cdiNamespace    string
+	installerLabels map[string]string
+}
+
+// NewImportCacheController creates a new instance of the import cache controller
+func NewImportCacheController(mgr manager.Manager, log logr.Logger, importerImage, pullPolicy string, installerLabels map[string]string) (controller.Controller, error) {
+	uncachedClient, err := client.New(mgr.GetConfig(), client.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reconciler := &ImportCacheReconciler{
+		client:          mgr.GetClient(),
+		uncachedClient:  uncachedClient,
+		scheme:          mgr.GetScheme(),
+		log:             log.WithName(importCacheControllerName),
+		image:           importerImage,
+		pullPolicy:      pullPolicy,
+		cdiNamespace:    util.GetNamespace(),
+		installerLabels: installerLabels,
+	}
+	importCacheController, err := controller.New(importCacheControllerName, mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := addImportCacheControllerWatches(importCacheController, reconciler.cdiNamespace); err != nil {
+		return nil, err
+	}
+	log.Info("Initialized import cache controller")
+	return importCacheController, nil
+}
+
+func addImportCacheControllerWatches(c controller.Controller, cdiNamespace string) error {
+	// There is a single import cache, reconciled on changes to the CDIConfig or to its objects
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: cdiNamespace, Name: common.ImportCacheName}}
+	mapToImportCache := func(obj client.Object) []reconcile.Request {
+		if _, ok := obj.(*cdiv1.CDIConfig); !ok && (obj.GetNamespace() != cdiNamespace || obj.GetName() != common.ImportCacheName) {
+			return nil
+		}
+		return []reconcile.Request{request}
+	}
+	for _, obj := range []client.Object{&cdiv1.CDIConfig{}, &appsv1.DaemonSet{}, &corev1.Service{}} {
+		if err := c.Watch(&source.Kind{Type: obj}, handler.EnqueueRequestsFromMapFunc(mapToImportCache)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reconcile deploys or removes the import cache according to the CDIConfig
+func (r *ImportCacheReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	cdiConfig := &cdiv1.CDIConfig{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: common.ConfigName}, cdiConfig); cc.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, err
+	}
+	if cdiConfig.Spec.ImportCache == nil {
+		return reconcile.Result{}, r.deleteImportCache(ctx)
+	}
+	if err := r.reconcileDaemonSet(ctx, cdiConfig.Spec.ImportCache); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, r.reconcileService(ctx)
+}
+
+func (r *ImportCacheReconciler) reconcileDaemonSet(ctx context.Context, spec *cdiv1.ImportCacheSpec) error {
+	daemonSet := &appsv1.DaemonSet{}
+	key := types.NamespacedName{Namespace: r.cdiNamespace, Name: common.ImportCacheName}
+	if err := r.client.Get(ctx, key, daemonSet); err != nil {
+		if cc.IgnoreNotFound(err) != nil {
+			return err
+		}
+		daemonSet = &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+		if err := r.initDaemonSet(ctx, daemonSet, spec); err != nil {
+			return err
+		}
+		if err := operator.SetOwnerRuntime(r.uncachedClient, daemonSet); err != nil {
+			return err
+		}
+		r.log.Info("Creating the import cache DaemonSet")
+		return r.client.Create(ctx, daemonSet)
+	}
+
+	daemonSetCopy := daemonSet.DeepCopy()
+	if err := r.initDaemonSet(ctx, daemonSetCopy, spec); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(daemonSet, daemonSetCopy) {
+		r.log.Info("Updating the import cache DaemonSet")
+		return r.client.Update(ctx, daemonSetCopy)
+	}
+	return nil
+}
+
+func (r *ImportCacheReconciler) initDaemonSet(ctx context.Context, daemonSet *appsv1.DaemonSet, spec *cdiv1.ImportCacheSpec) error {
+	sizeLimit := defaultImportCacheSizeLimit
+	if spec.SizeLimit != nil {
+		sizeLimit = *spec.SizeLimit
+	}
+	imagePullSecrets, err := cc.GetImagePullSecrets(r.client)
+	if err != nil {
+		return err
+	}
+	workloadNodePlacement, err := cc.GetWorkloadNodePlacement(ctx, r.client)
+	if err != nil {
+		return err
+	}
+
+	pullPolicy := corev1.PullPolicy(r.pullPolicy)
+	if pullPolicy == "" {
+		pullPolicy = corev1.PullIfNotPresent
+	}
+
+	util.SetRecommendedLabels(daemonSet, r.installerLabels, common.CDIControllerName)
+	selector := getImportCacheSelector()
+	daemonSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+	daemonSet.Spec.Template.Labels = selector
+
+	container := corev1.Container{
+		Name:  common.ImportCacheName,
+		Image: r.image,
+		Command: []string{
+			"/usr/bin/cdi-import-cache-server",
+			"-port", strconv.Itoa(common.ImportCachePort),
+			"-dir", common.ImportCacheDir,
+			"-size-limit", strconv.FormatInt(sizeLimit.Value(), 10),
+		},
+		Env: []corev1.EnvVar{
+			{
+				// Validates the import cache tokens the controller gives to importer pods
+				Name: "APISERVER_PUBLIC_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "cdi-api-signing-key",
+						},
+						Key: "id_rsa.pub",
+					},
+				},
+			},
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: common.ImportCachePort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		// The probe and container defaults are set explicitly, like the API server defaults them, so comparing
+		// with the stored DaemonSet doesn't trigger updates
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/healthz",
+					Port:   intstr.FromInt(common.ImportCachePort),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			TimeoutSeconds:   1,
+			PeriodSeconds:    10,
+			SuccessThreshold: 1,
+			FailureThreshold: 3,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      importCacheVolumeName,
+				MountPath: common.ImportCacheDir,
+			},
+		},
+		ImagePullPolicy:          pullPolicy,
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+
+	// The cache keeps its entries, and the ones being written, within the size limit each. The limit is parsed
+	// like the stored DaemonSet is, so comparing them doesn't trigger updates.
+	volumeSizeLimit := resource.MustParse(resource.NewQuantity(2*sizeLimit.Value(), sizeLimit.Format).String())
+	podSpec := &daemonSet.Spec.Template.Spec
+	podSpec.Containers = []corev1.Container{container}
+	podSpec.Volumes = []corev1.Volume{
+		{
+			Name: importCacheVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &volumeSizeLimit},
+			},
+		},
+	}
+	podSpec.AutomountServiceAccountToken = pointer.Bool(false)
+	podSpec.ImagePullSecrets = imagePullSecrets
+	podSpec.NodeSelector = workloadNodePlacement.NodeSelector
+	podSpec.Tolerations = workloadNodePlacement.Tolerations
+	podSpec.Affinity = workloadNodePlacement.Affinity
+	cc.SetRestrictedSecurityContext(podSpec)
+	return nil
+}
+
+func (r *ImportCacheReconciler) reconcileService(ctx context.Context) error {
+	service := &corev1.Service{}
+	key := types.NamespacedName{Namespace: r.cdiNamespace, Name: common.ImportCacheName}
+	if err := r.client.Get(ctx, key, service); cc.IgnoreNotFound(err) != nil || err == nil {
+		return err
+	}
+
+	local := corev1.ServiceInternalTrafficPolicyLocal
+	service = &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Spec: corev1.ServiceSpec{
+			Selector: getImportCacheSelector(),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       common.ImportCachePort,
+					TargetPort: intstr.FromInt(common.ImportCachePort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			// Importer pods only reach the cache of their node
+			InternalTrafficPolicy: &local,
+		},
+	}
+	util.SetRecommendedLabels(service, r.installerLabels, common.CDIControllerName)
+	if err := operator.SetOwnerRuntime(r.uncachedClient, service); err != nil {
+		return err
+	}
+	r.log.Info("Creating the import cache Service")
+	return r.client.Create(ctx, service)
+}
+
+func (r *ImportCacheReconciler) deleteImportCache(ctx context.Context) error {
+	objectMeta := metav1.ObjectMeta{Namespace: r.cdiNamespace, Name: common.ImportCacheName}
+	for _, obj := range []client.Object{&appsv1.DaemonSet{ObjectMeta: objectMeta}, &corev1.Service{ObjectMeta: objectMeta}} {
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if cc.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		r.log.Info("Deleting the import cache", "kind", reflect.TypeOf(obj).Elem().Name())
+		if err := r.client.Delete(ctx, obj); cc.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getImportCacheSelector() map[string]string {
+	return map[string]string{common.CDIComponentLabel: common.ImportCacheName}
+}
+
+// GetImportCacheURL returns the URL of the node-local import cache
+func GetImportCacheURL(cdiNamespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", common.ImportCacheName, cdiNamespace, common.ImportCachePort)
+}
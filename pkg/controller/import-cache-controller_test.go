@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+const testImportCacheNamespace = "cdi"
+
+var importCacheLog = logf.Log.WithName("import-cache-controller-test")
+
+var _ = Describe("Import cache reconcile", func() {
+	var (
+		reconciler *ImportCacheReconciler
+		key        = types.NamespacedName{Namespace: testImportCacheNamespace, Name: common.ImportCacheName}
+	)
+
+	reconcileImportCache := func() {
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getDaemonSet := func() *appsv1.DaemonSet {
+		daemonSet := &appsv1.DaemonSet{}
+		Expect(reconciler.client.Get(context.TODO(), key, daemonSet)).To(Succeed())
+		return daemonSet
+	}
+
+	setImportCache := func(importCache *cdiv1.ImportCacheSpec) {
+		cdiConfig := &cdiv1.CDIConfig{}
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)).To(Succeed())
+		cdiConfig.Spec.ImportCache = importCache
+		Expect(reconciler.client.Update(context.TODO(), cdiConfig)).To(Succeed())
+	}
+
+	It("Should not deploy the import cache unless enabled", func() {
+		reconciler = createImportCacheReconciler(nil)
+		reconcileImportCache()
+		err := reconciler.client.Get(context.TODO(), key, &appsv1.DaemonSet{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		err = reconciler.client.Get(context.TODO(), key, &corev1.Service{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should deploy the import cache with a node-local Service", func() {
+		reconciler = createImportCacheReconciler(&cdiv1.ImportCacheSpec{})
+		reconcileImportCache()
+
+		daemonSet := getDaemonSet()
+		Expect(daemonSet.Spec.Selector.MatchLabels).To(HaveKeyWithValue(common.CDIComponentLabel, common.ImportCacheName))
+		Expect(daemonSet.Spec.Template.Labels).To(Equal(daemonSet.Spec.Selector.MatchLabels))
+		podSpec := daemonSet.Spec.Template.Spec
+		Expect(podSpec.Containers).To(HaveLen(1))
+		Expect(podSpec.Containers[0].Image).To(Equal(testImage))
+		Expect(podSpec.Containers[0].Command).To(ContainElements("-size-limit", "10737418240"))
+		Expect(podSpec.Volumes[0].EmptyDir.SizeLimit).To(HaveValue(Equal(resource.MustParse("20Gi"))))
+		Expect(podSpec.Containers[0].SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
+		Expect(podSpec.Containers[0].Env).To(HaveLen(1))
+		Expect(podSpec.Containers[0].Env[0].ValueFrom.SecretKeyRef.Name).To(Equal("cdi-api-signing-key"))
+		probe := podSpec.Containers[0].ReadinessProbe
+		Expect(probe.HTTPGet.Scheme).To(Equal(corev1.URISchemeHTTP))
+		Expect(probe.TimeoutSeconds).To(Equal(int32(1)))
+		Expect(probe.PeriodSeconds).To(Equal(int32(10)))
+		Expect(probe.SuccessThreshold).To(Equal(int32(1)))
+		Expect(probe.FailureThreshold).To(Equal(int32(3)))
+		Expect(podSpec.Containers[0].ImagePullPolicy).ToNot(BeEmpty())
+
+		By("Not updating the DaemonSet when nothing changed")
+		reconcileImportCache()
+		Expect(getDaemonSet().ResourceVersion).To(Equal(daemonSet.ResourceVersion))
+
+		service := &corev1.Service{}
+		Expect(reconciler.client.Get(context.TODO(), key, service)).To(Succeed())
+		Expect(service.Spec.Selector).To(Equal(daemonSet.Spec.Selector.MatchLabels))
+		Expect(service.Spec.InternalTrafficPolicy).To(HaveValue(Equal(corev1.ServiceInternalTrafficPolicyLocal)))
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(common.ImportCachePort)))
+	})
+
+	It("Should update the import cache size limit", func() {
+		reconciler = createImportCacheReconciler(&cdiv1.ImportCacheSpec{})
+		reconcileImportCache()
+
+		sizeLimit := resource.MustParse("1Gi")
+		setImportCache(&cdiv1.ImportCacheSpec{SizeLimit: &sizeLimit})
+		reconcileImportCache()
+		daemonSet := getDaemonSet()
+		Expect(daemonSet.Spec.Template.Spec.Containers[0].Command).To(ContainElements("-size-limit", "1073741824"))
+		Expect(daemonSet.Spec.Template.Spec.Volumes[0].EmptyDir.SizeLimit).To(HaveValue(Equal(resource.MustParse("2Gi"))))
+	})
+
+	It("Should remove the import cache once disabled", func() {
+		reconciler = createImportCacheReconciler(&cdiv1.ImportCacheSpec{})
+		reconcileImportCache()
+		getDaemonSet()
+
+		setImportCache(nil)
+		reconcileImportCache()
+		err := reconciler.client.Get(context.TODO(), key, &appsv1.DaemonSet{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		err = reconciler.client.Get(context.TODO(), key, &corev1.Service{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+})
+
+func createImportCacheReconciler(importCache *cdiv1.ImportCacheSpec) *ImportCacheReconciler {
+	cdiConfig := cc.MakeEmptyCDIConfigSpec(common.ConfigName)
+	cdiConfig.Spec.ImportCache = importCache
+	objs := []runtime.Object{cc.MakeEmptyCDICR(), cdiConfig}
+
+	s := scheme.Scheme
+	_ = cdiv1.AddToScheme(s)
+
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	return &ImportCacheReconciler{
+		client:         cl,
+		uncachedClient: cl,
+		scheme:         s,
+		log:            importCacheLog,
+		image:          testImage,
+		pullPolicy:     string(corev1.PullIfNotPresent),
+		cdiNamespace:   testImportCacheNamespace,
+		installerLabels: map[string]string{
+			common.AppKubernetesPartOfLabel:  "testing",
+			common.AppKubernetesVersionLabel: "v0.0.0-tests",
+		},
+	}
+}
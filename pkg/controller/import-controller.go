@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"net/url"
 	"path"
@@ -32,6 +33,8 @@ import (
 	"kubevirt.io/containerized-data-importer/pkg/common"
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 	featuregates "kubevirt.io/containerized-data-importer/pkg/feature-gates"
+	"kubevirt.io/containerized-data-importer/pkg/importcache"
+	"kubevirt.io/containerized-data-importer/pkg/token"
 	"kubevirt.io/containerized-data-importer/pkg/util"
 	"kubevirt.io/containerized-data-importer/pkg/util/naming"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
@@ -55,6 +58,9 @@ const (
 
 	// secretExtraHeadersVolumeName is the format string that specifies where extra HTTP header secrets will be mounted
 	secretExtraHeadersVolumeName = "cdi-secret-extra-headers-vol-%d"
+
+	// importCacheTokenLifetime covers the restarts of an importer pod, a pod with an expired token misses the cache
+	importCacheTokenLifetime = 24 * time.Hour
 )
 
 // ImportReconciler members
@@ -71,6 +77,8 @@ type ImportReconciler struct {
 	cdiNamespace       string
 	featureGates       featuregates.FeatureGates
 	installerLabels    map[string]string
+	workerQueue        *workerQueue
+	tokenGenerator     token.Generator
 }
 
 type importPodEnvVar struct {
@@ -93,12 +101,19 @@ type importPodEnvVar struct {
 	previousCheckpoint string
 	finalCheckpoint    string
 	preallocation      bool
+	sparsify           bool
+	sourceChecksum     string
+	sourceVersion      string
 	httpProxy          string
 	httpsProxy         string
 	noProxy            string
 	certConfigMapProxy string
 	extraHeaders       []string
 	secretExtraHeaders []string
+	importCacheURL     string
+	importCacheToken   string
+	// scratchSizeEstimated tells the importer the scratch space was sized from its estimate
+	scratchSizeEstimated bool
 }
 
 type importerPodArgs struct {
@@ -117,7 +132,7 @@ type importerPodArgs struct {
 }
 
 // NewImportController creates a new instance of the import controller.
-func NewImportController(mgr manager.Manager, log logr.Logger, importerImage, pullPolicy, verbose string, installerLabels map[string]string) (controller.Controller, error) {
+func NewImportController(mgr manager.Manager, log logr.Logger, importerImage, pullPolicy, verbose string, tokenPrivateKey *rsa.PrivateKey, installerLabels map[string]string) (controller.Controller, error) {
 	uncachedClient, err := client.New(mgr.GetConfig(), client.Options{
 		Scheme: mgr.GetScheme(),
 		Mapper: mgr.GetRESTMapper(),
@@ -138,6 +153,8 @@ func NewImportController(mgr manager.Manager, log logr.Logger, importerImage, pu
 		cdiNamespace:    util.GetNamespace(),
 		featureGates:    featuregates.NewFeatureGates(client),
 		installerLabels: installerLabels,
+		workerQueue:     defaultWorkerQueue,
+		tokenGenerator:  newImportCacheTokenGenerator(tokenPrivateKey),
 	}
 	importController, err := controller.New("import-controller", mgr, controller.Options{
 		MaxConcurrentReconciles: 3,
@@ -245,6 +262,9 @@ func (r *ImportReconciler) reconcilePvc(pvc *corev1.PersistentVolumeClaim, log l
 		if cc.IsPVCComplete(pvc) {
 			// Don't create the POD if the PVC is completed already
 			log.V(1).Info("PVC is already complete")
+		} else if isImportFailed(pvc) {
+			// Don't retry an import which failed for good
+			log.V(1).Info("PVC import failed")
 		} else if pvc.DeletionTimestamp == nil {
 			podsUsingPVC, err := cc.GetPodsUsingPVCs(context.TODO(), r.client, pvc.Namespace, sets.New(pvc.Name), false)
 			if err != nil {
@@ -263,6 +283,23 @@ func (r *ImportReconciler) reconcilePvc(pvc *corev1.PersistentVolumeClaim, log l
 			}
 
 			if _, ok := pvc.Annotations[cc.AnnImportPod]; ok {
+				terminating, err := r.isScratchPvcTerminating(pvc)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				if terminating {
+					// The scratch PVC is recreated under the same name, so wait until the old one is gone
+					log.V(1).Info("Waiting for the previous scratch PVC to be deleted before creating the importer pod")
+					return reconcile.Result{RequeueAfter: 2 * time.Second}, nil
+				}
+				admitted, err := r.workerQueue.queueWorkerPod(context.TODO(), r.client, r.recorder, pvc)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				if !admitted {
+					log.V(1).Info("Importer pod is queued due to the worker pod limits")
+					return reconcile.Result{RequeueAfter: workerQueueRequeueInterval}, nil
+				}
 				// Create importer pod, make sure the PVC owns it.
 				if err := r.createImporterPod(pvc); err != nil {
 					return reconcile.Result{}, err
@@ -292,7 +329,7 @@ func (r *ImportReconciler) reconcilePvc(pvc *corev1.PersistentVolumeClaim, log l
 		}
 	}
 
-	if !cc.IsPVCComplete(pvc) {
+	if !cc.IsPVCComplete(pvc) && !isImportFailed(pvc) {
 		// We are not done yet, force a re-reconcile in 2 seconds to get an update.
 		log.V(1).Info("Force Reconcile pvc import not finished", "pvc.Name", pvc.Name)
 
@@ -302,6 +339,11 @@ func (r *ImportReconciler) reconcilePvc(pvc *corev1.PersistentVolumeClaim, log l
 }
 
 func (r *ImportReconciler) copyImportProxyConfigMap(pvc *corev1.PersistentVolumeClaim, pod *corev1.Pod) error {
+	return r.copyProxyConfigMap(GetImportProxyConfigMapName(pvc.Name), pod)
+}
+
+// copyProxyConfigMap copies the import proxy ConfigMap to the namespace of the pod, owned by the pod
+func (r *ImportReconciler) copyProxyConfigMap(name string, pod *corev1.Pod) error {
 	cdiConfig := &cdiv1.CDIConfig{}
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig); err != nil {
 		return err
@@ -316,8 +358,8 @@ func (r *ImportReconciler) copyImportProxyConfigMap(pvc *corev1.PersistentVolume
 	}
 	importConfigMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      GetImportProxyConfigMapName(pvc.Name),
-			Namespace: pvc.Namespace,
+			Name:      name,
+			Namespace: pod.Namespace,
 			OwnerReferences: []metav1.OwnerReference{{
 				APIVersion:         pod.APIVersion,
 				Kind:               pod.Kind,
@@ -371,16 +413,28 @@ func (r *ImportReconciler) updatePvcFromPod(pvc *corev1.PersistentVolumeClaim, p
 	setAnnotationsFromPodWithPrefix(anno, pod, cc.AnnRunningCondition)
 
 	scratchExitCode := false
-	if pod.Status.ContainerStatuses != nil &&
-		pod.Status.ContainerStatuses[0].LastTerminationState.Terminated != nil &&
-		pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.ExitCode > 0 {
-		log.Info("Pod termination code", "pod.Name", pod.Name, "ExitCode", pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.ExitCode)
-		if pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.ExitCode == common.ScratchSpaceNeededExitCode {
+	retryImport := false
+	if terminated := getImporterTerminatedState(pod); terminated != nil && terminated.ExitCode > 0 {
+		log.Info("Pod termination code", "pod.Name", pod.Name, "ExitCode", terminated.ExitCode)
+		if terminated.ExitCode == common.ScratchSpaceNeededExitCode {
 			log.V(1).Info("Pod requires scratch space, terminating pod, and restarting with scratch space", "pod.Name", pod.Name)
 			scratchExitCode = true
 			anno[cc.AnnRequiresScratch] = "true"
+			if err := r.updateScratchSizeEstimate(pvc, pod, terminated.Message, log); err != nil {
+				return err
+			}
 		} else {
-			r.recorder.Event(pvc, corev1.EventTypeWarning, ErrImportFailedPVC, pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.Message)
+			reason, message := ErrImportFailedPVC, terminated.Message
+			if te, ok := util.ParseTerminationError(terminated.Message); ok {
+				reason, message = te.Code, te.Message
+			}
+			r.recorder.Event(pvc, corev1.EventTypeWarning, reason, message)
+			if pod.Status.Phase == corev1.PodFailed && pod.Spec.RestartPolicy == corev1.RestartPolicyNever {
+				var err error
+				if retryImport, err = r.applyRetryPolicy(pvc, pod, log); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -423,12 +477,19 @@ func (r *ImportReconciler) updatePvcFromPod(pvc *corev1.PersistentVolumeClaim, p
 		log.V(1).Info("Updated PVC", "pvc.anno.Phase", anno[cc.AnnPodPhase], "pvc.anno.Restarts", anno[cc.AnnPodRestarts])
 	}
 
+	if retryImport {
+		// The pod is recreated by the next reconcile, unless the import gets queued
+		log.V(1).Info("Deleting failed pod to retry the import", "pod.Name", pod.Name)
+		return r.cleanup(pvc, pod, log)
+	}
+
 	if cc.IsPVCComplete(pvc) || scratchExitCode {
 		if !scratchExitCode {
 			r.recorder.Event(pvc, corev1.EventTypeNormal, ImportSucceededPVC, "Import Successful")
 			log.V(1).Info("Import completed successfully")
 		}
-		if cc.ShouldDeletePod(pvc) {
+		// A pod which is not restarted by Kubernetes has to be recreated with scratch space
+		if cc.ShouldDeletePod(pvc) || (scratchExitCode && pod.Spec.RestartPolicy == corev1.RestartPolicyNever) {
 			log.V(1).Info("Deleting pod", "pod.Name", pod.Name)
 			if err := r.cleanup(pvc, pod, log); err != nil {
 				return err
@@ -438,6 +499,39 @@ func (r *ImportReconciler) updatePvcFromPod(pvc *corev1.PersistentVolumeClaim, p
 	return nil
 }
 
+// updateScratchSizeEstimate records the scratch size estimated by the importer to size the scratch PVC, or drops it
+// along with the scratch PVC when the scratch space was too small, so the import is retried with a full size one.
+func (r *ImportReconciler) updateScratchSizeEstimate(pvc *corev1.PersistentVolumeClaim, pod *corev1.Pod, message string, log logr.Logger) error {
+	anno := pvc.GetAnnotations()
+	if strings.Contains(message, common.ScratchSpaceTooSmall) {
+		log.V(1).Info("Estimated scratch space too small, retrying with full size scratch space", "pod.Name", pod.Name)
+		delete(anno, cc.AnnScratchSizeEstimate)
+		scratchPvcName, exists := getScratchNameFromPod(pod)
+		if !exists {
+			return nil
+		}
+		scratchPvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: pvc.Namespace, Name: scratchPvcName}}
+		return cc.IgnoreNotFound(r.client.Delete(context.TODO(), scratchPvc))
+	}
+	if match := scratchSizeEstimateMatch.FindStringSubmatch(message); match != nil {
+		anno[cc.AnnScratchSizeEstimate] = match[1]
+	}
+	return nil
+}
+
+// isScratchPvcTerminating returns true while the scratch PVC of a previous importer pod is still being deleted.
+func (r *ImportReconciler) isScratchPvcTerminating(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	scratchPvc := &corev1.PersistentVolumeClaim{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: pvc.Namespace, Name: createScratchNameFromPvc(pvc)}, scratchPvc)
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return scratchPvc.DeletionTimestamp != nil, nil
+}
+
 func (r *ImportReconciler) cleanup(pvc *corev1.PersistentVolumeClaim, pod *corev1.Pod, log logr.Logger) error {
 	if err := r.client.Delete(context.TODO(), pod); cc.IgnoreNotFound(err) != nil {
 		return err
@@ -497,6 +591,7 @@ func (r *ImportReconciler) createImporterPod(pvc *corev1.PersistentVolumeClaim)
 	if err != nil {
 		return err
 	}
+	podEnvVar.scratchSizeEstimated = requiresScratch && pvc.Annotations[cc.AnnScratchSizeEstimate] != ""
 	// all checks passed, let's create the importer pod!
 	podArgs := &importerPodArgs{
 		image:             r.image,
@@ -574,6 +669,8 @@ func (r *ImportReconciler) createImportEnvVar(pvc *corev1.PersistentVolumeClaim)
 		podEnvVar.previousCheckpoint = getValueFromAnnotation(pvc, cc.AnnPreviousCheckpoint)
 		podEnvVar.currentCheckpoint = getValueFromAnnotation(pvc, cc.AnnCurrentCheckpoint)
 		podEnvVar.finalCheckpoint = getValueFromAnnotation(pvc, cc.AnnFinalCheckpoint)
+		podEnvVar.sourceChecksum = getValueFromAnnotation(pvc, cc.AnnSourceChecksum)
+		podEnvVar.sourceVersion = getValueFromAnnotation(pvc, cc.AnnSourceVersion)
 
 		for annotation, value := range pvc.Annotations {
 			if strings.HasPrefix(annotation, cc.AnnExtraHeaders) {
@@ -601,6 +698,12 @@ func (r *ImportReconciler) createImportEnvVar(pvc *corev1.PersistentVolumeClaim)
 			r.log.V(3).Info("no proxy CA certiticate will be supplied:", "error", err.Error())
 		}
 		podEnvVar.certConfigMapProxy = field
+		if isImportCacheable(podEnvVar, cdiConfig) {
+			podEnvVar.importCacheURL = GetImportCacheURL(r.cdiNamespace)
+			if podEnvVar.importCacheToken, err = r.createImportCacheToken(pvc, podEnvVar); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	fsOverhead, err := GetFilesystemOverhead(context.TODO(), r.client, pvc)
@@ -612,6 +715,7 @@ func (r *ImportReconciler) createImportEnvVar(pvc *corev1.PersistentVolumeClaim)
 	if preallocation, err := strconv.ParseBool(getValueFromAnnotation(pvc, cc.AnnPreallocationRequested)); err == nil {
 		podEnvVar.preallocation = preallocation
 	} // else use the default "false"
+	podEnvVar.sparsify = pvc.Annotations[cc.AnnSparsify] == "true"
 
 	//get the requested image size.
 	podEnvVar.imageSize, err = cc.GetRequestedImageSize(pvc)
@@ -849,6 +953,16 @@ func createImporterPod(ctx context.Context, log logr.Logger, client client.Clien
 	}
 
 	util.SetRecommendedLabels(pod, installerLabels, "cdi-controller")
+	setWorkerPodAnnotations(pod, args.pvc, getPVCWorkerKey(args.pvc))
+
+	retryPolicy, err := getRetryPolicy(ctx, client, args.pvc)
+	if err != nil {
+		return nil, err
+	}
+	if retryPolicy != nil {
+		// The import controller restarts the failed pod according to the retry policy
+		pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
 
 	if err = client.Create(context.TODO(), pod); err != nil {
 		return nil, err
@@ -1091,34 +1205,46 @@ func makeImporterPodSpec(args *importerPodArgs) *corev1.Pod {
 		})
 	}
 
-	if args.podEnvVar.certConfigMap != "" {
+	addImporterSourceVolumes(pod, args.podEnvVar, GetImportProxyConfigMapName(args.pvc.Name))
+
+	cc.SetRestrictedSecurityContext(&pod.Spec)
+	// We explicitly define a NodeName for dynamically provisioned PVCs
+	// when the PVC is being handled by a populator (PVC')
+	cc.SetNodeNameIfPopulator(args.pvc, &pod.Spec)
+
+	return pod
+}
+
+// addImporterSourceVolumes mounts the certificates and secrets the importer needs to access its source
+func addImporterSourceVolumes(pod *corev1.Pod, podEnvVar *importPodEnvVar, proxyConfigMapName string) {
+	if podEnvVar.certConfigMap != "" {
 		vm := corev1.VolumeMount{
 			Name:      CertVolName,
 			MountPath: common.ImporterCertDir,
 		}
 		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
-		pod.Spec.Volumes = append(pod.Spec.Volumes, createConfigMapVolume(CertVolName, args.podEnvVar.certConfigMap))
+		pod.Spec.Volumes = append(pod.Spec.Volumes, createConfigMapVolume(CertVolName, podEnvVar.certConfigMap))
 	}
 
-	if args.podEnvVar.certConfigMapProxy != "" {
+	if podEnvVar.certConfigMapProxy != "" {
 		vm := corev1.VolumeMount{
 			Name:      ProxyCertVolName,
 			MountPath: common.ImporterProxyCertDir,
 		}
 		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
-		pod.Spec.Volumes = append(pod.Spec.Volumes, createConfigMapVolume(ProxyCertVolName, GetImportProxyConfigMapName(args.pvc.Name)))
+		pod.Spec.Volumes = append(pod.Spec.Volumes, createConfigMapVolume(ProxyCertVolName, proxyConfigMapName))
 	}
 
-	if args.podEnvVar.source == cc.SourceGCS && args.podEnvVar.secretName != "" {
+	if podEnvVar.source == cc.SourceGCS && podEnvVar.secretName != "" {
 		vm := corev1.VolumeMount{
 			Name:      SecretVolName,
 			MountPath: common.ImporterGoogleCredentialDir,
 		}
 		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
-		pod.Spec.Volumes = append(pod.Spec.Volumes, createSecretVolume(SecretVolName, args.podEnvVar.secretName))
+		pod.Spec.Volumes = append(pod.Spec.Volumes, createSecretVolume(SecretVolName, podEnvVar.secretName))
 	}
 
-	for index, header := range args.podEnvVar.secretExtraHeaders {
+	for index, header := range podEnvVar.secretExtraHeaders {
 		vm := corev1.VolumeMount{
 			Name:      fmt.Sprintf(secretExtraHeadersVolumeName, index),
 			MountPath: path.Join(common.ImporterSecretExtraHeadersDir, fmt.Sprint(index)),
@@ -1136,13 +1262,6 @@ func makeImporterPodSpec(args *importerPodArgs) *corev1.Pod {
 		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
 		pod.Spec.Volumes = append(pod.Spec.Volumes, vol)
 	}
-
-	cc.SetRestrictedSecurityContext(&pod.Spec)
-	// We explicitly define a NodeName for dynamically provisioned PVCs
-	// when the PVC is being handled by a populator (PVC')
-	cc.SetNodeNameIfPopulator(args.pvc, &pod.Spec)
-
-	return pod
 }
 
 func setImporterPodCommons(pod *corev1.Pod, podEnvVar *importPodEnvVar, pvc *corev1.PersistentVolumeClaim, podResourceRequirements *corev1.ResourceRequirements, imagePullSecrets []corev1.LocalObjectReference) {
@@ -1345,5 +1464,79 @@ func makeImportEnv(podEnvVar *importPodEnvVar, uid types.UID) []corev1.EnvVar {
 			Value: header,
 		})
 	}
+	if podEnvVar.importCacheURL != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImportCacheURL,
+			Value: podEnvVar.importCacheURL,
+		}, corev1.EnvVar{
+			Name:  common.ImportCacheToken,
+			Value: podEnvVar.importCacheToken,
+		})
+	}
+	if podEnvVar.scratchSizeEstimated {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterScratchSizeEstimated,
+			Value: "true",
+		})
+	}
+	if podEnvVar.sparsify {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterSparsify,
+			Value: "true",
+		})
+	}
+	if podEnvVar.sourceChecksum != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterSourceChecksum,
+			Value: podEnvVar.sourceChecksum,
+		})
+	}
+	if podEnvVar.sourceVersion != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterSourceVersion,
+			Value: podEnvVar.sourceVersion,
+		})
+	}
 	return env
 }
+
+// createImportCacheToken returns the token authorizing the importer pod to use the import cache. The token of an HTTP
+// import only holds its source URL, so the cache doesn't download other URLs on behalf of the pod.
+func (r *ImportReconciler) createImportCacheToken(pvc *corev1.PersistentVolumeClaim, podEnvVar *importPodEnvVar) (string, error) {
+	payload := &token.Payload{
+		Operation: token.OperationImportCache,
+		Name:      pvc.Name,
+		Namespace: pvc.Namespace,
+		Resource: metav1.GroupVersionResource{
+			Version:  "v1",
+			Resource: "persistentvolumeclaims",
+		},
+	}
+	if podEnvVar.source == cc.SourceHTTP {
+		// The importer reads the URL as parsed
+		sourceURL, err := url.Parse(podEnvVar.ep)
+		if err != nil {
+			return "", err
+		}
+		payload.Params = map[string]string{importcache.TokenURLParam: sourceURL.String()}
+	}
+	return r.tokenGenerator.Generate(payload)
+}
+
+func newImportCacheTokenGenerator(key *rsa.PrivateKey) token.Generator {
+	return token.NewGenerator(common.ImportCacheTokenIssuer, key, importCacheTokenLifetime)
+}
+
+// isImportCacheable tells whether the import may go through the node-local import cache. Cached content is shared
+// by all imports of the node, so only sources which don't need credentials, headers or certificates are cached.
+func isImportCacheable(podEnvVar *importPodEnvVar, cdiConfig *cdiv1.CDIConfig) bool {
+	if cdiConfig.Spec.ImportCache == nil {
+		return false
+	}
+	if podEnvVar.source != cc.SourceHTTP && podEnvVar.source != cc.SourceRegistry {
+		return false
+	}
+	return podEnvVar.secretName == "" && podEnvVar.certConfigMap == "" && !podEnvVar.insecureTLS &&
+		len(podEnvVar.extraHeaders) == 0 && len(podEnvVar.secretExtraHeaders) == 0 &&
+		podEnvVar.httpProxy == "" && podEnvVar.httpsProxy == ""
+}
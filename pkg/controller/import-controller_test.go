@@ -17,13 +17,18 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
 	featuregates "kubevirt.io/containerized-data-importer/pkg/feature-gates"
+	"kubevirt.io/containerized-data-importer/pkg/importcache"
+	"kubevirt.io/containerized-data-importer/pkg/token"
+	"kubevirt.io/containerized-data-importer/pkg/util"
 	"kubevirt.io/containerized-data-importer/pkg/util/naming"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
@@ -42,12 +47,15 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 )
 
 const (
@@ -356,6 +364,233 @@ var _ = Describe("ImportConfig Controller reconcile loop", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("Pod is not owned by PVC"))
 	})
+
+	Context("with worker pod limits", func() {
+		const sourceHost = "test.somewhere.tt.blah"
+		created := time.Now().Add(-time.Hour)
+
+		newImportPvc := func(name string, minutes int) *v1.PersistentVolumeClaim {
+			pvc := cc.CreatePvc(name, "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnImportPod: "importer-" + name}, nil)
+			pvc.UID = types.UID(name + "-uid")
+			pvc.Status.Phase = v1.ClaimBound
+			pvc.CreationTimestamp = metav1.NewTime(created.Add(time.Duration(minutes) * time.Minute))
+			return pvc
+		}
+
+		setWorkerPodLimits := func(limits *cdiv1.WorkerPodLimits) {
+			cdiConfig := &cdiv1.CDIConfig{}
+			err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+			Expect(err).ToNot(HaveOccurred())
+			cdiConfig.Spec.WorkerPodLimits = limits
+			err = reconciler.client.Update(context.TODO(), cdiConfig)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		reconcilePvc := func(name string) (*v1.PersistentVolumeClaim, reconcile.Result) {
+			key := types.NamespacedName{Name: name, Namespace: "default"}
+			result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+			pvc := &v1.PersistentVolumeClaim{}
+			err = reconciler.client.Get(context.TODO(), key, pvc)
+			Expect(err).ToNot(HaveOccurred())
+			return pvc, result
+		}
+
+		getImporterPod := func(name string) (*v1.Pod, error) {
+			pod := &v1.Pod{}
+			err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "importer-" + name, Namespace: "default"}, pod)
+			return pod, err
+		}
+
+		completeImporterPod := func(name string) {
+			pod, err := getImporterPod(name)
+			Expect(err).ToNot(HaveOccurred())
+			pod.Status.Phase = v1.PodSucceeded
+			err = reconciler.client.Status().Update(context.TODO(), pod)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		verifyQueued := func(pvc *v1.PersistentVolumeClaim, result reconcile.Result, message string) {
+			Expect(result.RequeueAfter).To(Equal(workerQueueRequeueInterval))
+			Expect(pvc.Labels).To(HaveKey(common.WorkerQueuedLabel))
+			Expect(pvc.Annotations[cc.AnnRunningCondition]).To(Equal("false"))
+			Expect(pvc.Annotations[cc.AnnRunningConditionReason]).To(Equal(common.WorkerQueued))
+			Expect(pvc.Annotations[cc.AnnRunningConditionMessage]).To(Equal(message))
+			_, err := getImporterPod(pvc.Name)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		}
+
+		It("Should queue the importer pod until a worker pod completes", func() {
+			reconciler = createImportReconciler(newImportPvc("testPvc1", 0), newImportPvc("testPvc2", 1))
+			setWorkerPodLimits(&cdiv1.WorkerPodLimits{Global: pointer.Int32(1)})
+
+			reconcilePvc("testPvc1")
+			pod, err := getImporterPod("testPvc1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Annotations[cc.AnnWorkerSourceHost]).To(Equal(sourceHost))
+			Expect(pod.Annotations[cc.AnnWorkerStorageClass]).To(BeEmpty())
+
+			pvc, result := reconcilePvc("testPvc2")
+			verifyQueued(pvc, result, "Queued at position 1, the cluster reached the limit of 1 worker pods")
+
+			completeImporterPod("testPvc1")
+			pvc, _ = reconcilePvc("testPvc2")
+			_, err = getImporterPod("testPvc2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Labels).ToNot(HaveKey(common.WorkerQueuedLabel))
+			Expect(pvc.Annotations).ToNot(HaveKey(cc.AnnRunningConditionReason))
+		})
+
+		It("Should count an admitted importer pod until it is observed", func() {
+			pvc1, pvc2 := newImportPvc("testPvc1", 0), newImportPvc("testPvc2", 1)
+			reconciler = createImportReconciler(pvc1, pvc2)
+			setWorkerPodLimits(&cdiv1.WorkerPodLimits{Global: pointer.Int32(1)})
+
+			admitted, err := reconciler.workerQueue.queueWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, pvc1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+			admitted, err = reconciler.workerQueue.queueWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, pvc2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+
+			By("Keeping the admission of the reserved PVC")
+			admitted, err = reconciler.workerQueue.queueWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, pvc1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+
+			By("Releasing the reservation once the importer pod is observed")
+			reconcilePvc("testPvc1")
+			_, err = getImporterPod("testPvc1")
+			Expect(err).ToNot(HaveOccurred())
+			pvc, result := reconcilePvc("testPvc2")
+			verifyQueued(pvc, result, "Queued at position 1, the cluster reached the limit of 1 worker pods")
+			Expect(reconciler.workerQueue.reservations).To(BeEmpty())
+		})
+
+		It("Should admit queued PVCs in creation order", func() {
+			reconciler = createImportReconciler(newImportPvc("testPvc1", 0), newImportPvc("testPvc2", 1), newImportPvc("testPvc3", 2))
+			setWorkerPodLimits(&cdiv1.WorkerPodLimits{PerSourceHost: pointer.Int32(1)})
+			msg := "source host " + sourceHost + " reached the limit of 1 worker pods"
+
+			reconcilePvc("testPvc1")
+			pvc, result := reconcilePvc("testPvc3")
+			verifyQueued(pvc, result, "Queued at position 1, "+msg)
+			pvc, result = reconcilePvc("testPvc2")
+			verifyQueued(pvc, result, "Queued at position 1, "+msg)
+			pvc, result = reconcilePvc("testPvc3")
+			verifyQueued(pvc, result, "Queued at position 2, "+msg)
+
+			By("Keeping the free slot for the earlier PVC")
+			completeImporterPod("testPvc1")
+			pvc, result = reconcilePvc("testPvc3")
+			verifyQueued(pvc, result, "Queued at position 1, "+msg)
+			reconcilePvc("testPvc2")
+			_, err := getImporterPod("testPvc2")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should not queue PVCs of other namespaces on a namespace limit", func() {
+			otherPvc := newImportPvc("testPvc2", 1)
+			otherPvc.Namespace = "other"
+			reconciler = createImportReconciler(newImportPvc("testPvc1", 0), newImportPvc("testPvc3", 2), otherPvc)
+			setWorkerPodLimits(&cdiv1.WorkerPodLimits{PerNamespace: pointer.Int32(1)})
+
+			reconcilePvc("testPvc1")
+			pvc, result := reconcilePvc("testPvc3")
+			verifyQueued(pvc, result, "Queued at position 1, namespace default reached the limit of 1 worker pods")
+
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "testPvc2", Namespace: "other"}})
+			Expect(err).ToNot(HaveOccurred())
+			pod := &v1.Pod{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "importer-testPvc2", Namespace: "other"}, pod)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("and priorities", func() {
+			neverPreempt := v1.PreemptNever
+			lowPriority := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "low"}, Value: 10}
+			highPriority := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 1000}
+			nonPreemptingPriority := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "non-preempting"}, Value: 1000, PreemptionPolicy: &neverPreempt}
+
+			newPriorityPvc := func(name string, minutes int, priorityClass string) *v1.PersistentVolumeClaim {
+				pvc := newImportPvc(name, minutes)
+				pvc.Annotations[cc.AnnPriorityClassName] = priorityClass
+				return pvc
+			}
+
+			newPreemptiblePvc := func(name string, minutes int, priorityClass string) *v1.PersistentVolumeClaim {
+				pvc := newPriorityPvc(name, minutes, priorityClass)
+				pvc.Annotations[cc.AnnPreemptible] = "true"
+				return pvc
+			}
+
+			It("Should admit higher priority PVCs first", func() {
+				reconciler = createImportReconciler(lowPriority, highPriority,
+					newImportPvc("testPvc1", 0), newPriorityPvc("testPvc2", 1, "low"), newPriorityPvc("testPvc3", 2, "high"))
+				setWorkerPodLimits(&cdiv1.WorkerPodLimits{Global: pointer.Int32(1)})
+				msg := "the cluster reached the limit of 1 worker pods"
+
+				reconcilePvc("testPvc1")
+				pvc, result := reconcilePvc("testPvc2")
+				verifyQueued(pvc, result, "Queued at position 1, "+msg)
+				pvc, result = reconcilePvc("testPvc3")
+				verifyQueued(pvc, result, "Queued at position 1, "+msg)
+				pvc, result = reconcilePvc("testPvc2")
+				verifyQueued(pvc, result, "Queued at position 2, "+msg)
+
+				completeImporterPod("testPvc1")
+				pvc, result = reconcilePvc("testPvc2")
+				verifyQueued(pvc, result, "Queued at position 1, "+msg)
+				reconcilePvc("testPvc3")
+				_, err := getImporterPod("testPvc3")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Should preempt a lower priority import when enabled", func() {
+				reconciler = createImportReconciler(lowPriority, highPriority,
+					newPreemptiblePvc("testPvc1", 0, "low"), newPriorityPvc("testPvc2", 1, "high"))
+				setWorkerPodLimits(&cdiv1.WorkerPodLimits{Global: pointer.Int32(1), PreemptLowerPriorityImports: true})
+
+				reconcilePvc("testPvc1")
+				_, err := getImporterPod("testPvc1")
+				Expect(err).ToNot(HaveOccurred())
+
+				reconcilePvc("testPvc2")
+				_, err = getImporterPod("testPvc2")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = getImporterPod("testPvc1")
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+				event := <-reconciler.recorder.(*record.FakeRecorder).Events
+				Expect(event).To(ContainSubstring(WorkerPodPreempted))
+				Expect(event).To(ContainSubstring("Importer pod importer-testPvc1 preempted by higher priority PersistentVolumeClaim default/testPvc2, the import restarts from the beginning"))
+
+				By("Queueing the preempted PVC")
+				pvc, result := reconcilePvc("testPvc1")
+				verifyQueued(pvc, result, "Queued at position 1, the cluster reached the limit of 1 worker pods")
+			})
+
+			DescribeTable("Should not preempt a lower priority import", func(preemptible bool, priorityClass string, preempt bool) {
+				pvc1 := newPriorityPvc("testPvc1", 0, "low")
+				if preemptible {
+					pvc1.Annotations[cc.AnnPreemptible] = "true"
+				}
+				reconciler = createImportReconciler(lowPriority, highPriority, nonPreemptingPriority,
+					pvc1, newPriorityPvc("testPvc2", 1, priorityClass))
+				setWorkerPodLimits(&cdiv1.WorkerPodLimits{Global: pointer.Int32(1), PreemptLowerPriorityImports: preempt})
+
+				reconcilePvc("testPvc1")
+				pvc, result := reconcilePvc("testPvc2")
+				verifyQueued(pvc, result, "Queued at position 1, the cluster reached the limit of 1 worker pods")
+				_, err := getImporterPod("testPvc1")
+				Expect(err).ToNot(HaveOccurred())
+			},
+				Entry("when preemption is disabled", true, "high", false),
+				Entry("by a non-preempting priority class", true, "non-preempting", true),
+				Entry("by an equal priority class", true, "low", true),
+				Entry("which did not opt in to preemption", false, "high", true),
+			)
+		})
+	})
 })
 
 var _ = Describe("Update PVC from POD", func() {
@@ -530,6 +765,40 @@ var _ = Describe("Update PVC from POD", func() {
 		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionReason]).To(Equal("Explosion"))
 	})
 
+	It("Should use the error code of a structured termination message as the reason", func() {
+		pvc := cc.CreatePvcInStorageClass("testPvc1", "default", &testStorageClass, map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodPhase: string(corev1.PodRunning)}, nil, corev1.ClaimBound)
+		pod := cc.CreateImporterTestPod(pvc, "testPvc1", nil)
+		payload, err := json.Marshal(util.NewTerminationError(&util.HTTPStatusError{StatusCode: 404, Status: "404 Not Found"}, "Unable to connect to http data source: 404"))
+		Expect(err).ToNot(HaveOccurred())
+		terminated := &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Message:  string(payload),
+			Reason:   common.GenericError,
+		}
+		pod.Status = corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					RestartCount:         1,
+					State:                v1.ContainerState{Terminated: terminated},
+					LastTerminationState: corev1.ContainerState{Terminated: terminated},
+				},
+			},
+		}
+		reconciler = createImportReconciler(pvc, pod)
+		err = reconciler.updatePvcFromPod(pvc, pod, reconciler.log)
+		Expect(err).ToNot(HaveOccurred())
+		resPvc := &corev1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1", Namespace: "default"}, resPvc)
+		Expect(err).ToNot(HaveOccurred())
+		By("Checking error event recorded with the error code")
+		event := <-reconciler.recorder.(*record.FakeRecorder).Events
+		Expect(event).To(Equal("Warning " + util.ErrorCodeHTTPNotFound + " Unable to connect to http data source: 404"))
+		Expect(resPvc.GetAnnotations()[cc.AnnRunningCondition]).To(Equal("false"))
+		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionMessage]).To(Equal("Unable to connect to http data source: 404"))
+		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionReason]).To(Equal(util.ErrorCodeHTTPNotFound))
+	})
+
 	It("Should NOT update phase on PVC, if pod exited with error state that is scratchspace exit", func() {
 		pvc := cc.CreatePvcInStorageClass("testPvc1", "default", &testStorageClass, map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodPhase: string(corev1.PodRunning)}, nil, corev1.ClaimBound)
 		scratchPvcName := &corev1.PersistentVolumeClaim{}
@@ -575,6 +844,103 @@ var _ = Describe("Update PVC from POD", func() {
 		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionReason]).To(Equal("Explosion"))
 	})
 
+	It("Should size scratch space from the estimate of the importer", func() {
+		pvc := cc.CreatePvcInStorageClass("testPvc1", "default", &testStorageClass, map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodPhase: string(corev1.PodRunning)}, nil, corev1.ClaimBound)
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("20Gi")
+		scratchPvcName := &corev1.PersistentVolumeClaim{}
+		scratchPvcName.Name = "testPvc1-scratch"
+		pod := cc.CreateImporterTestPod(pvc, "testPvc1", scratchPvcName)
+		terminated := &corev1.ContainerStateTerminated{
+			ExitCode: common.ScratchSpaceNeededExitCode,
+			Message:  fmt.Sprintf("%s; %s: %d", common.ScratchSpaceRequired, common.ScratchSizeEstimate, 2*1024*1024*1024),
+			Reason:   common.GenericError,
+		}
+		pod.Status = corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State:                corev1.ContainerState{Terminated: terminated},
+					LastTerminationState: corev1.ContainerState{Terminated: terminated},
+				},
+			},
+		}
+		reconciler = createImportReconciler(pvc, pod)
+		err := reconciler.updatePvcFromPod(pvc, pod, reconciler.log)
+		Expect(err).ToNot(HaveOccurred())
+		resPvc := &corev1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1", Namespace: "default"}, resPvc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resPvc.GetAnnotations()[cc.AnnScratchSizeEstimate]).To(Equal("2147483648"))
+		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionMessage]).To(Equal(common.ScratchSpaceRequired))
+		Expect(resPvc.GetAnnotations()[cc.AnnRunningConditionReason]).To(Equal(ScratchSpaceRequiredReason))
+
+		By("Checking the scratch PVC is sized from the estimate plus a margin")
+		scratchPvc := &corev1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1-scratch", Namespace: "default"}, scratchPvc)
+		Expect(err).ToNot(HaveOccurred())
+		scratchSize := scratchPvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		Expect(scratchSize.Cmp(resource.MustParse("2.2Gi"))).To(BeNumerically(">=", 0))
+		Expect(scratchSize.Cmp(resource.MustParse("3Gi"))).To(BeNumerically("<", 0))
+		targetSize := resPvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		Expect(targetSize.Cmp(resource.MustParse("20Gi"))).To(Equal(0))
+	})
+
+	It("Should retry with full size scratch space when the estimated one was too small", func() {
+		pvc := cc.CreatePvcInStorageClass("testPvc1", "default", &testStorageClass, map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodPhase: string(corev1.PodRunning), cc.AnnScratchSizeEstimate: "1024"}, nil, corev1.ClaimBound)
+		scratchPvc := cc.CreatePvc("testPvc1-scratch", "default", nil, nil)
+		pod := cc.CreateImporterTestPod(pvc, "testPvc1", scratchPvc)
+		terminated := &corev1.ContainerStateTerminated{
+			ExitCode: common.ScratchSpaceNeededExitCode,
+			Message:  common.ScratchSpaceTooSmall,
+			Reason:   common.GenericError,
+		}
+		pod.Status = corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State:                corev1.ContainerState{Terminated: terminated},
+					LastTerminationState: corev1.ContainerState{Terminated: terminated},
+				},
+			},
+		}
+		reconciler = createImportReconciler(pvc, scratchPvc, pod)
+		err := reconciler.updatePvcFromPod(pvc, pod, reconciler.log)
+		Expect(err).ToNot(HaveOccurred())
+		resPvc := &corev1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1", Namespace: "default"}, resPvc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resPvc.GetAnnotations()).ToNot(HaveKey(cc.AnnScratchSizeEstimate))
+		Expect(resPvc.GetAnnotations()[cc.AnnRequiresScratch]).To(Equal("true"))
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1-scratch", Namespace: "default"}, &corev1.PersistentVolumeClaim{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should wait for the previous scratch PVC to be gone before creating the importer pod", func() {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnImportPod: "importer-testPvc1", cc.AnnRequiresScratch: "true"}, nil)
+		pvc.Status.Phase = v1.ClaimBound
+		scratchPvc := cc.CreatePvc("testPvc1-scratch", "default", nil, nil)
+		scratchPvc.Finalizers = []string{"kubernetes.io/pvc-protection"}
+		reconciler = createImportReconciler(pvc, scratchPvc)
+		Expect(reconciler.client.Delete(context.TODO(), scratchPvc)).To(Succeed())
+		key := types.NamespacedName{Name: "testPvc1", Namespace: "default"}
+		podKey := types.NamespacedName{Name: "importer-testPvc1", Namespace: "default"}
+
+		By("Requeueing while the scratch PVC is terminating")
+		result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).ToNot(BeZero())
+		err = reconciler.client.Get(context.TODO(), podKey, &corev1.Pod{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+
+		By("Creating the importer pod once the scratch PVC is gone")
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "testPvc1-scratch", Namespace: "default"}, scratchPvc)).To(Succeed())
+		scratchPvc.Finalizers = nil
+		Expect(reconciler.client.Update(context.TODO(), scratchPvc)).To(Succeed())
+		_, err = reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.client.Get(context.TODO(), podKey, &corev1.Pod{})).To(Succeed())
+	})
+
 	It("Should mark PVC as waiting for VDDK configmap, if not already present", func() {
 		pvc := cc.CreatePvcInStorageClass("testPvc1", "default", &testStorageClass, map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnImportPod: "testpod", cc.AnnSource: cc.SourceVDDK}, nil, corev1.ClaimPending)
 		reconciler = createImportReconciler(pvc)
@@ -707,6 +1073,174 @@ var _ = Describe("Update PVC from POD", func() {
 
 })
 
+var _ = Describe("Import retry policy", func() {
+	var (
+		reconciler *ImportReconciler
+	)
+
+	newFailedImporterPod := func(pvc *v1.PersistentVolumeClaim, message string, finishedAt time.Time) *v1.Pod {
+		pod := cc.CreateImporterTestPod(pvc, pvc.Name, nil)
+		pod.UID = types.UID("pod-uid-" + message)
+		pod.Spec.RestartPolicy = v1.RestartPolicyNever
+		pod.Status = v1.PodStatus{
+			Phase: v1.PodFailed,
+			ContainerStatuses: []v1.ContainerStatus{{
+				State: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{
+						ExitCode:   1,
+						Message:    message,
+						Reason:     common.GenericError,
+						FinishedAt: metav1.NewTime(finishedAt),
+					},
+				},
+			}},
+		}
+		return pod
+	}
+
+	setRetryPolicy := func(policy *cdiv1.RetryPolicy) {
+		cdiConfig := &cdiv1.CDIConfig{}
+		err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+		Expect(err).ToNot(HaveOccurred())
+		cdiConfig.Spec.RetryPolicy = policy
+		err = reconciler.client.Update(context.TODO(), cdiConfig)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	reconcileFailedPod := func(pvc *v1.PersistentVolumeClaim, pod *v1.Pod) (*v1.PersistentVolumeClaim, error) {
+		err := reconciler.updatePvcFromPod(pvc, pod, reconciler.log)
+		Expect(err).ToNot(HaveOccurred())
+		resPvc := &v1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pvc), resPvc)
+		Expect(err).ToNot(HaveOccurred())
+		return resPvc, reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pod), &v1.Pod{})
+	}
+
+	It("Should create the importer pod with a Never restart policy", func() {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnImportPod: "importer-testPvc1"}, nil)
+		pvc.Status.Phase = v1.ClaimBound
+		reconciler = createImportReconciler(pvc)
+		setRetryPolicy(&cdiv1.RetryPolicy{})
+
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pvc)})
+		Expect(err).ToNot(HaveOccurred())
+		pod := &v1.Pod{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: "importer-testPvc1", Namespace: "default"}, pod)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+	})
+
+	It("Should keep the failed pod during the backoff, then delete it to retry", func() {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodRestarts: "1"}, nil)
+		pod := newFailedImporterPod(pvc, "dial tcp: connection refused", time.Now())
+		reconciler = createImportReconciler(pvc, pod)
+		reconciler.recorder = record.NewFakeRecorder(10)
+		setRetryPolicy(&cdiv1.RetryPolicy{BackoffBase: &metav1.Duration{Duration: time.Minute}})
+
+		resPvc, err := reconcileFailedPod(pvc, pod)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resPvc.Annotations[cc.AnnPodRestarts]).To(Equal("2"))
+		Expect(resPvc.Annotations[cc.AnnRunningConditionReason]).To(Equal(string(cdiv1.ImportErrorNetwork)))
+		Expect(resPvc.Annotations[cc.AnnRunningConditionMessage]).To(Equal("Import attempt 2 failed, retrying after 2m0s: dial tcp: connection refused"))
+		Expect(resPvc.Annotations).ToNot(HaveKey(cc.AnnImportFailed))
+
+		By("Counting the failed pod once")
+		pod.Status.ContainerStatuses[0].State.Terminated.FinishedAt = metav1.NewTime(time.Now().Add(-time.Hour))
+		resPvc, err = reconcileFailedPod(resPvc, pod)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		Expect(resPvc.Annotations[cc.AnnPodRestarts]).To(Equal("2"))
+	})
+
+	DescribeTable("Should fail the import for good", func(policy, dvPolicy *cdiv1.RetryPolicy, message, expectedMessage, expectedReason string) {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodRestarts: "2"}, nil)
+		pvc.Status.Phase = v1.ClaimBound
+		if dvPolicy != nil {
+			value, err := json.Marshal(dvPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			pvc.Annotations[cc.AnnRetryPolicy] = string(value)
+		}
+		pod := newFailedImporterPod(pvc, message, time.Now().Add(-time.Hour))
+		reconciler = createImportReconciler(pvc, pod)
+		reconciler.recorder = record.NewFakeRecorder(10)
+		setRetryPolicy(policy)
+
+		resPvc, err := reconcileFailedPod(pvc, pod)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resPvc.Annotations[cc.AnnImportFailed]).To(Equal("true"))
+		Expect(resPvc.Annotations[cc.AnnPodPhase]).To(Equal(string(v1.PodFailed)))
+		Expect(resPvc.Annotations[cc.AnnRunningConditionReason]).To(Equal(expectedReason))
+		Expect(resPvc.Annotations[cc.AnnRunningConditionMessage]).To(Equal(expectedMessage))
+
+		By("Not recreating the importer pod")
+		err = reconciler.client.Delete(context.TODO(), pod)
+		Expect(err).ToNot(HaveOccurred())
+		result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pvc)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		err = reconciler.client.Get(context.TODO(), client.ObjectKeyFromObject(pod), &v1.Pod{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	},
+		Entry("after the max attempts", &cdiv1.RetryPolicy{MaxAttempts: pointer.Int32(3)}, nil,
+			"expected status code 200, got 503. Status: 503 Service Unavailable",
+			"Import failed, giving up after attempt 3: expected status code 200, got 503. Status: 503 Service Unavailable", string(cdiv1.ImportErrorNetwork)),
+		Entry("on an error class which is not retried", &cdiv1.RetryPolicy{RetryOn: []cdiv1.ImportErrorClass{cdiv1.ImportErrorNetwork}}, nil,
+			"expected status code 200, got 404. Status: 404 Not Found",
+			"Import failed, giving up after attempt 3: expected status code 200, got 404. Status: 404 Not Found", string(cdiv1.ImportErrorNotFound)),
+		Entry("with the DataVolume max attempts over the CDIConfig ones", &cdiv1.RetryPolicy{MaxAttempts: pointer.Int32(10)}, &cdiv1.RetryPolicy{MaxAttempts: pointer.Int32(2)},
+			"Virtual image size 2 is larger than the reported available storage 1. A larger PVC is required.",
+			"Import failed, giving up after attempt 3: DataVolume too small to contain image", string(cdiv1.ImportErrorOutOfSpace)),
+		Entry("on an error which the importer reported as not retryable", &cdiv1.RetryPolicy{}, nil,
+			`{"code":"TLSFailed","category":"Network","retryable":false,"message":"Unable to connect to http data source: x509: certificate signed by unknown authority"}`,
+			"Import failed, giving up after attempt 3: Unable to connect to http data source: x509: certificate signed by unknown authority", util.ErrorCodeTLSFailed),
+		Entry("on a retryable error of a class which is not retried", &cdiv1.RetryPolicy{RetryOn: []cdiv1.ImportErrorClass{cdiv1.ImportErrorAuth}}, nil,
+			`{"code":"HTTPUnavailable","category":"Network","retryable":true,"message":"Status: 503 Service Unavailable"}`,
+			"Import failed, giving up after attempt 3: Status: 503 Service Unavailable", util.ErrorCodeHTTPUnavailable),
+	)
+
+	DescribeTable("Should retry the import", func(policy *cdiv1.RetryPolicy, message, expectedReason string) {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: testEndPoint, cc.AnnPodRestarts: "2"}, nil)
+		pod := newFailedImporterPod(pvc, message, time.Now().Add(-time.Hour))
+		reconciler = createImportReconciler(pvc, pod)
+		reconciler.recorder = record.NewFakeRecorder(10)
+		setRetryPolicy(policy)
+
+		resPvc, err := reconcileFailedPod(pvc, pod)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		Expect(resPvc.Annotations).ToNot(HaveKey(cc.AnnImportFailed))
+		Expect(resPvc.Annotations[cc.AnnRunningConditionReason]).To(Equal(expectedReason))
+	},
+		Entry("on an error which the importer reported as retryable", &cdiv1.RetryPolicy{},
+			`{"code":"HTTPUnavailable","category":"Network","retryable":true,"message":"Status: 503 Service Unavailable"}`, util.ErrorCodeHTTPUnavailable),
+		Entry("on a free-text error", &cdiv1.RetryPolicy{}, "expected status code 200, got 404. Status: 404 Not Found", string(cdiv1.ImportErrorNotFound)),
+		Entry("on a not retryable error of a retried class", &cdiv1.RetryPolicy{RetryOn: []cdiv1.ImportErrorClass{cdiv1.ImportErrorAuth}},
+			`{"code":"HTTPUnauthorized","category":"Auth","retryable":false,"message":"Status: 401 Unauthorized"}`, util.ErrorCodeHTTPUnauthorized),
+	)
+
+	DescribeTable("classifyImportError", func(message string, expected cdiv1.ImportErrorClass) {
+		Expect(classifyImportError(message)).To(Equal(expected))
+	},
+		Entry("connection refused", "Unable to connect to http data source: dial tcp 10.0.0.1:80: connect: connection refused", cdiv1.ImportErrorNetwork),
+		Entry("unknown host", "dial tcp: lookup example.com: no such host", cdiv1.ImportErrorNetwork),
+		Entry("unauthorized registry", "Failed to read registry image: unauthorized: authentication required", cdiv1.ImportErrorAuth),
+		Entry("forbidden http", "expected status code 200, got 403. Status: 403 Forbidden", cdiv1.ImportErrorAuth),
+		Entry("missing registry image", "Error retrieving image: manifest unknown", cdiv1.ImportErrorNotFound),
+		Entry("invalid image", "Invalid format vmdk2 for image /scratch/disk.img", cdiv1.ImportErrorInvalidImage),
+		Entry("missing disk in container image", "image file does not exist in image directory - directory is empty", cdiv1.ImportErrorInvalidImage),
+		Entry("no space", "write /data/disk.img: no space left on device", cdiv1.ImportErrorOutOfSpace),
+		Entry("anything else", "I went poof", cdiv1.ImportErrorUnknown),
+		Entry("structured termination message", `{"code":"HTTPNotFound","category":"NotFound","retryable":false,"message":"Status: 404 Forbidden"}`, cdiv1.ImportErrorNotFound),
+	)
+
+	DescribeTable("getRetryBackoff", func(policy *cdiv1.RetryPolicy, failures int, expected time.Duration) {
+		Expect(getRetryBackoff(policy, failures)).To(Equal(expected))
+	},
+		Entry("default base", &cdiv1.RetryPolicy{}, 1, 10*time.Second),
+		Entry("doubled", &cdiv1.RetryPolicy{}, 3, 40*time.Second),
+		Entry("default cap", &cdiv1.RetryPolicy{}, 10, 5*time.Minute),
+		Entry("policy base and cap", &cdiv1.RetryPolicy{BackoffBase: &metav1.Duration{Duration: time.Second}, BackoffCap: &metav1.Duration{Duration: 3 * time.Second}}, 3, 3*time.Second),
+	)
+})
+
 var _ = Describe("Create Importer Pod", func() {
 	var scratchPvcName = "scratchPvc"
 
@@ -834,6 +1368,101 @@ var _ = Describe("Import test env", func() {
 			preallocation:      false}
 		Expect(reflect.DeepEqual(makeImportEnv(testEnvVar, mockUID), createImportTestEnv(testEnvVar, mockUID))).To(BeTrue())
 	})
+
+	It("Should pass the import cache URL", func() {
+		testEnvVar := &importPodEnvVar{
+			ep:             "myendpoint",
+			source:         cc.SourceRegistry,
+			importCacheURL: GetImportCacheURL("cdi"),
+		}
+		Expect(makeImportEnv(testEnvVar, mockUID)).To(ContainElement(corev1.EnvVar{
+			Name:  common.ImportCacheURL,
+			Value: "http://cdi-import-cache.cdi.svc:8080",
+		}))
+	})
+
+	It("Should tell the importer the scratch space was sized from an estimate", func() {
+		testEnvVar := &importPodEnvVar{
+			ep:                   "myendpoint",
+			source:               cc.SourceHTTP,
+			scratchSizeEstimated: true,
+		}
+		Expect(makeImportEnv(testEnvVar, mockUID)).To(ContainElement(corev1.EnvVar{
+			Name:  common.ImporterScratchSizeEstimated,
+			Value: "true",
+		}))
+	})
+
+	It("Should ask the importer to sparsify the target", func() {
+		testEnvVar := &importPodEnvVar{
+			ep:       "myendpoint",
+			source:   cc.SourceHTTP,
+			sparsify: true,
+		}
+		Expect(makeImportEnv(testEnvVar, mockUID)).To(ContainElement(corev1.EnvVar{
+			Name:  common.ImporterSparsify,
+			Value: "true",
+		}))
+	})
+
+	It("Should pin the import to the source checksum and version", func() {
+		testEnvVar := &importPodEnvVar{
+			ep:             "myendpoint",
+			source:         cc.SourceHTTP,
+			sourceChecksum: "sha256:1234",
+			sourceVersion:  "sha256:5678",
+		}
+		env := makeImportEnv(testEnvVar, mockUID)
+		Expect(env).To(ContainElement(corev1.EnvVar{
+			Name:  common.ImporterSourceChecksum,
+			Value: "sha256:1234",
+		}))
+		Expect(env).To(ContainElement(corev1.EnvVar{
+			Name:  common.ImporterSourceVersion,
+			Value: "sha256:5678",
+		}))
+	})
+
+	DescribeTable("Should only use the import cache for sources without credentials", func(podEnvVar *importPodEnvVar, importCache *cdiv1.ImportCacheSpec, expected bool) {
+		cdiConfig := cc.MakeEmptyCDIConfigSpec(common.ConfigName)
+		cdiConfig.Spec.ImportCache = importCache
+		Expect(isImportCacheable(podEnvVar, cdiConfig)).To(Equal(expected))
+	},
+		Entry("http source", &importPodEnvVar{source: cc.SourceHTTP}, &cdiv1.ImportCacheSpec{}, true),
+		Entry("registry source", &importPodEnvVar{source: cc.SourceRegistry}, &cdiv1.ImportCacheSpec{}, true),
+		Entry("disabled import cache", &importPodEnvVar{source: cc.SourceHTTP}, nil, false),
+		Entry("s3 source", &importPodEnvVar{source: cc.SourceS3}, &cdiv1.ImportCacheSpec{}, false),
+		Entry("source with a secret", &importPodEnvVar{source: cc.SourceHTTP, secretName: "secret"}, &cdiv1.ImportCacheSpec{}, false),
+		Entry("source with a certificate", &importPodEnvVar{source: cc.SourceHTTP, certConfigMap: "certs"}, &cdiv1.ImportCacheSpec{}, false),
+		Entry("source with extra headers", &importPodEnvVar{source: cc.SourceHTTP, extraHeaders: []string{"Authorization: Bearer x"}}, &cdiv1.ImportCacheSpec{}, false),
+		Entry("source with secret extra headers", &importPodEnvVar{source: cc.SourceHTTP, secretExtraHeaders: []string{"headers"}}, &cdiv1.ImportCacheSpec{}, false),
+		Entry("source behind a proxy", &importPodEnvVar{source: cc.SourceHTTP, httpProxy: "proxy"}, &cdiv1.ImportCacheSpec{}, false),
+	)
+
+	DescribeTable("Should authorize the importer to use the import cache", func(source, endpoint string, expectedParams map[string]string) {
+		pvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnEndpoint: endpoint, cc.AnnSource: source}, nil)
+		reconciler := createImportReconciler(pvc)
+		cdiConfig := &cdiv1.CDIConfig{}
+		err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+		Expect(err).ToNot(HaveOccurred())
+		cdiConfig.Spec.ImportCache = &cdiv1.ImportCacheSpec{}
+		err = reconciler.client.Update(context.TODO(), cdiConfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		podEnvVar, err := reconciler.createImportEnvVar(pvc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podEnvVar.importCacheURL).ToNot(BeEmpty())
+		validator := token.NewValidator(common.ImportCacheTokenIssuer, &cc.GetAPIServerKey().PublicKey, time.Second)
+		payload, err := validator.Validate(podEnvVar.importCacheToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Operation).To(Equal(token.OperationImportCache))
+		Expect(payload.Name).To(Equal(pvc.Name))
+		Expect(payload.Namespace).To(Equal(pvc.Namespace))
+		Expect(payload.Params).To(Equal(expectedParams))
+	},
+		Entry("holding the URL of an http source", cc.SourceHTTP, "http://example.com/disk.img", map[string]string{importcache.TokenURLParam: "http://example.com/disk.img"}),
+		Entry("of a registry source", cc.SourceRegistry, "docker://example.com/disk", nil),
+	)
 })
 
 var _ = Describe("getSecretName", func() {
@@ -1004,7 +1633,10 @@ func createImportReconciler(objects ...runtime.Object) *ImportReconciler {
 	objs = append(objs, cdiConfig)
 
 	// Create a fake client to mock API calls.
-	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithIndex(&corev1.Pod{}, workerPodLimitField, indexWorkerPodLimit).
+		WithIndex(&corev1.PersistentVolumeClaim{}, workerQueuedField, indexWorkerQueued).
+		Build()
 
 	// Increase this if you have more than one event that fires.
 	rec := record.NewFakeRecorder(1)
@@ -1020,6 +1652,8 @@ func createImportReconciler(objects ...runtime.Object) *ImportReconciler {
 			common.AppKubernetesPartOfLabel:  "testing",
 			common.AppKubernetesVersionLabel: "v0.0.0-tests",
 		},
+		workerQueue:    newWorkerQueue(),
+		tokenGenerator: newImportCacheTokenGenerator(cc.GetAPIServerKey()),
 	}
 	return r
 }
@@ -1140,12 +1774,17 @@ func createImportTestEnv(podEnvVar *importPodEnvVar, uid string) []corev1.EnvVar
 
 type FakeFeatureGates struct {
 	honorWaitForFirstConsumerEnabled bool
+	importDeduplicationEnabled       bool
 }
 
 func (f *FakeFeatureGates) HonorWaitForFirstConsumerEnabled() (bool, error) {
 	return f.honorWaitForFirstConsumerEnabled, nil
 }
 
+func (f *FakeFeatureGates) ImportDeduplicationEnabled() (bool, error) {
+	return f.importDeduplicationEnabled, nil
+}
+
 func createPendingPvc(name, ns string, annotations, labels map[string]string) *v1.PersistentVolumeClaim {
 	return cc.CreatePvcInStorageClass(name, ns, nil, annotations, labels, v1.ClaimPending)
 }
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+const (
+	defaultRetryBackoffBase = 10 * time.Second
+	defaultRetryBackoffCap  = 5 * time.Minute
+
+	// ImportRetriesExhausted provides a const to indicate an import failed for good according to its retry policy
+	ImportRetriesExhausted = "ImportRetriesExhausted"
+)
+
+// classifyImportError returns the error class of an importer termination message
+func classifyImportError(message string) cdiv1.ImportErrorClass {
+	if te, ok := util.ParseTerminationError(message); ok {
+		return te.Category
+	}
+	return util.ClassifyErrorMessage(message)
+}
+
+// getRetryPolicy returns the retry policy of the PVC import, which is the DataVolume retry policy over the CDIConfig one,
+// or nil if neither sets one
+func getRetryPolicy(ctx context.Context, c client.Client, pvc *corev1.PersistentVolumeClaim) (*cdiv1.RetryPolicy, error) {
+	cdiConfig := &cdiv1.CDIConfig{}
+	if err := c.Get(ctx, client.ObjectKey{Name: common.ConfigName}, cdiConfig); cc.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	policy := cdiConfig.Spec.RetryPolicy
+	if value, ok := pvc.Annotations[cc.AnnRetryPolicy]; ok {
+		dvPolicy := &cdiv1.RetryPolicy{}
+		if err := json.Unmarshal([]byte(value), dvPolicy); err != nil {
+			return nil, err
+		}
+		policy = mergeRetryPolicy(policy, dvPolicy)
+	}
+	return policy, nil
+}
+
+// mergeRetryPolicy returns the policy with the fields set by the override replaced
+func mergeRetryPolicy(policy, override *cdiv1.RetryPolicy) *cdiv1.RetryPolicy {
+	if policy == nil {
+		return override
+	}
+	merged := policy.DeepCopy()
+	if override.MaxAttempts != nil {
+		merged.MaxAttempts = override.MaxAttempts
+	}
+	if override.BackoffBase != nil {
+		merged.BackoffBase = override.BackoffBase
+	}
+	if override.BackoffCap != nil {
+		merged.BackoffCap = override.BackoffCap
+	}
+	if override.RetryOn != nil {
+		merged.RetryOn = override.RetryOn
+	}
+	return merged
+}
+
+// getRetryBackoff returns the delay before retrying an import which failed the given number of times
+func getRetryBackoff(policy *cdiv1.RetryPolicy, failures int) time.Duration {
+	base, backoffCap := defaultRetryBackoffBase, defaultRetryBackoffCap
+	if policy.BackoffBase != nil && policy.BackoffBase.Duration > 0 {
+		base = policy.BackoffBase.Duration
+	}
+	if policy.BackoffCap != nil && policy.BackoffCap.Duration > 0 {
+		backoffCap = policy.BackoffCap.Duration
+	}
+	backoff := base
+	for i := 1; i < failures && backoff < backoffCap; i++ {
+		backoff *= 2
+	}
+	if backoff > backoffCap {
+		return backoffCap
+	}
+	return backoff
+}
+
+// isRetriedError tells whether the policy retries the error of a failed import attempt. Without retried error classes,
+// the errors which the importer reported as not retryable, like a 404 or a TLS failure, fail the import right away.
+// Free-text errors of older importers are retried.
+func isRetriedError(policy *cdiv1.RetryPolicy, class cdiv1.ImportErrorClass, te *util.TerminationError) bool {
+	if len(policy.RetryOn) == 0 {
+		return te == nil || te.Retryable
+	}
+	for _, retried := range policy.RetryOn {
+		if retried == class {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRetryPolicy counts a failed import attempt of an importer pod which is not restarted by Kubernetes,
+// and either fails the import for good, or tells whether the pod should be deleted so the import is retried
+// once the backoff elapsed
+func (r *ImportReconciler) applyRetryPolicy(pvc *corev1.PersistentVolumeClaim, pod *corev1.Pod, log logr.Logger) (bool, error) {
+	terminated := getImporterTerminatedState(pod)
+	if terminated == nil {
+		return false, nil
+	}
+	policy, err := getRetryPolicy(context.TODO(), r.client, pvc)
+	if err != nil {
+		return false, err
+	}
+	if policy == nil {
+		policy = &cdiv1.RetryPolicy{}
+	}
+
+	anno := pvc.Annotations
+	failures, _ := strconv.Atoi(anno[cc.AnnPodRestarts])
+	if anno[cc.AnnRetriedPod] != string(pod.UID) {
+		failures++
+		anno[cc.AnnPodRestarts] = strconv.Itoa(failures)
+		anno[cc.AnnRetriedPod] = string(pod.UID)
+	}
+
+	class := classifyImportError(terminated.Message)
+	reason, msg := string(class), simplifyKnownMessage(terminated.Message)
+	te, ok := util.ParseTerminationError(terminated.Message)
+	if ok {
+		reason, msg = te.Code, simplifyKnownMessage(te.Message)
+	}
+	anno[cc.AnnRunningCondition] = "false"
+	anno[cc.AnnRunningConditionReason] = reason
+	if !isRetriedError(policy, class, te) || (policy.MaxAttempts != nil && failures >= int(*policy.MaxAttempts)) {
+		log.V(1).Info("Import failed for good", "attempts", failures, "class", class, "reason", reason)
+		if anno[cc.AnnImportFailed] != "true" {
+			anno[cc.AnnImportFailed] = "true"
+			r.recorder.Eventf(pvc, corev1.EventTypeWarning, ImportRetriesExhausted, "Import failed, giving up after attempt %d: %s", failures, msg)
+		}
+		anno[cc.AnnRunningConditionMessage] = fmt.Sprintf("Import failed, giving up after attempt %d: %s", failures, msg)
+		return false, nil
+	}
+
+	backoff := getRetryBackoff(policy, failures)
+	anno[cc.AnnRunningConditionMessage] = fmt.Sprintf("Import attempt %d failed, retrying after %s: %s", failures, backoff, msg)
+	if time.Now().Before(terminated.FinishedAt.Add(backoff)) {
+		return false, nil
+	}
+	log.V(1).Info("Retrying the import", "attempts", failures, "class", class, "reason", reason)
+	return true, nil
+}
+
+// getImporterTerminatedState returns the last termination of the importer container which restarted,
+// or the termination of a failed importer pod which is not restarted by Kubernetes
+func getImporterTerminatedState(pod *corev1.Pod) *corev1.ContainerStateTerminated {
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return nil
+	}
+	status := pod.Status.ContainerStatuses[0]
+	if status.LastTerminationState.Terminated != nil {
+		return status.LastTerminationState.Terminated
+	}
+	if pod.Status.Phase == corev1.PodFailed {
+		return status.State.Terminated
+	}
+	return nil
+}
+
+func isImportFailed(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Annotations[cc.AnnImportFailed] == "true"
+}
@@ -278,6 +278,10 @@ var _ = Describe("Import populator tests", func() {
 			Entry("side car injection is passed", AnnPodSidecarInjection, AnnPodSidecarInjectionDefault, AnnPodSidecarInjectionDefault),
 			Entry("multus default network is passed", AnnPodMultusDefaultNetwork, "test", "test"),
 			Entry("retain pod annotation is passed", AnnPodRetainAfterCompletion, "true", "true"),
+			Entry("retry policy is passed", AnnRetryPolicy, `{"maxAttempts":3}`, `{"maxAttempts":3}`),
+			Entry("sparsify annotation is passed", AnnSparsify, "true", "true"),
+			Entry("source version is passed", AnnSourceVersion, "sha256:1234", "sha256:1234"),
+			Entry("preemptible annotation is passed", AnnPreemptible, "true", "true"),
 		)
 
 		It("should trigger appropriate event when using AnnPodRetainAfterCompletion", func() {
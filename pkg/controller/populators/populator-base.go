@@ -179,6 +179,18 @@ func (r *ReconcilerBase) createPVCPrime(pvc *corev1.PersistentVolumeClaim, sourc
 	if _, ok := pvc.Annotations[cc.AnnPodRetainAfterCompletion]; ok {
 		annotations[cc.AnnPodRetainAfterCompletion] = pvc.Annotations[cc.AnnPodRetainAfterCompletion]
 	}
+	if retryPolicy, ok := pvc.Annotations[cc.AnnRetryPolicy]; ok {
+		annotations[cc.AnnRetryPolicy] = retryPolicy
+	}
+	if sparsify, ok := pvc.Annotations[cc.AnnSparsify]; ok {
+		annotations[cc.AnnSparsify] = sparsify
+	}
+	if sourceVersion, ok := pvc.Annotations[cc.AnnSourceVersion]; ok {
+		annotations[cc.AnnSourceVersion] = sourceVersion
+	}
+	if preemptible, ok := pvc.Annotations[cc.AnnPreemptible]; ok {
+		annotations[cc.AnnPreemptible] = preemptible
+	}
 
 	// Assemble PVC' spec
 	pvcPrime := &corev1.PersistentVolumeClaim{
@@ -220,8 +232,8 @@ func (r *ReconcilerBase) createPVCPrime(pvc *corev1.PersistentVolumeClaim, sourc
 type updatePVCAnnotationsFunc func(pvc, pvcPrime *corev1.PersistentVolumeClaim)
 
 var desiredAnnotations = []string{cc.AnnPodPhase, cc.AnnPodReady, cc.AnnPodRestarts,
-	cc.AnnPreallocationRequested, cc.AnnPreallocationApplied, cc.AnnCurrentCheckpoint, cc.AnnMultiStageImportDone,
-	cc.AnnRunningCondition, cc.AnnRunningConditionMessage, cc.AnnRunningConditionReason}
+	cc.AnnPreallocationRequested, cc.AnnPreallocationApplied, cc.AnnSparsifyUnmapped, cc.AnnSparsifyReclaimed, cc.AnnCurrentCheckpoint, cc.AnnMultiStageImportDone,
+	cc.AnnRunningCondition, cc.AnnRunningConditionMessage, cc.AnnRunningConditionReason, cc.AnnImportFailed}
 
 func (r *ReconcilerBase) updatePVCWithPVCPrimeAnnotations(pvc, pvcPrime *corev1.PersistentVolumeClaim, updateFunc updatePVCAnnotationsFunc) error {
 	pvcCopy := pvc.DeepCopy()
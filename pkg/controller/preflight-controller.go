@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	dvc "kubevirt.io/containerized-data-importer/pkg/controller/datavolume"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+	"kubevirt.io/containerized-data-importer/pkg/util/naming"
+)
+
+const (
+	preflightControllerName = "preflight-controller"
+
+	// PreflightSucceeded provides a const to indicate a DataVolume dry run succeeded
+	PreflightSucceeded = "PreflightSucceeded"
+	// PreflightFailed provides a const to indicate a DataVolume dry run failed
+	PreflightFailed = "PreflightFailed"
+
+	// preflightReasonInsufficientStorage is the reason of a dry run whose image doesn't fit in the requested storage
+	preflightReasonInsufficientStorage = "InsufficientStorage"
+	// preflightReasonNotSupported is the reason of a dry run whose source can't be inspected
+	preflightReasonNotSupported = "NotSupported"
+	// preflightReasonDeadlineExceeded is the reason of a dry run whose pod didn't terminate in time
+	preflightReasonDeadlineExceeded = "DeadlineExceeded"
+
+	// preflightPodDeadline bounds the time a probe pod may take, pending or running
+	preflightPodDeadline = 10 * time.Minute
+	// podReasonDeadlineExceeded is the reason of a pod failed by its active deadline
+	podReasonDeadlineExceeded = "DeadlineExceeded"
+)
+
+// PreflightReconciler runs the dry runs of DataVolume imports: a short-lived importer pod inspects the
+// source without a target volume, and the result is saved in an annotation of the DataVolume
+type PreflightReconciler struct {
+	client          client.Client
+	scheme          *runtime.Scheme
+	log             logr.Logger
+	recorder        record.EventRecorder
+	image           string
+	verbose         string
+	pullPolicy      string
+	installerLabels map[string]string
+	workerQueue     *workerQueue
+	// importReconciler renders the importer environment of the probe pods
+	importReconciler *ImportReconciler
+}
+
+// NewPreflightController creates a new instance of the preflight controller
+func NewPreflightController(mgr manager.Manager, log logr.Logger, importerImage, pullPolicy, verbose string, installerLabels map[string]string) (controller.Controller, error) {
+	uncachedClient, err := client.New(mgr.GetConfig(), client.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reconciler := &PreflightReconciler{
+		client:          mgr.GetClient(),
+		scheme:          mgr.GetScheme(),
+		log:             log.WithName(preflightControllerName),
+		recorder:        mgr.GetEventRecorderFor(preflightControllerName),
+		image:           importerImage,
+		verbose:         verbose,
+		pullPolicy:      pullPolicy,
+		installerLabels: installerLabels,
+		workerQueue:     defaultWorkerQueue,
+	}
+	reconciler.importReconciler = &ImportReconciler{
+		client:         reconciler.client,
+		uncachedClient: uncachedClient,
+		log:            reconciler.log,
+		cdiNamespace:   util.GetNamespace(),
+	}
+	preflightController, err := controller.New(preflightControllerName, mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := addPreflightControllerWatches(preflightController); err != nil {
+		return nil, err
+	}
+	log.Info("Initialized preflight controller")
+	return preflightController, nil
+}
+
+func addPreflightControllerWatches(c controller.Controller) error {
+	needsPreflight := func(obj client.Object) bool {
+		_, dryRun := obj.GetAnnotations()[cc.AnnDryRun]
+		_, result := obj.GetAnnotations()[cc.AnnPreflightResult]
+		dv, ok := obj.(*cdiv1.DataVolume)
+		return dryRun || result || (ok && cc.NeedsSizeDetection(dv))
+	}
+	if err := c.Watch(&source.Kind{Type: &cdiv1.DataVolume{}}, &handler.EnqueueRequestForObject{}, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return needsPreflight(e.Object) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return needsPreflight(e.ObjectOld) || needsPreflight(e.ObjectNew)
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}); err != nil {
+		return err
+	}
+	isPreflightPod := func(obj client.Object) bool {
+		return obj.GetLabels()[common.CDIComponentLabel] == common.PreflightPodName
+	}
+	return c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestForOwner{
+		OwnerType:    &cdiv1.DataVolume{},
+		IsController: true,
+	}, predicate.NewPredicateFuncs(isPreflightPod))
+}
+
+// Reconcile runs the dry run of a DataVolume, once per dry-run annotation, or once to detect the size of
+// an import DataVolume without a storage size
+func (r *PreflightReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	dv := &cdiv1.DataVolume{}
+	if err := r.client.Get(ctx, req.NamespacedName, dv); err != nil {
+		return reconcile.Result{}, cc.IgnoreNotFound(err)
+	}
+	pod := &corev1.Pod{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: dv.Namespace, Name: getPreflightPodName(dv)}, pod); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		pod = nil
+	}
+
+	if (!cc.IsDryRun(dv) && !cc.NeedsSizeDetection(dv)) || dv.DeletionTimestamp != nil {
+		if err := r.deletePod(ctx, pod); err != nil {
+			return reconcile.Result{}, err
+		}
+		// Setting the dry-run annotation again runs a new dry run
+		if _, ok := dv.Annotations[cc.AnnPreflightResult]; ok && dv.DeletionTimestamp == nil {
+			delete(dv.Annotations, cc.AnnPreflightResult)
+			return reconcile.Result{}, r.client.Update(ctx, dv)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	result, err := cc.GetPreflightResult(dv)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if result != nil && result.Phase != cc.PreflightPhaseRunning {
+		return reconcile.Result{}, r.deletePod(ctx, pod)
+	}
+	if pod == nil {
+		// The size is only detected before the PVC is created
+		if !cc.IsDryRun(dv) {
+			if exists, err := r.pvcExists(ctx, dv); err != nil || exists {
+				return reconcile.Result{}, err
+			}
+		}
+		return r.startPreflight(ctx, dv)
+	}
+	return r.finishPreflight(ctx, dv, pod)
+}
+
+func getPreflightPodName(dv *cdiv1.DataVolume) string {
+	return naming.GetResourceName(dv.Name, common.PreflightPodName)
+}
+
+// startPreflight creates the pod inspecting the source of the DataVolume, once admitted by the worker pod limits
+func (r *PreflightReconciler) startPreflight(ctx context.Context, dv *cdiv1.DataVolume) (reconcile.Result, error) {
+	pvc, err := dvc.RenderImportPVC(r.client, r.recorder, r.log, dv)
+	if err != nil {
+		return reconcile.Result{}, r.setPreflightResult(ctx, dv, &cc.PreflightResult{Phase: cc.PreflightPhaseFailed, Reason: preflightReasonNotSupported, Message: err.Error()})
+	}
+	switch source := cc.GetSource(pvc); {
+	case cc.IsImageStream(pvc), source != cc.SourceHTTP && source != cc.SourceS3 && source != cc.SourceGCS && source != cc.SourceRegistry:
+		message := fmt.Sprintf("Dry run is not supported for source %s", source)
+		return reconcile.Result{}, r.setPreflightResult(ctx, dv, &cc.PreflightResult{Phase: cc.PreflightPhaseFailed, Reason: preflightReasonNotSupported, Message: message})
+	}
+	// The rendered PVC is queued in place of the DataVolume, which its preflight pod is counted by
+	pvc.UID = dv.UID
+	pvc.CreationTimestamp = dv.CreationTimestamp
+	if pvc.Labels == nil {
+		pvc.Labels = make(map[string]string)
+	}
+	pvc.Labels[common.CDIComponentLabel] = common.PreflightPodName
+	admitted, err := r.workerQueue.queueWorkerPod(ctx, r.client, r.recorder, pvc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !admitted {
+		r.log.V(3).Info("Preflight pod queued", "dataVolume", dv.Name, "namespace", dv.Namespace, "reason", pvc.Annotations[cc.AnnRunningConditionMessage])
+		return reconcile.Result{RequeueAfter: workerQueueRequeueInterval}, nil
+	}
+	podEnvVar, err := r.importReconciler.createImportEnvVar(pvc)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	pod, err := r.makePreflightPodSpec(ctx, dv, pvc, podEnvVar)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.client.Create(ctx, pod); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return reconcile.Result{}, err
+	}
+	if podEnvVar.certConfigMapProxy != "" {
+		if err := r.importReconciler.copyProxyConfigMap(GetImportProxyConfigMapName(pod.Name), pod); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	r.log.V(1).Info("Created preflight pod", "pod.Name", pod.Name, "pod.Namespace", pod.Namespace)
+	return reconcile.Result{}, r.setPreflightResult(ctx, dv, &cc.PreflightResult{Phase: cc.PreflightPhaseRunning})
+}
+
+func (r *PreflightReconciler) makePreflightPodSpec(ctx context.Context, dv *cdiv1.DataVolume, pvc *corev1.PersistentVolumeClaim, podEnvVar *importPodEnvVar) (*corev1.Pod, error) {
+	podResourceRequirements, err := cc.GetDefaultPodResourceRequirements(r.client)
+	if err != nil {
+		return nil, err
+	}
+	imagePullSecrets, err := cc.GetImagePullSecrets(r.client)
+	if err != nil {
+		return nil, err
+	}
+	workloadNodePlacement, err := cc.GetWorkloadNodePlacement(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	container := makeImporterContainerSpec(r.image, r.verbose, r.pullPolicy)
+	container.Env = append(makeImportEnv(podEnvVar, dv.UID), corev1.EnvVar{Name: common.ImporterDryRun, Value: "true"})
+	if podResourceRequirements != nil {
+		container.Resources = *podResourceRequirements
+	}
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPreflightPodName(dv),
+			Namespace: dv.Namespace,
+			Annotations: map[string]string{
+				cc.AnnCreatedBy: "yes",
+			},
+			Labels: map[string]string{
+				common.CDILabelKey:       common.CDILabelValue,
+				common.CDIComponentLabel: common.PreflightPodName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers:            []corev1.Container{*container},
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: pointer.Int64(int64(preflightPodDeadline.Seconds())),
+			NodeSelector:          workloadNodePlacement.NodeSelector,
+			Tolerations:           workloadNodePlacement.Tolerations,
+			Affinity:              workloadNodePlacement.Affinity,
+			PriorityClassName:     dv.Spec.PriorityClassName,
+			ImagePullSecrets:      imagePullSecrets,
+		},
+	}
+	setWorkerPodAnnotations(pod, pvc, getPVCWorkerKey(pvc))
+	addImporterSourceVolumes(pod, podEnvVar, GetImportProxyConfigMapName(pod.Name))
+	cc.SetRestrictedSecurityContext(&pod.Spec)
+	util.SetRecommendedLabels(pod, r.installerLabels, "cdi-controller")
+	if err := controllerutil.SetControllerReference(dv, pod, r.scheme); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// finishPreflight saves the result of the pod once it terminated, or once it is pending past its deadline
+func (r *PreflightReconciler) finishPreflight(ctx context.Context, dv *cdiv1.DataVolume, pod *corev1.Pod) (reconcile.Result, error) {
+	var message string
+	if statuses := pod.Status.ContainerStatuses; len(statuses) > 0 && statuses[0].State.Terminated != nil {
+		message = statuses[0].State.Terminated.Message
+	}
+
+	var result *cc.PreflightResult
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		info, ok := util.ParsePreflightInfo(message)
+		if !ok {
+			return reconcile.Result{}, fmt.Errorf("unexpected preflight pod termination message %q", message)
+		}
+		result = getPreflightSizes(pod, info)
+	case corev1.PodFailed:
+		result = &cc.PreflightResult{Phase: cc.PreflightPhaseFailed, Reason: util.ErrorCodeUnknown, Message: message}
+		if te, ok := util.ParseTerminationError(message); ok {
+			result.Reason = te.Code
+			result.Message = te.Message
+		} else if pod.Status.Reason == podReasonDeadlineExceeded {
+			result = getPreflightDeadlineResult(pod)
+		}
+	case corev1.PodPending:
+		// The active deadline of a pod only starts once it is scheduled
+		if remaining := preflightPodDeadline - time.Since(pod.CreationTimestamp.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+		result = getPreflightDeadlineResult(pod)
+	default:
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.setPreflightResult(ctx, dv, result); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, r.deletePod(ctx, pod)
+}
+
+// getPreflightDeadlineResult fails a dry run whose pod didn't terminate in time, with what the pod is waiting for
+func getPreflightDeadlineResult(pod *corev1.Pod) *cc.PreflightResult {
+	message := fmt.Sprintf("Dry run did not finish within %s", preflightPodDeadline)
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+			message += fmt.Sprintf(", pod not scheduled: %s", cond.Message)
+		}
+	}
+	if statuses := pod.Status.ContainerStatuses; len(statuses) > 0 && statuses[0].State.Waiting != nil {
+		message += fmt.Sprintf(", container waiting: %s", statuses[0].State.Waiting.Reason)
+	}
+	return &cc.PreflightResult{Phase: cc.PreflightPhaseFailed, Reason: preflightReasonDeadlineExceeded, Message: message}
+}
+
+// getPreflightSizes checks the image fits in the requested storage, with the filesystem overhead passed to the pod
+func getPreflightSizes(pod *corev1.Pod, info *util.PreflightInfo) *cc.PreflightResult {
+	result := &cc.PreflightResult{Phase: cc.PreflightPhaseSucceeded, Format: info.Format, VirtualSize: info.VirtualSize}
+	var filesystemOverhead float64
+	var requestedSize resource.Quantity
+	for _, env := range pod.Spec.Containers[0].Env {
+		switch env.Name {
+		case common.FilesystemOverheadVar:
+			filesystemOverhead, _ = strconv.ParseFloat(env.Value, 64)
+		case common.ImporterImageSize:
+			requestedSize, _ = resource.ParseQuantity(env.Value)
+		}
+	}
+	result.RequestedSize = requestedSize.Value()
+	if info.VirtualSize == 0 {
+		return result
+	}
+	result.RequiredSize = cc.GetRequiredSpace(filesystemOverhead, info.VirtualSize)
+	if result.RequestedSize > 0 && result.RequestedSize < result.RequiredSize {
+		result.Phase = cc.PreflightPhaseFailed
+		result.Reason = preflightReasonInsufficientStorage
+		result.Message = fmt.Sprintf("Requested storage %d is smaller than the %d required by the image", result.RequestedSize, result.RequiredSize)
+	}
+	return result
+}
+
+func (r *PreflightReconciler) setPreflightResult(ctx context.Context, dv *cdiv1.DataVolume, result *cc.PreflightResult) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	cc.AddAnnotation(dv, cc.AnnPreflightResult, string(value))
+	if err := r.client.Update(ctx, dv); err != nil {
+		return err
+	}
+	switch result.Phase {
+	case cc.PreflightPhaseSucceeded:
+		r.recorder.Eventf(dv, corev1.EventTypeNormal, PreflightSucceeded, "Dry run succeeded, format: %s, virtual size: %d, required size: %d",
+			result.Format, result.VirtualSize, result.RequiredSize)
+	case cc.PreflightPhaseFailed:
+		r.recorder.Eventf(dv, corev1.EventTypeWarning, PreflightFailed, "Dry run failed, %s: %s", result.Reason, result.Message)
+	}
+	return nil
+}
+
+func (r *PreflightReconciler) pvcExists(ctx context.Context, dv *cdiv1.DataVolume) (bool, error) {
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: dv.Namespace, Name: dv.Name}, &corev1.PersistentVolumeClaim{})
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *PreflightReconciler) deletePod(ctx context.Context, pod *corev1.Pod) error {
+	if pod == nil || pod.DeletionTimestamp != nil {
+		return nil
+	}
+	r.log.V(1).Info("Deleting preflight pod", "pod.Name", pod.Name, "pod.Namespace", pod.Namespace)
+	return cc.IgnoreNotFound(r.client.Delete(ctx, pod))
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+var preflightLog = logf.Log.WithName("preflight-controller-test")
+
+var _ = Describe("Preflight reconcile", func() {
+	var (
+		reconciler *PreflightReconciler
+		dvKey      = types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "testdv"}
+		podKey     = types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "testdv-" + common.PreflightPodName}
+	)
+
+	newDryRunDataVolume := func() *cdiv1.DataVolume {
+		dv := cc.NewImportDataVolume(dvKey.Name)
+		dv.Annotations = map[string]string{cc.AnnDryRun: "true"}
+		dv.Spec.PVC.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}
+		return dv
+	}
+
+	reconcileDataVolume := func() {
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: dvKey})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getResult := func() *cc.PreflightResult {
+		dv := &cdiv1.DataVolume{}
+		Expect(reconciler.client.Get(context.TODO(), dvKey, dv)).To(Succeed())
+		result, err := cc.GetPreflightResult(dv)
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	getPod := func() *corev1.Pod {
+		pod := &corev1.Pod{}
+		Expect(reconciler.client.Get(context.TODO(), podKey, pod)).To(Succeed())
+		return pod
+	}
+
+	terminatePod := func(phase corev1.PodPhase, message string) {
+		pod := getPod()
+		pod.Status.Phase = phase
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+		}}
+		Expect(reconciler.client.Status().Update(context.TODO(), pod)).To(Succeed())
+	}
+
+	expectPodDeleted := func() {
+		err := reconciler.client.Get(context.TODO(), podKey, &corev1.Pod{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	}
+
+	It("Should start a dry run pod without a target volume", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+
+		pod := getPod()
+		Expect(pod.Labels).To(HaveKeyWithValue(common.CDIComponentLabel, common.PreflightPodName))
+		Expect(pod.OwnerReferences).To(HaveLen(1))
+		Expect(pod.OwnerReferences[0].Name).To(Equal(dvKey.Name))
+		Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(pod.Spec.ActiveDeadlineSeconds).To(HaveValue(Equal(int64(preflightPodDeadline.Seconds()))))
+		Expect(pod.Spec.Volumes).To(BeEmpty())
+		Expect(pod.Spec.Containers[0].VolumeMounts).To(BeEmpty())
+		Expect(pod.Spec.Containers[0].VolumeDevices).To(BeEmpty())
+		Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: common.ImporterDryRun, Value: "true"},
+			corev1.EnvVar{Name: common.ImporterSource, Value: cc.SourceHTTP},
+			corev1.EnvVar{Name: common.ImporterEndpoint, Value: "http://example.com/data"},
+		))
+		Expect(getResult().Phase).To(Equal(cc.PreflightPhaseRunning))
+		Expect(reconciler.client.Get(context.TODO(), dvKey, &corev1.PersistentVolumeClaim{})).ToNot(Succeed())
+	})
+
+	It("Should queue the dry run pod within the worker pod limits", func() {
+		dv := newDryRunDataVolume()
+		dv.UID = "dv-uid"
+		reconciler = createPreflightReconciler(dv)
+		cdiConfig := &cdiv1.CDIConfig{}
+		Expect(reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)).To(Succeed())
+		cdiConfig.Spec.WorkerPodLimits = &cdiv1.WorkerPodLimits{PerNamespace: pointer.Int32(1)}
+		Expect(reconciler.client.Update(context.TODO(), cdiConfig)).To(Succeed())
+		importerPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "importer-other",
+				Namespace:   dvKey.Namespace,
+				Labels:      map[string]string{common.CDIComponentLabel: common.ImporterPodName},
+				Annotations: map[string]string{cc.AnnWorkerPVCUID: "other-uid"},
+			},
+		}
+		Expect(reconciler.client.Create(context.TODO(), importerPod)).To(Succeed())
+
+		result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: dvKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(workerQueueRequeueInterval))
+		Expect(reconciler.client.Get(context.TODO(), podKey, &corev1.Pod{})).ToNot(Succeed())
+		Expect(getResult()).To(BeNil())
+
+		Expect(reconciler.client.Delete(context.TODO(), importerPod)).To(Succeed())
+		reconcileDataVolume()
+		pod := getPod()
+		Expect(pod.Annotations).To(HaveKeyWithValue(cc.AnnWorkerPVCUID, "dv-uid"))
+		Expect(reconciler.workerQueue.reservations).To(HaveKey(types.UID("dv-uid")))
+		Expect(getResult().Phase).To(Equal(cc.PreflightPhaseRunning))
+	})
+
+	It("Should report the image information of a dry run which succeeded", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		terminatePod(corev1.PodSucceeded, `{"format":"qcow2","virtualSize":536870912}`)
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseSucceeded))
+		Expect(result.Format).To(Equal("qcow2"))
+		Expect(result.VirtualSize).To(Equal(int64(536870912)))
+		Expect(result.RequiredSize).To(BeNumerically(">=", result.VirtualSize))
+		Expect(result.RequestedSize).To(Equal(int64(1 << 30)))
+		expectPodDeleted()
+		Expect(<-reconciler.recorder.(*record.FakeRecorder).Events).To(ContainSubstring(PreflightSucceeded))
+	})
+
+	It("Should fail a dry run whose image doesn't fit in the requested storage", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		terminatePod(corev1.PodSucceeded, `{"format":"raw","virtualSize":2147483648}`)
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseFailed))
+		Expect(result.Reason).To(Equal(preflightReasonInsufficientStorage))
+		Expect(result.RequiredSize).To(BeNumerically(">", result.RequestedSize))
+		expectPodDeleted()
+	})
+
+	It("Should report the error of a dry run which failed", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		payload, err := json.Marshal(util.NewTerminationError(&util.HTTPStatusError{StatusCode: 401}, "Unable to connect to http data source"))
+		Expect(err).ToNot(HaveOccurred())
+		terminatePod(corev1.PodFailed, string(payload))
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseFailed))
+		Expect(result.Reason).To(Equal(util.ErrorCodeHTTPUnauthorized))
+		Expect(result.Message).To(Equal("Unable to connect to http data source"))
+		expectPodDeleted()
+		Expect(<-reconciler.recorder.(*record.FakeRecorder).Events).To(ContainSubstring(PreflightFailed))
+	})
+
+	It("Should fail a dry run whose pod exceeded its deadline", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		pod := getPod()
+		pod.Status.Phase = corev1.PodFailed
+		pod.Status.Reason = podReasonDeadlineExceeded
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+		}}
+		Expect(reconciler.client.Status().Update(context.TODO(), pod)).To(Succeed())
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseFailed))
+		Expect(result.Reason).To(Equal(preflightReasonDeadlineExceeded))
+		Expect(result.Message).To(ContainSubstring("ImagePullBackOff"))
+		expectPodDeleted()
+	})
+
+	It("Should fail a dry run whose pod stays pending past its deadline", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		pod := getPod()
+		pod.CreationTimestamp = metav1.Now()
+		Expect(reconciler.client.Update(context.TODO(), pod)).To(Succeed())
+		pod.Status.Phase = corev1.PodPending
+		pod.Status.Conditions = []corev1.PodCondition{{
+			Type:    corev1.PodScheduled,
+			Status:  corev1.ConditionFalse,
+			Message: "0/3 nodes are available",
+		}}
+		Expect(reconciler.client.Status().Update(context.TODO(), pod)).To(Succeed())
+
+		By("Requeueing until the deadline")
+		result, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: dvKey})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", preflightPodDeadline))
+		Expect(getResult().Phase).To(Equal(cc.PreflightPhaseRunning))
+
+		By("Failing once the deadline passed")
+		pod = getPod()
+		pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-preflightPodDeadline))
+		Expect(reconciler.client.Update(context.TODO(), pod)).To(Succeed())
+		reconcileDataVolume()
+
+		preflightResult := getResult()
+		Expect(preflightResult.Phase).To(Equal(cc.PreflightPhaseFailed))
+		Expect(preflightResult.Reason).To(Equal(preflightReasonDeadlineExceeded))
+		Expect(preflightResult.Message).To(ContainSubstring("0/3 nodes are available"))
+		expectPodDeleted()
+	})
+
+	It("Should not run a dry run of an unsupported source", func() {
+		dv := newDryRunDataVolume()
+		dv.Spec.Source = &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}}
+		reconciler = createPreflightReconciler(dv)
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseFailed))
+		Expect(result.Reason).To(Equal(preflightReasonNotSupported))
+		expectPodDeleted()
+	})
+
+	It("Should detect the size of an import without storage size", func() {
+		dv := cc.NewImportDataVolume(dvKey.Name)
+		dv.Spec.PVC = nil
+		dv.Spec.Storage = &cdiv1.StorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}}
+		reconciler = createPreflightReconciler(dv)
+		reconcileDataVolume()
+		terminatePod(corev1.PodSucceeded, `{"format":"qcow2","virtualSize":2147483648}`)
+		reconcileDataVolume()
+
+		result := getResult()
+		Expect(result.Phase).To(Equal(cc.PreflightPhaseSucceeded))
+		Expect(result.VirtualSize).To(Equal(int64(2147483648)))
+		Expect(result.RequestedSize).To(BeZero())
+		expectPodDeleted()
+	})
+
+	It("Should not detect the size of an import whose PVC exists", func() {
+		dv := cc.NewImportDataVolume(dvKey.Name)
+		dv.Spec.PVC = nil
+		dv.Spec.Storage = &cdiv1.StorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}}
+		pvc := cc.CreatePvc(dvKey.Name, dvKey.Namespace, nil, nil)
+		reconciler = createPreflightReconciler(dv, pvc)
+		reconcileDataVolume()
+
+		expectPodDeleted()
+		Expect(getResult()).To(BeNil())
+	})
+
+	It("Should clean up once the dry-run annotation is removed", func() {
+		reconciler = createPreflightReconciler(newDryRunDataVolume())
+		reconcileDataVolume()
+		getPod()
+
+		dv := &cdiv1.DataVolume{}
+		Expect(reconciler.client.Get(context.TODO(), dvKey, dv)).To(Succeed())
+		delete(dv.Annotations, cc.AnnDryRun)
+		Expect(reconciler.client.Update(context.TODO(), dv)).To(Succeed())
+		reconcileDataVolume()
+
+		expectPodDeleted()
+		Expect(getResult()).To(BeNil())
+	})
+})
+
+func createPreflightReconciler(objects ...runtime.Object) *PreflightReconciler {
+	objs := []runtime.Object{cc.MakeEmptyCDICR(), cc.MakeEmptyCDIConfigSpec(common.ConfigName)}
+	objs = append(objs, objects...)
+
+	s := scheme.Scheme
+	_ = cdiv1.AddToScheme(s)
+
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithIndex(&corev1.Pod{}, workerPodLimitField, indexWorkerPodLimit).
+		WithIndex(&corev1.PersistentVolumeClaim{}, workerQueuedField, indexWorkerQueued).
+		Build()
+	return &PreflightReconciler{
+		client:     cl,
+		scheme:     s,
+		log:        preflightLog,
+		recorder:   record.NewFakeRecorder(10),
+		image:      testImage,
+		verbose:    "5",
+		pullPolicy: string(corev1.PullIfNotPresent),
+		installerLabels: map[string]string{
+			common.AppKubernetesPartOfLabel:  "testing",
+			common.AppKubernetesVersionLabel: "v0.0.0-tests",
+		},
+		workerQueue: newWorkerQueue(),
+		importReconciler: &ImportReconciler{
+			client:         cl,
+			uncachedClient: cl,
+			log:            preflightLog,
+		},
+	}
+}
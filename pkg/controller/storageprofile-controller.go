@@ -105,6 +105,7 @@ func (r *StorageProfileReconciler) reconcileStorageProfile(sc *storagev1.Storage
 	}
 	storageProfile.Status.CloneStrategy = r.reconcileCloneStrategy(sc, storageProfile.Spec.CloneStrategy, snapClass)
 	storageProfile.Status.DataImportCronSourceFormat = r.reconcileDataImportCronSourceFormat(sc, storageProfile.Spec.DataImportCronSourceFormat, snapClass)
+	storageProfile.Status.CloneBandwidthLimit = storageProfile.Spec.CloneBandwidthLimit
 
 	var claimPropertySets []cdiv1.ClaimPropertySet
 
@@ -359,6 +359,31 @@ var _ = Describe("Storage profile controller reconcile loop", func() {
 		Expect(*sp.Status.SnapshotClass).To(Equal(snapName))
 	})
 
+	It("Should set the clone bandwidth limit status from the spec", func() {
+		storageClass := CreateStorageClassWithProvisioner(storageClassName, nil, nil, cephProvisioner)
+		reconciler = createStorageProfileReconciler(storageClass)
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: storageClassName}})
+		Expect(err).ToNot(HaveOccurred())
+
+		sp := &cdiv1.StorageProfile{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: storageClassName}, sp, &client.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.Status.CloneBandwidthLimit).To(BeNil())
+
+		limit := resource.MustParse("100Mi")
+		sp.Spec.CloneBandwidthLimit = &limit
+		err = reconciler.client.Update(context.TODO(), sp, &client.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: storageClassName}})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: storageClassName}, sp, &client.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.Status.CloneBandwidthLimit).ToNot(BeNil())
+		Expect(sp.Status.CloneBandwidthLimit.Value()).To(Equal(int64(100 * 1024 * 1024)))
+	})
+
 	It("Should error when updating storage profile with non-existing SnapshotClass", func() {
 		storageClass := CreateStorageClassWithProvisioner(storageClassName, nil, nil, cephProvisioner)
 		reconciler = createStorageProfileReconciler(storageClass, createVolumeSnapshotContentCrd(), createVolumeSnapshotClassCrd(), createVolumeSnapshotCrd())
@@ -91,6 +91,7 @@ type UploadReconciler struct {
 	clientCAFetcher        fetcher.CertBundleFetcher
 	featureGates           featuregates.FeatureGates
 	installerLabels        map[string]string
+	workerQueue            *workerQueue
 }
 
 // UploadPodArgs are the parameters required to create an upload pod
@@ -167,6 +168,7 @@ func (r *UploadReconciler) shouldReconcile(isUpload bool, isCloneTarget bool, pv
 
 func (r *UploadReconciler) reconcilePVC(log logr.Logger, pvc *corev1.PersistentVolumeClaim, isCloneTarget bool) (reconcile.Result, error) {
 	var uploadClientName string
+	requiresScratch := !isCloneTarget
 	pvcCopy := pvc.DeepCopy()
 	anno := pvcCopy.Annotations
 
@@ -186,7 +188,12 @@ func (r *UploadReconciler) reconcilePVC(log logr.Logger, pvc *corev1.PersistentV
 		}
 
 		uploadClientName = fmt.Sprintf("%s/%s-%s/%s", source.Namespace, source.Name, pvc.Namespace, pvc.Name)
+		if group := pvc.Annotations[cc.AnnCloneFanOutGroup]; group != "" {
+			// all targets of a fan-out group accept the same source pod
+			uploadClientName = fmt.Sprintf("%s/%s-%s/fan-out/%s", source.Namespace, source.Name, pvc.Namespace, group)
+		}
 		anno[AnnUploadClientName] = uploadClientName
+		requiresScratch = cloneRequiresConversion(source, pvc)
 	} else {
 		uploadClientName = uploadServerClientName
 	}
@@ -219,7 +226,7 @@ func (r *UploadReconciler) reconcilePVC(log logr.Logger, pvc *corev1.PersistentV
 		}
 
 		podName, ok := pvc.Annotations[AnnUploadPod]
-		scratchPVCName := createScratchPvcNameFromPvc(pvc, isCloneTarget)
+		scratchPVCName := createScratchPvcNameFromPvc(pvc, requiresScratch)
 
 		if !ok {
 			podName = createUploadResourceName(pvc.Name)
@@ -228,6 +235,17 @@ func (r *UploadReconciler) reconcilePVC(log logr.Logger, pvc *corev1.PersistentV
 			}
 			return reconcile.Result{Requeue: true}, nil
 		}
+		// An upload pod idles until the client sends the data, only the transfer of a host-assisted clone is queued
+		if isCloneTarget {
+			admitted, err := r.workerQueue.queueWorkerPod(context.TODO(), r.client, r.recorder, pvcCopy)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if !admitted {
+				log.V(1).Info("Upload pod is queued due to the worker pod limits")
+				return reconcile.Result{RequeueAfter: workerQueueRequeueInterval}, nil
+			}
+		}
 		pod, err = r.createUploadPodForPvc(pvc, podName, scratchPVCName, uploadClientName)
 		if err != nil {
 			return reconcile.Result{}, err
@@ -595,6 +613,9 @@ func (r *UploadReconciler) createUploadPod(args UploadPodArgs) (*v1.Pod, error)
 
 	pod := r.makeUploadPodSpec(args, podResourceRequirements, imagePullSecrets, workloadNodePlacement)
 	util.SetRecommendedLabels(pod, r.installerLabels, "cdi-controller")
+	if _, isCloneTarget := args.PVC.Annotations[cc.AnnCloneRequest]; isCloneTarget {
+		setWorkerPodAnnotations(pod, args.PVC, getPVCWorkerKey(args.PVC))
+	}
 
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: args.Name, Namespace: ns}, pod); err != nil {
 		if !k8serrors.IsNotFound(err) {
@@ -660,6 +681,7 @@ func NewUploadController(mgr manager.Manager, log logr.Logger, uploadImage, pull
 		clientCAFetcher:     clientCAFetcher,
 		featureGates:        featuregates.NewFeatureGates(client),
 		installerLabels:     installerLabels,
+		workerQueue:         defaultWorkerQueue,
 	}
 	uploadController, err := controller.New("upload-controller", mgr, controller.Options{
 		MaxConcurrentReconciles: 3,
@@ -696,14 +718,21 @@ func addUploadControllerWatches(mgr manager.Manager, uploadController controller
 	return nil
 }
 
-func createScratchPvcNameFromPvc(pvc *v1.PersistentVolumeClaim, isCloneTarget bool) string {
-	if isCloneTarget {
+func createScratchPvcNameFromPvc(pvc *v1.PersistentVolumeClaim, requiresScratch bool) string {
+	if !requiresScratch {
 		return ""
 	}
 
 	return naming.GetResourceName(pvc.Name, common.ScratchNameSuffix)
 }
 
+// cloneRequiresConversion returns true if the disk image of a filesystem clone source may need
+// to be converted to raw for a block target, which requires scratch space
+func cloneRequiresConversion(source, target *v1.PersistentVolumeClaim) bool {
+	return util.ResolveVolumeMode(source.Spec.VolumeMode) == v1.PersistentVolumeFilesystem &&
+		util.ResolveVolumeMode(target.Spec.VolumeMode) == v1.PersistentVolumeBlock
+}
+
 // getUploadResourceName returns the name given to upload resources
 func getUploadResourceNameFromPvc(pvc *corev1.PersistentVolumeClaim) string {
 	podName, ok := pvc.Annotations[AnnUploadPod]
@@ -33,12 +33,14 @@ import (
 
 	ocpconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"kubevirt.io/containerized-data-importer/pkg/common"
@@ -277,6 +279,28 @@ var _ = Describe("Upload controller reconcile loop", func() {
 		Expect(or.Name).To(Equal(uploadPod.Name))
 		Expect(or.UID).To(Equal(uploadPod.UID))
 	})
+
+	DescribeTable("Should create scratch space for a clone pvc only if the disk image may need conversion", func(sourceVolumeMode, targetVolumeMode corev1.PersistentVolumeMode, expectScratch bool) {
+		testPvc := cc.CreatePvc("testPvc1", "default", map[string]string{cc.AnnCloneRequest: "default/testPvc2", AnnUploadPod: createUploadResourceName("testPvc1")}, nil)
+		testPvc.Spec.VolumeMode = &targetVolumeMode
+		testPvcSource := cc.CreatePvc("testPvc2", "default", map[string]string{}, nil)
+		testPvcSource.Spec.VolumeMode = &sourceVolumeMode
+		reconciler := createUploadReconciler(testPvc, testPvcSource)
+
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "testPvc1", Namespace: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		scratchPvc := &corev1.PersistentVolumeClaim{}
+		err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: naming.GetResourceName("testPvc1", common.ScratchNameSuffix), Namespace: "default"}, scratchPvc)
+		if expectScratch {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		}
+	},
+		Entry("filesystem to block", corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeBlock, true),
+		Entry("block to filesystem", corev1.PersistentVolumeBlock, corev1.PersistentVolumeFilesystem, false),
+		Entry("filesystem to filesystem", corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeFilesystem, false),
+	)
 })
 
 var _ = Describe("reconcilePVC loop", func() {
@@ -408,11 +432,105 @@ var _ = Describe("reconcilePVC loop", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("uploader service not controlled by pvc testPvc1"))
 		})
+		It("Should queue the upload pod of a clone on the storage class worker pod limit", func() {
+			storageClassName := "test-sc"
+			testPvc := cc.CreatePvcInStorageClass(testPvcName, "default", &storageClassName,
+				map[string]string{cc.AnnCloneRequest: "source/testPvc2", AnnUploadPod: uploadResourceName}, nil, corev1.ClaimBound)
+			testPvc.UID = "target-uid"
+			testPvcSource := cc.CreatePvc("testPvc2", "source", map[string]string{}, nil)
+			workerPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "importer-other",
+					Namespace:   "other",
+					Annotations: map[string]string{cc.AnnWorkerPVCUID: "other-uid", cc.AnnWorkerStorageClass: storageClassName},
+					Labels:      map[string]string{common.CDILabelKey: common.CDILabelValue, common.CDIComponentLabel: common.ImporterPodName},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			reconciler := createUploadReconciler(testPvc, testPvcSource, workerPod)
+			setUploadWorkerPodLimits(reconciler, &cdiv1.WorkerPodLimits{PerStorageClass: pointer.Int32(1)})
+
+			result, err := reconciler.reconcilePVC(reconciler.log, testPvc, isClone)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(workerQueueRequeueInterval))
+			uploadPod := &corev1.Pod{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: uploadResourceName, Namespace: "default"}, uploadPod)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			resultPvc := &corev1.PersistentVolumeClaim{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: testPvcName, Namespace: "default"}, resultPvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resultPvc.Annotations[cc.AnnRunningConditionReason]).To(Equal(common.WorkerQueued))
+			Expect(resultPvc.Annotations[cc.AnnRunningConditionMessage]).To(Equal("Queued at position 1, storage class test-sc reached the limit of 1 worker pods"))
+
+			By("Creating the upload pod once the worker pod completes")
+			workerPod.Status.Phase = corev1.PodSucceeded
+			err = reconciler.client.Status().Update(context.TODO(), workerPod)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = reconciler.reconcilePVC(reconciler.log, resultPvc, isClone)
+			Expect(err).ToNot(HaveOccurred())
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: uploadResourceName, Namespace: "default"}, uploadPod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploadPod.Annotations[cc.AnnWorkerPVCUID]).To(Equal("target-uid"))
+			Expect(uploadPod.Annotations[cc.AnnWorkerStorageClass]).To(Equal(storageClassName))
+		})
+
+		It("Should reserve a worker pod for the clone source pod", func() {
+			testPvc := cc.CreatePvc(testPvcName, "default", map[string]string{cc.AnnCloneRequest: "source/testPvc2", AnnUploadPod: uploadResourceName}, nil)
+			testPvc.UID = "target-uid"
+			testPvcSource := cc.CreatePvc("testPvc2", "source", map[string]string{}, nil)
+			reconciler := createUploadReconciler(testPvc, testPvcSource)
+			setUploadWorkerPodLimits(reconciler, &cdiv1.WorkerPodLimits{PerNamespace: pointer.Int32(1)})
+			_, err := reconciler.reconcilePVC(reconciler.log, testPvc, isClone)
+			Expect(err).ToNot(HaveOccurred())
+
+			importPvc := cc.CreatePvc("import", "source", map[string]string{cc.AnnEndpoint: "http://example.com/disk.img"}, nil)
+			importPvc.UID = "import-uid"
+			admitted, msg, err := reconciler.workerQueue.admitWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, importPvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+			Expect(msg).To(Equal("Queued at position 1, namespace source reached the limit of 1 worker pods"))
+
+			By("Counting the clone source pod once it is observed")
+			sourcePod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "target-uid-source-pod",
+					Namespace:   "source",
+					Annotations: map[string]string{cc.AnnWorkerPVCUID: "target-uid"},
+					Labels:      map[string]string{common.CDILabelKey: common.CDILabelValue, common.CDIComponentLabel: common.ClonerSourcePodName},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			Expect(reconciler.client.Create(context.TODO(), sourcePod)).To(Succeed())
+			admitted, _, err = reconciler.workerQueue.admitWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, importPvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+			Expect(reconciler.workerQueue.reservations[testPvc.UID]).ToNot(HaveKey(common.ClonerSourcePodName))
+
+			sourcePod.Status.Phase = corev1.PodSucceeded
+			Expect(reconciler.client.Status().Update(context.TODO(), sourcePod)).To(Succeed())
+			admitted, _, err = reconciler.workerQueue.admitWorkerPod(context.TODO(), reconciler.client, reconciler.recorder, importPvc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+		})
 	})
 
 	Context("Is upload", func() {
 		isClone := false
 
+		It("Should not queue nor count the upload pod, which idles until the client sends the data", func() {
+			testPvc := cc.CreatePvc(testPvcName, "default", map[string]string{cc.AnnUploadRequest: "", AnnUploadPod: uploadResourceName}, nil)
+			reconciler := createUploadReconciler(testPvc)
+			setUploadWorkerPodLimits(reconciler, &cdiv1.WorkerPodLimits{Global: pointer.Int32(0)})
+
+			_, err := reconciler.reconcilePVC(reconciler.log, testPvc, isClone)
+			Expect(err).ToNot(HaveOccurred())
+			uploadPod := &corev1.Pod{}
+			err = reconciler.client.Get(context.TODO(), types.NamespacedName{Name: uploadResourceName, Namespace: "default"}, uploadPod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploadPod.Annotations).ToNot(HaveKey(cc.AnnWorkerPVCUID))
+			Expect(indexWorkerPodLimit(uploadPod)).To(BeEmpty())
+		})
+
 		It("Should create the service and pod", func() {
 			testPvc := cc.CreatePvc(testPvcName, "default", map[string]string{cc.AnnUploadRequest: "", AnnUploadPod: uploadResourceName}, nil)
 			reconciler := createUploadReconciler(testPvc)
@@ -602,6 +720,15 @@ var _ = Describe("updateUploadAnnotations", func() {
 	})
 })
 
+func setUploadWorkerPodLimits(reconciler *UploadReconciler, limits *cdiv1.WorkerPodLimits) {
+	cdiConfig := &cdiv1.CDIConfig{}
+	err := reconciler.client.Get(context.TODO(), types.NamespacedName{Name: common.ConfigName}, cdiConfig)
+	Expect(err).ToNot(HaveOccurred())
+	cdiConfig.Spec.WorkerPodLimits = limits
+	err = reconciler.client.Update(context.TODO(), cdiConfig)
+	Expect(err).ToNot(HaveOccurred())
+}
+
 func createUploadReconciler(objects ...runtime.Object) *UploadReconciler {
 	objs := []runtime.Object{}
 	objs = append(objs, objects...)
@@ -619,7 +746,10 @@ func createUploadReconciler(objects ...runtime.Object) *UploadReconciler {
 	_ = cdiv1.AddToScheme(s)
 
 	// Create a fake client to mock API calls.
-	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithIndex(&corev1.Pod{}, workerPodLimitField, indexWorkerPodLimit).
+		WithIndex(&corev1.PersistentVolumeClaim{}, workerQueuedField, indexWorkerQueued).
+		Build()
 
 	rec := record.NewFakeRecorder(10)
 
@@ -636,6 +766,7 @@ func createUploadReconciler(objects ...runtime.Object) *UploadReconciler {
 			common.AppKubernetesPartOfLabel:  "testing",
 			common.AppKubernetesVersionLabel: "v0.0.0-tests",
 		},
+		workerQueue: newWorkerQueue(),
 	}
 	return r
 }
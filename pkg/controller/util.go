@@ -30,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -52,9 +53,21 @@ const (
 	// SecretVolName is the name of the volume containing gcs key
 	SecretVolName = "cdi-secret-vol"
 
+	// scratchSizeMarginPercent is the margin added to the scratch size estimated by the importer
+	scratchSizeMarginPercent = 10
+
 	// AnnOwnerRef is used when owner is in a different namespace
 	AnnOwnerRef = cc.AnnAPIGroup + "/storage.ownerRef"
 
+	// AnnCloneFanOutTargets is the comma separated list of clone targets (namespace/name) populated by a fan-out source pod
+	AnnCloneFanOutTargets = cc.AnnAPIGroup + "/storage.clone.fanOutTargets"
+
+	// AnnCloneBandwidthLimit is the bandwidth limit, in bytes per second, of a clone source pod
+	AnnCloneBandwidthLimit = cc.AnnAPIGroup + "/storage.clone.bandwidthLimit"
+
+	// CloneLimitsVolName is the name of the volume exposing the clone source pod limits
+	CloneLimitsVolName = "cdi-clone-limits-vol"
+
 	// PodRunningReason is const that defines the pod was started as a reason
 	PodRunningReason = "Pod is running"
 
@@ -81,6 +94,14 @@ const (
 
 var (
 	vddkInfoMatch = regexp.MustCompile(`((.*; )|^)VDDK: (?P<info>{.*})`)
+	// scratchSizeEstimateMatch extracts the scratch size estimated by the importer from its exit message
+	scratchSizeEstimateMatch = regexp.MustCompile(common.ScratchSizeEstimate + `: (\d+)`)
+	// sparsifyUnmappedMatch extracts the size of the zero ranges the importer deallocated from its exit message
+	sparsifyUnmappedMatch = regexp.MustCompile(common.SparsifyUnmapped + `: (\d+)`)
+	// sparsifyReclaimedMatch extracts the allocated space the importer reclaimed when sparsifying the target from its exit message
+	sparsifyReclaimedMatch = regexp.MustCompile(common.SparsifyReclaimed + `: (\d+)`)
+	// minScratchSize is the smallest scratch PVC sized from an estimate
+	minScratchSize = resource.MustParse("1Gi")
 )
 
 func checkPVC(pvc *v1.PersistentVolumeClaim, annotation string, log logr.Logger) bool {
@@ -169,6 +190,9 @@ func newScratchPersistentVolumeClaimSpec(pvc *v1.PersistentVolumeClaim, pod *v1.
 // createScratchPersistentVolumeClaim creates and returns a pointer to a scratch PVC which is created based on the passed-in pvc and storage class name.
 func createScratchPersistentVolumeClaim(client client.Client, pvc *v1.PersistentVolumeClaim, pod *v1.Pod, name, storageClassName string, installerLabels map[string]string, recorder record.EventRecorder) (*v1.PersistentVolumeClaim, error) {
 	scratchPvcSpec := newScratchPersistentVolumeClaimSpec(pvc, pod, name, storageClassName)
+	if err := sizeScratchFromEstimate(client, pvc, scratchPvcSpec); err != nil {
+		return nil, err
+	}
 	util.SetRecommendedLabels(scratchPvcSpec, installerLabels, "cdi-controller")
 	if err := client.Create(context.TODO(), scratchPvcSpec); err != nil {
 		if cc.ErrQuotaExceeded(err) {
@@ -187,6 +211,30 @@ func createScratchPersistentVolumeClaim(client client.Client, pvc *v1.Persistent
 	return scratchPvc, nil
 }
 
+// sizeScratchFromEstimate sizes the scratch PVC from the scratch size estimated by the importer plus a margin, when
+// smaller than the target. An import which fills the estimated scratch space is retried with a full size one.
+func sizeScratchFromEstimate(c client.Client, pvc, scratchPvc *v1.PersistentVolumeClaim) error {
+	estimate, err := strconv.ParseInt(pvc.Annotations[cc.AnnScratchSizeEstimate], 10, 64)
+	if err != nil || estimate <= 0 {
+		return nil
+	}
+	size, err := cc.InflateSizeWithOverhead(context.TODO(), c, estimate+estimate*scratchSizeMarginPercent/100, &scratchPvc.Spec)
+	if err != nil {
+		return err
+	}
+	if size.Cmp(minScratchSize) < 0 {
+		size = minScratchSize
+	}
+	if requested, ok := scratchPvc.Spec.Resources.Requests[v1.ResourceStorage]; ok && size.Cmp(requested) >= 0 {
+		return nil
+	}
+	klog.V(1).Infof("Sizing scratch PVC %s/%s to %s from the estimated %d bytes", scratchPvc.Namespace, scratchPvc.Name, size.String(), estimate)
+	scratchPvc.Spec.Resources = v1.ResourceRequirements{
+		Requests: v1.ResourceList{v1.ResourceStorage: size},
+	}
+	return nil
+}
+
 // GetFilesystemOverhead determines the filesystem overhead defined in CDIConfig for this PVC's volumeMode and storageClass.
 func GetFilesystemOverhead(ctx context.Context, client client.Client, pvc *v1.PersistentVolumeClaim) (cdiv1.Percent, error) {
 	if cc.GetVolumeMode(pvc) != v1.PersistentVolumeFilesystem {
@@ -298,21 +346,35 @@ func setAnnotationsFromPodWithPrefix(anno map[string]string, pod *v1.Pod, prefix
 			anno[prefix+".message"] = simplifyKnownMessage(containerState.Waiting.Message)
 			anno[prefix+".reason"] = containerState.Waiting.Reason
 		} else if containerState.Terminated != nil {
-			anno[prefix+".message"] = simplifyKnownMessage(containerState.Terminated.Message)
-			reason := containerState.Terminated.Reason
-			if reason == common.GenericError {
-				reason = handleGenericErrorReason(containerState.Terminated.Message)
+			if te, ok := util.ParseTerminationError(containerState.Terminated.Message); ok {
+				anno[prefix+".message"] = simplifyKnownMessage(te.Message)
+				anno[prefix+".reason"] = te.Code
+			} else if result, ok := util.ParseCloneSourceResult(containerState.Terminated.Message); ok {
+				anno[prefix+".message"] = result.Message
+				anno[prefix+".reason"] = containerState.Terminated.Reason
+			} else {
+				anno[prefix+".message"] = simplifyKnownMessage(containerState.Terminated.Message)
+				reason := containerState.Terminated.Reason
+				if reason == common.GenericError {
+					reason = handleGenericErrorReason(containerState.Terminated.Message)
+				}
+				anno[prefix+".reason"] = reason
 			}
-			anno[prefix+".reason"] = reason
 			if strings.Contains(containerState.Terminated.Message, common.PreallocationApplied) {
 				anno[cc.AnnPreallocationApplied] = "true"
 			}
+			if match := sparsifyUnmappedMatch.FindStringSubmatch(containerState.Terminated.Message); match != nil {
+				anno[cc.AnnSparsifyUnmapped] = match[1]
+			}
+			if match := sparsifyReclaimedMatch.FindStringSubmatch(containerState.Terminated.Message); match != nil {
+				anno[cc.AnnSparsifyReclaimed] = match[1]
+			}
 		}
 	}
 }
 
 func handleGenericErrorReason(message string) string {
-	if strings.Contains(message, common.ScratchSpaceRequired) {
+	if strings.Contains(message, common.ScratchSpaceRequired) || strings.Contains(message, common.ScratchSpaceTooSmall) {
 		// Sometimes the pod will need scratch space to complete some operations.
 		// Better to add a custom reason instead of a generic container state.
 		return ScratchSpaceRequiredReason
@@ -321,6 +383,10 @@ func handleGenericErrorReason(message string) string {
 }
 
 func simplifyKnownMessage(msg string) string {
+	if strings.Contains(msg, common.ScratchSpaceRequired) {
+		// Drop the estimated scratch size
+		return common.ScratchSpaceRequired
+	}
 	if strings.Contains(msg, "is larger than the reported available") ||
 		strings.Contains(msg, "no space left on device") ||
 		strings.Contains(msg, "file largest block is bigger than maxblock") {
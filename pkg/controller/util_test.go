@@ -214,6 +214,54 @@ var _ = Describe("setAnnotationsFromPod", func() {
 		Expect(result[AnnPreallocationApplied]).To(Equal("true"))
 	})
 
+	DescribeTable("Should set the bytes deallocated by the sparsify phase", func(message, unmapped string, reclaimedKnown bool) {
+		result := make(map[string]string)
+		testPod := CreateImporterTestPod(CreatePvc("test", metav1.NamespaceDefault, nil, nil), "test", nil)
+		testPod.Status = v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					State: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{
+							Message: message,
+							Reason:  "Completed",
+						},
+					},
+				},
+			},
+		}
+		setAnnotationsFromPodWithPrefix(result, testPod, AnnRunningCondition)
+		Expect(result[AnnSparsifyUnmapped]).To(Equal(unmapped))
+		if reclaimedKnown {
+			Expect(result[AnnSparsifyReclaimed]).To(Equal("1048576"))
+		} else {
+			Expect(result).ToNot(HaveKey(AnnSparsifyReclaimed))
+		}
+	},
+		Entry("of a file", "Import Complete, "+common.SparsifyUnmapped+": 2097152, "+common.SparsifyReclaimed+": 1048576", "2097152", true),
+		Entry("of a block device, whose reclaimed space is unknown", "Import Complete, "+common.SparsifyUnmapped+": 2097152", "2097152", false),
+	)
+
+	It("Should set the message and preallocation from the result of a fan-out clone", func() {
+		result := make(map[string]string)
+		testPod := CreateImporterTestPod(CreatePvc("test", metav1.NamespaceDefault, nil, nil), "test", nil)
+		testPod.Status = v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					State: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{
+							Message: `{"message":"Clone Complete, ` + common.PreallocationApplied + `","failedCount":1,"failedTargets":["uid-target2"]}`,
+							Reason:  "Completed",
+						},
+					},
+				},
+			},
+		}
+		setAnnotationsFromPodWithPrefix(result, testPod, AnnSourceRunningCondition)
+		Expect(result[AnnSourceRunningConditionMessage]).To(Equal("Clone Complete, " + common.PreallocationApplied))
+		Expect(result[AnnSourceRunningConditionReason]).To(Equal("Completed"))
+		Expect(result[AnnPreallocationApplied]).To(Equal("true"))
+	})
+
 	It("Should handle generic error when msg is scratch space required", func() {
 		result := make(map[string]string)
 		testPod := CreateImporterTestPod(CreatePvc("test", metav1.NamespaceDefault, nil, nil), "test", nil)
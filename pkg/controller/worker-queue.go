@@ -0,0 +1,628 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	cc "kubevirt.io/containerized-data-importer/pkg/controller/common"
+)
+
+const (
+	// workerQueueRequeueInterval is how often a queued PVC is reconciled to check whether its worker pod can be created
+	workerQueueRequeueInterval = 10 * time.Second
+
+	// workerReservationTimeout is how long an admitted worker pod is counted before it is observed, in case its creation failed
+	workerReservationTimeout = 5 * time.Minute
+
+	// WorkerPodPreempted provides a const to indicate an importer pod was preempted by a higher priority PVC
+	WorkerPodPreempted = "WorkerPodPreempted"
+
+	workerPodLimitField = "workerPodLimit"
+	workerQueuedField   = "workerQueued"
+)
+
+// defaultWorkerQueue is shared by the import, upload and preflight controllers, so they don't admit worker pods beyond the limits together
+var defaultWorkerQueue = newWorkerQueue()
+
+// workerLimit identifies which of the worker pod limits prevents a worker from starting
+type workerLimit int
+
+const (
+	workerLimitNone workerLimit = iota
+	workerLimitGlobal
+	workerLimitNamespace
+	workerLimitStorageClass
+	workerLimitSourceHost
+)
+
+var workerLimits = []workerLimit{workerLimitGlobal, workerLimitNamespace, workerLimitStorageClass, workerLimitSourceHost}
+
+// indexValue returns the value the worker pods counted in the limit which applies to the key are indexed by,
+// or an empty string if the limit doesn't apply
+func (l workerLimit) indexValue(key workerKey) string {
+	switch l {
+	case workerLimitGlobal:
+		return "global"
+	case workerLimitNamespace:
+		return "namespace/" + key.namespace
+	case workerLimitStorageClass:
+		if key.storageClass != "" {
+			return "storageClass/" + key.storageClass
+		}
+	case workerLimitSourceHost:
+		if key.sourceHost != "" {
+			return "sourceHost/" + key.sourceHost
+		}
+	}
+	return ""
+}
+
+func (l workerLimit) max(limits *cdiv1.WorkerPodLimits) *int32 {
+	switch l {
+	case workerLimitGlobal:
+		return limits.Global
+	case workerLimitNamespace:
+		return limits.PerNamespace
+	case workerLimitStorageClass:
+		return limits.PerStorageClass
+	case workerLimitSourceHost:
+		return limits.PerSourceHost
+	}
+	return nil
+}
+
+func (l workerLimit) reason(key workerKey, max int32) string {
+	switch l {
+	case workerLimitGlobal:
+		return fmt.Sprintf("the cluster reached the limit of %d worker pods", max)
+	case workerLimitNamespace:
+		return fmt.Sprintf("namespace %s reached the limit of %d worker pods", key.namespace, max)
+	case workerLimitStorageClass:
+		return fmt.Sprintf("storage class %s reached the limit of %d worker pods", key.storageClass, max)
+	case workerLimitSourceHost:
+		return fmt.Sprintf("source host %s reached the limit of %d worker pods", key.sourceHost, max)
+	}
+	return ""
+}
+
+// workerKey is what a worker pod is counted by in the worker pod limits
+type workerKey struct {
+	namespace    string
+	storageClass string
+	sourceHost   string
+}
+
+// worker is a worker pod a PVC needs, identified by its component label
+type worker struct {
+	component string
+	key       workerKey
+}
+
+// workerReservation is an admitted worker pod which is not observed yet
+type workerReservation struct {
+	key     workerKey
+	expires time.Time
+}
+
+// workerQueue serializes the admission of worker pods, and counts the admitted ones until they are observed,
+// since a just created worker pod is not in the cache yet
+type workerQueue struct {
+	mutex        sync.Mutex
+	reservations map[types.UID]map[string]workerReservation
+}
+
+func newWorkerQueue() *workerQueue {
+	return &workerQueue{reservations: make(map[types.UID]map[string]workerReservation)}
+}
+
+func (q *workerQueue) reserve(uid types.UID, workers []worker) {
+	reservations := make(map[string]workerReservation)
+	for _, w := range workers {
+		reservations[w.component] = workerReservation{key: w.key, expires: time.Now().Add(workerReservationTimeout)}
+	}
+	q.reservations[uid] = reservations
+}
+
+// release drops the reservation of an observed worker pod, returning its key
+func (q *workerQueue) release(uid types.UID, component string) (workerKey, bool) {
+	reservation, ok := q.reservations[uid][component]
+	if !ok {
+		return workerKey{}, false
+	}
+	delete(q.reservations[uid], component)
+	if len(q.reservations[uid]) == 0 {
+		delete(q.reservations, uid)
+	}
+	return reservation.key, true
+}
+
+func (q *workerQueue) dropExpiredReservations() {
+	now := time.Now()
+	for uid, reservations := range q.reservations {
+		for component, reservation := range reservations {
+			if now.After(reservation.expires) {
+				delete(reservations, component)
+			}
+		}
+		if len(reservations) == 0 {
+			delete(q.reservations, uid)
+		}
+	}
+}
+
+// workerCounts counts the worker pods and the reserved or simulated workers in each limit.
+// The worker pods are listed from the index of a limit the first time it is checked.
+type workerCounts struct {
+	ctx    context.Context
+	client client.Client
+	queue  *workerQueue
+	pods   map[string][]*corev1.Pod
+	added  map[string]int
+}
+
+func newWorkerCounts(ctx context.Context, c client.Client, q *workerQueue) *workerCounts {
+	counts := &workerCounts{
+		ctx:    ctx,
+		client: c,
+		queue:  q,
+		pods:   make(map[string][]*corev1.Pod),
+		added:  make(map[string]int),
+	}
+	for _, reservations := range q.reservations {
+		for _, reservation := range reservations {
+			counts.add(reservation.key)
+		}
+	}
+	return counts
+}
+
+func (c *workerCounts) add(key workerKey) {
+	for _, limit := range workerLimits {
+		if value := limit.indexValue(key); value != "" {
+			c.added[value]++
+		}
+	}
+}
+
+func (c *workerCounts) remove(key workerKey) {
+	for _, limit := range workerLimits {
+		if value := limit.indexValue(key); value != "" {
+			c.added[value]--
+		}
+	}
+}
+
+// list returns the worker pods counted in the limit with the index value, releasing the reservations of the observed pods
+func (c *workerCounts) list(value string) ([]*corev1.Pod, error) {
+	if pods, ok := c.pods[value]; ok {
+		return pods, nil
+	}
+	podList := &corev1.PodList{}
+	if err := c.client.List(c.ctx, podList, client.MatchingFields{workerPodLimitField: value}); err != nil {
+		return nil, err
+	}
+	pods := make([]*corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if key, ok := c.queue.release(types.UID(pod.Annotations[cc.AnnWorkerPVCUID]), pod.Labels[common.CDIComponentLabel]); ok {
+			c.remove(key)
+		}
+		pods = append(pods, pod)
+	}
+	c.pods[value] = pods
+	return pods, nil
+}
+
+func (c *workerCounts) count(value string) (int, error) {
+	pods, err := c.list(value)
+	if err != nil {
+		return 0, err
+	}
+	return len(pods) + c.added[value], nil
+}
+
+// exceededLimit returns the limit preventing the workers from starting together and the reason,
+// or workerLimitNone if they can start
+func (c *workerCounts) exceededLimit(limits *cdiv1.WorkerPodLimits, workers []worker) (workerLimit, string, error) {
+	for _, limit := range workerLimits {
+		max := limit.max(limits)
+		if max == nil {
+			continue
+		}
+		needed := make(map[string]int)
+		for _, w := range workers {
+			value := limit.indexValue(w.key)
+			if value == "" {
+				continue
+			}
+			needed[value]++
+			count, err := c.count(value)
+			if err != nil {
+				return workerLimitNone, "", err
+			}
+			if count+needed[value] > int(*max) {
+				return limit, limit.reason(w.key, *max), nil
+			}
+		}
+	}
+	return workerLimitNone, "", nil
+}
+
+// workerPriorities resolves the priority of PVCs and worker pods from their priority class
+type workerPriorities struct {
+	classes      map[string]*schedulingv1.PriorityClass
+	defaultClass *schedulingv1.PriorityClass
+}
+
+func getWorkerPriorities(ctx context.Context, c client.Client) (*workerPriorities, error) {
+	classList := &schedulingv1.PriorityClassList{}
+	if err := c.List(ctx, classList); err != nil {
+		return nil, err
+	}
+	p := &workerPriorities{classes: make(map[string]*schedulingv1.PriorityClass)}
+	for i := range classList.Items {
+		class := &classList.Items[i]
+		p.classes[class.Name] = class
+		if class.GlobalDefault {
+			p.defaultClass = class
+		}
+	}
+	return p, nil
+}
+
+// getClass returns the priority class with the given name, or the global default class, like the priority admission does
+func (p *workerPriorities) getClass(name string) *schedulingv1.PriorityClass {
+	if name == "" {
+		return p.defaultClass
+	}
+	return p.classes[name]
+}
+
+func (p *workerPriorities) getPVCPriority(pvc *corev1.PersistentVolumeClaim) int32 {
+	if class := p.getClass(cc.GetPriorityClass(pvc)); class != nil {
+		return class.Value
+	}
+	return 0
+}
+
+func (p *workerPriorities) getPodPriority(pod *corev1.Pod) int32 {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	if class := p.getClass(pod.Spec.PriorityClassName); class != nil {
+		return class.Value
+	}
+	return 0
+}
+
+// canPreempt tells whether the PVC priority class allows preempting lower priority imports
+func (p *workerPriorities) canPreempt(pvc *corev1.PersistentVolumeClaim) bool {
+	class := p.getClass(cc.GetPriorityClass(pvc))
+	return class == nil || class.PreemptionPolicy == nil || *class.PreemptionPolicy != corev1.PreemptNever
+}
+
+// queueWorkerPod tells whether the worker pods of the PVC can be created according to the CDIConfig worker pod limits.
+// Otherwise the PVC is labeled as queued, and its Running condition shows its position in the queue and the reason.
+// Queued PVCs are admitted by priority then creation order, so the free slots of a limit are taken by the
+// higher priority and earlier PVCs first.
+func (q *workerQueue) queueWorkerPod(ctx context.Context, c client.Client, recorder record.EventRecorder, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	pvcCopy := pvc.DeepCopy()
+	admitted, msg, err := q.admitWorkerPod(ctx, c, recorder, pvc)
+	if err != nil {
+		return false, err
+	}
+	if admitted {
+		delete(pvc.Labels, common.WorkerQueuedLabel)
+		if pvc.Annotations[cc.AnnRunningConditionReason] == common.WorkerQueued {
+			delete(pvc.Annotations, cc.AnnRunningCondition)
+			delete(pvc.Annotations, cc.AnnRunningConditionReason)
+			delete(pvc.Annotations, cc.AnnRunningConditionMessage)
+		}
+	} else {
+		if pvc.Labels == nil {
+			pvc.Labels = make(map[string]string)
+		}
+		pvc.Labels[common.WorkerQueuedLabel] = "true"
+		cc.AddAnnotation(pvc, cc.AnnRunningCondition, "false")
+		cc.AddAnnotation(pvc, cc.AnnRunningConditionReason, common.WorkerQueued)
+		cc.AddAnnotation(pvc, cc.AnnRunningConditionMessage, msg)
+	}
+	// The PVC rendered for a dry run is not created, so it is only queued while reconciled
+	if pvc.ResourceVersion != "" && !reflect.DeepEqual(pvc.ObjectMeta, pvcCopy.ObjectMeta) {
+		if err := c.Update(ctx, pvc); err != nil {
+			return false, err
+		}
+	}
+	return admitted, nil
+}
+
+// admitWorkerPod simulates starting the queued PVCs in queue order, returning whether the PVC is admitted,
+// or its position in the queue and the limit it is waiting for. An admitted PVC reserves its worker pods.
+func (q *workerQueue) admitWorkerPod(ctx context.Context, c client.Client, recorder record.EventRecorder, pvc *corev1.PersistentVolumeClaim) (bool, string, error) {
+	cdiConfig := &cdiv1.CDIConfig{}
+	if err := c.Get(ctx, client.ObjectKey{Name: common.ConfigName}, cdiConfig); err != nil {
+		return false, "", cc.IgnoreNotFound(err)
+	}
+	limits := cdiConfig.Spec.WorkerPodLimits
+	if limits == nil {
+		return true, "", nil
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.dropExpiredReservations()
+	if _, ok := q.reservations[pvc.UID]; ok {
+		// Already admitted, its worker pods are not observed yet
+		return true, "", nil
+	}
+
+	priorities, err := getWorkerPriorities(ctx, c)
+	if err != nil {
+		return false, "", err
+	}
+	queue, err := getWorkerQueue(ctx, c, priorities, pvc)
+	if err != nil {
+		return false, "", err
+	}
+
+	counts := newWorkerCounts(ctx, c, q)
+	position := 1
+	for _, queued := range queue {
+		workers, err := getPVCWorkers(ctx, c, queued)
+		if err != nil {
+			return false, "", err
+		}
+		limit, reason, err := counts.exceededLimit(limits, workers)
+		if err != nil {
+			return false, "", err
+		}
+		isPVC := queued == pvc
+		if limit == workerLimitNone {
+			if isPVC {
+				q.reserve(pvc.UID, workers)
+				return true, "", nil
+			}
+			for _, w := range workers {
+				counts.add(w.key)
+			}
+			continue
+		}
+		if isPVC {
+			// Only the head of the queue preempts, so the PVCs queued before it are not bypassed
+			if position == 1 && limits.PreemptLowerPriorityImports && priorities.canPreempt(pvc) {
+				preempted, err := preemptWorkerPods(ctx, c, recorder, priorities, limits, counts, limit, pvc, workers)
+				if err != nil {
+					return false, "", err
+				}
+				if preempted {
+					q.reserve(pvc.UID, workers)
+					return true, "", nil
+				}
+			}
+			return false, fmt.Sprintf("Queued at position %d, %s", position, reason), nil
+		}
+		position++
+	}
+	return true, "", nil
+}
+
+// preemptWorkerPods deletes the importer pods of lower priority PVCs counted in the exceeded limit, lowest priority
+// and most recent first, if this lets the workers of the PVC start. The preempted PVCs then get queued behind the PVC.
+func preemptWorkerPods(ctx context.Context, c client.Client, recorder record.EventRecorder, priorities *workerPriorities,
+	limits *cdiv1.WorkerPodLimits, counts *workerCounts, limit workerLimit, pvc *corev1.PersistentVolumeClaim, workers []worker) (bool, error) {
+	if len(workers) != 1 || workers[0].component != common.ImporterPodName {
+		return false, nil
+	}
+	pods, err := counts.list(limit.indexValue(workers[0].key))
+	if err != nil {
+		return false, err
+	}
+	priority := priorities.getPVCPriority(pvc)
+	var candidates []*corev1.Pod
+	for _, pod := range pods {
+		if pod.Labels[common.CDIComponentLabel] == common.ImporterPodName && priorities.getPodPriority(pod) < priority {
+			candidates = append(candidates, pod)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if pa, pb := priorities.getPodPriority(a), priorities.getPodPriority(b); pa != pb {
+			return pa < pb
+		}
+		return b.CreationTimestamp.Before(&a.CreationTimestamp)
+	})
+
+	victims := map[*corev1.Pod]*corev1.PersistentVolumeClaim{}
+	for _, pod := range candidates {
+		if limit == workerLimitNone {
+			break
+		}
+		podPVC, err := getPreemptibleImportPVC(ctx, c, pod)
+		if err != nil {
+			return false, err
+		}
+		if podPVC == nil {
+			continue
+		}
+		victims[pod] = podPVC
+		counts.remove(getPodWorkerKey(pod))
+		if limit, _, err = counts.exceededLimit(limits, workers); err != nil {
+			return false, err
+		}
+	}
+	if limit != workerLimitNone {
+		return false, nil
+	}
+
+	for pod, podPVC := range victims {
+		if err := c.Delete(ctx, pod); cc.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+		recorder.Eventf(podPVC, corev1.EventTypeWarning, WorkerPodPreempted,
+			"Importer pod %s preempted by higher priority PersistentVolumeClaim %s/%s, the import restarts from the beginning", pod.Name, pvc.Namespace, pvc.Name)
+	}
+	return true, nil
+}
+
+// getPreemptibleImportPVC returns the PVC of an importer pod if its import opted in to preemption, since the import
+// restarts from the beginning. Multi-stage imports and imports not owned by their PVC are never preempted.
+func getPreemptibleImportPVC(ctx context.Context, c client.Client, pod *corev1.Pod) (*corev1.PersistentVolumeClaim, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "PersistentVolumeClaim" {
+		return nil, nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: owner.Name}, pvc); err != nil {
+		return nil, cc.IgnoreNotFound(err)
+	}
+	if pvc.UID != owner.UID || pvc.Annotations[cc.AnnPreemptible] != "true" || pvc.Annotations[cc.AnnCurrentCheckpoint] != "" {
+		return nil, nil
+	}
+	return pvc, nil
+}
+
+// CreateWorkerQueueIndexes creates the indexes of the worker pods and queued PVCs, shared by the import and upload controllers
+func CreateWorkerQueueIndexes(mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &corev1.Pod{}, workerPodLimitField, indexWorkerPodLimit); err != nil {
+		return err
+	}
+	return mgr.GetFieldIndexer().IndexField(context.TODO(), &corev1.PersistentVolumeClaim{}, workerQueuedField, indexWorkerQueued)
+}
+
+// indexWorkerPodLimit indexes the worker pods which are neither done nor terminating by the limits they are counted in
+func indexWorkerPodLimit(obj client.Object) []string {
+	pod := obj.(*corev1.Pod)
+	if !metav1.HasAnnotation(pod.ObjectMeta, cc.AnnWorkerPVCUID) || pod.DeletionTimestamp != nil ||
+		pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return nil
+	}
+	key := getPodWorkerKey(pod)
+	var values []string
+	for _, limit := range workerLimits {
+		if value := limit.indexValue(key); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func indexWorkerQueued(obj client.Object) []string {
+	if _, ok := obj.GetLabels()[common.WorkerQueuedLabel]; ok {
+		return []string{"true"}
+	}
+	return nil
+}
+
+func getPodWorkerKey(pod *corev1.Pod) workerKey {
+	return workerKey{
+		namespace:    pod.Namespace,
+		storageClass: pod.Annotations[cc.AnnWorkerStorageClass],
+		sourceHost:   pod.Annotations[cc.AnnWorkerSourceHost],
+	}
+}
+
+// getWorkerQueue returns the queued PVCs and the given PVC, in queue order
+func getWorkerQueue(ctx context.Context, c client.Client, priorities *workerPriorities, pvc *corev1.PersistentVolumeClaim) ([]*corev1.PersistentVolumeClaim, error) {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(ctx, pvcList, client.MatchingFields{workerQueuedField: "true"}); err != nil {
+		return nil, err
+	}
+	queue := []*corev1.PersistentVolumeClaim{pvc}
+	for i := range pvcList.Items {
+		queued := &pvcList.Items[i]
+		if (queued.Namespace == pvc.Namespace && queued.Name == pvc.Name) || queued.DeletionTimestamp != nil || cc.IsPVCComplete(queued) {
+			continue
+		}
+		queue = append(queue, queued)
+	}
+	sort.Slice(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+		if pa, pb := priorities.getPVCPriority(a), priorities.getPVCPriority(b); pa != pb {
+			return pa > pb
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+	})
+	return queue, nil
+}
+
+// getPVCWorkers returns the worker pods the PVC needs: an importer pod, a preflight pod for the PVC rendered
+// for a dry run, or the upload server and clone source pods of a host-assisted clone
+func getPVCWorkers(ctx context.Context, c client.Client, pvc *corev1.PersistentVolumeClaim) ([]worker, error) {
+	key := getPVCWorkerKey(pvc)
+	if pvc.Labels[common.CDIComponentLabel] == common.PreflightPodName {
+		return []worker{{component: common.PreflightPodName, key: key}}, nil
+	}
+	exists, namespace, name := ParseCloneRequestAnnotation(pvc)
+	if !exists {
+		return []worker{{component: common.ImporterPodName, key: key}}, nil
+	}
+	sourcePvc := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, sourcePvc); err != nil {
+		if cc.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		sourcePvc.Namespace = namespace
+	}
+	return []worker{
+		{component: common.UploadServerCDILabel, key: key},
+		{component: common.ClonerSourcePodName, key: getPVCWorkerKey(sourcePvc)},
+	}, nil
+}
+
+func getPVCWorkerKey(pvc *corev1.PersistentVolumeClaim) workerKey {
+	key := workerKey{namespace: pvc.Namespace}
+	if pvc.Spec.StorageClassName != nil {
+		key.storageClass = *pvc.Spec.StorageClassName
+	}
+	if endpoint := pvc.Annotations[cc.AnnEndpoint]; endpoint != "" {
+		if u, err := url.Parse(endpoint); err == nil {
+			key.sourceHost = u.Hostname()
+		}
+	}
+	return key
+}
+
+// setWorkerPodAnnotations annotates a worker pod of the PVC with what it is counted by in the worker pod limits
+func setWorkerPodAnnotations(pod *corev1.Pod, pvc *corev1.PersistentVolumeClaim, key workerKey) {
+	cc.AddAnnotation(pod, cc.AnnWorkerPVCUID, string(pvc.UID))
+	if key.storageClass != "" {
+		cc.AddAnnotation(pod, cc.AnnWorkerStorageClass, key.storageClass)
+	}
+	if key.sourceHost != "" {
+		cc.AddAnnotation(pod, cc.AnnWorkerSourceHost, key.sourceHost)
+	}
+}
@@ -14,12 +14,17 @@ import (
 const (
 	// HonorWaitForFirstConsumer - if enabled will not schedule worker pods on a storage with WaitForFirstConsumer binding mode
 	HonorWaitForFirstConsumer = "HonorWaitForFirstConsumer"
+
+	// ImportDeduplication - if enabled will satisfy imports of an already imported source by cloning the existing volume
+	ImportDeduplication = "ImportDeduplication"
 )
 
 // FeatureGates is a util for determining whether an optional feature is enabled or not.
 type FeatureGates interface {
 	// HonorWaitForFirstConsumerEnabled - see the HonorWaitForFirstConsumer const
 	HonorWaitForFirstConsumerEnabled() (bool, error)
+	// ImportDeduplicationEnabled - see the ImportDeduplication const
+	ImportDeduplicationEnabled() (bool, error)
 }
 
 // CDIConfigFeatureGates is a util for determining whether an optional feature is enabled or not.
@@ -59,3 +64,8 @@ func (f *CDIConfigFeatureGates) getConfig() ([]string, error) {
 func (f *CDIConfigFeatureGates) HonorWaitForFirstConsumerEnabled() (bool, error) {
 	return f.isFeatureGateEnabled(HonorWaitForFirstConsumer)
 }
+
+// ImportDeduplicationEnabled - see the ImportDeduplication const
+func (f *CDIConfigFeatureGates) ImportDeduplicationEnabled() (bool, error) {
+	return f.isFeatureGateEnabled(ImportDeduplication)
+}
@@ -37,6 +37,7 @@ var _ = Describe("Feature Gates", func() {
 	It("Should be false if not set", func() {
 		featureGates, _ := createFeatureGatesAndClient()
 		Expect(featureGates.HonorWaitForFirstConsumerEnabled()).To(BeFalse())
+		Expect(featureGates.ImportDeduplicationEnabled()).To(BeFalse())
 	})
 
 	It("Should reflect config changes", func() {
@@ -46,10 +47,11 @@ var _ = Describe("Feature Gates", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// update the config on the status not the spec
-		cdiConfig.Spec.FeatureGates = []string{HonorWaitForFirstConsumer}
+		cdiConfig.Spec.FeatureGates = []string{HonorWaitForFirstConsumer, ImportDeduplication}
 		err = client.Update(context.TODO(), cdiConfig)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(featureGates.HonorWaitForFirstConsumerEnabled()).To(BeTrue())
+		Expect(featureGates.ImportDeduplicationEnabled()).To(BeTrue())
 
 		cdiConfig.Spec.FeatureGates = nil
 		err = client.Update(context.TODO(), cdiConfig)
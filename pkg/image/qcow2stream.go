@@ -0,0 +1,576 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	qcow2V2HeaderLen           = 72
+	qcow2V3HeaderLen           = 104
+	qcow2MinClusterBits        = 9
+	qcow2MaxClusterBits        = 21
+	qcow2SectorSize            = 512
+	qcow2OffsetMask            = 0x00fffffffffffe00
+	qcow2CompressedFlag        = uint64(1) << 62
+	qcow2ZeroFlag              = uint64(1)
+	qcow2IncompatDirty         = uint64(1) << 0
+	qcow2IncompatCompression   = uint64(1) << 3
+	qcow2CompressionTypeOffset = 104
+	qcow2CompressionDeflate    = 0
+	qcow2CompressionZstd       = 1
+)
+
+const (
+	qcow2L1Table = iota
+	qcow2L2Table
+	qcow2DataCluster
+	qcow2CompressedCluster
+)
+
+// ErrQCOW2NotStreamable is returned when a qcow2 image can't be converted while it is read sequentially, either
+// because of its features or because too many of its clusters precede the tables referencing them.
+var ErrQCOW2NotStreamable = errors.New("qcow2 image can't be converted as a stream")
+
+type qcow2Header struct {
+	clusterBits     uint32
+	size            int64
+	l1Size          int64
+	l1TableOffset   int64
+	compressionType byte
+}
+
+// a host byte range of the image and what to do with it once read
+type qcow2Request struct {
+	kind        int
+	start       int64
+	end         int64
+	guestOffset int64
+	l1Index     int64
+}
+
+// QCOW2Stream converts a qcow2 image to raw while reading it sequentially, so the image doesn't need to be
+// seekable. Guest clusters are written as soon as the tables referencing them are known, clusters preceding those
+// tables are buffered up to a limit.
+type QCOW2Stream struct {
+	r           io.Reader
+	hdr         *qcow2Header
+	clusterSize int64
+	bufferLimit int64
+
+	// number of bytes read from r, and whether it reached its end
+	pos int64
+	eof bool
+	// the cluster just read, and the clusters read earlier that are still needed
+	current      []byte
+	currentIndex int64
+	buffered     map[int64][]byte
+	bufferedSize int64
+	// number of pending requests for each host cluster, and the pending requests by their last host cluster
+	refs       map[int64]int
+	pending    map[int64][]*qcow2Request
+	numPending int
+	pendingL2  int
+	l1Done     bool
+
+	w           io.WriterAt
+	zeroRange   func(offset, length int64) error
+	zeroStart   int64
+	zeroEnd     int64
+	zeroCluster []byte
+	cluster     []byte
+	flate       io.ReadCloser
+	zstd        *zstd.Decoder
+}
+
+// IsQCOW2Streamable returns true if the qcow2 header describes an image QCOW2Stream can convert
+func IsQCOW2Streamable(hdr []byte) bool {
+	_, err := parseQCOW2Header(hdr)
+	return err == nil
+}
+
+func parseQCOW2Header(hdr []byte) (*qcow2Header, error) {
+	if len(hdr) < qcow2V2HeaderLen || !bytes.Equal(hdr[:4], knownHeaders["qcow2"].magicNumber) {
+		return nil, errors.New("invalid qcow2 header")
+	}
+	be := binary.BigEndian
+	h := &qcow2Header{
+		clusterBits:   be.Uint32(hdr[20:]),
+		size:          int64(be.Uint64(hdr[24:])),
+		l1Size:        int64(be.Uint32(hdr[36:])),
+		l1TableOffset: int64(be.Uint64(hdr[40:])),
+	}
+	switch version := be.Uint32(hdr[4:]); version {
+	case 2:
+	case 3:
+		if len(hdr) < qcow2V3HeaderLen {
+			return nil, errors.New("invalid qcow2 v3 header")
+		}
+		incompatible := be.Uint64(hdr[72:])
+		if unsupported := incompatible &^ (qcow2IncompatDirty | qcow2IncompatCompression); unsupported != 0 {
+			return nil, errors.Wrapf(ErrQCOW2NotStreamable, "unsupported incompatible features %#x", unsupported)
+		}
+		if incompatible&qcow2IncompatCompression != 0 {
+			if be.Uint32(hdr[100:]) <= qcow2CompressionTypeOffset || len(hdr) <= qcow2CompressionTypeOffset {
+				return nil, errors.New("invalid qcow2 compression type")
+			}
+			h.compressionType = hdr[qcow2CompressionTypeOffset]
+		}
+	default:
+		return nil, errors.Wrapf(ErrQCOW2NotStreamable, "unsupported version %d", version)
+	}
+	if be.Uint64(hdr[8:]) != 0 {
+		return nil, errors.Wrap(ErrQCOW2NotStreamable, "image has a backing file")
+	}
+	if be.Uint32(hdr[32:]) != 0 {
+		return nil, errors.Wrap(ErrQCOW2NotStreamable, "image is encrypted")
+	}
+	if h.compressionType != qcow2CompressionDeflate && h.compressionType != qcow2CompressionZstd {
+		return nil, errors.Wrapf(ErrQCOW2NotStreamable, "unsupported compression type %d", h.compressionType)
+	}
+	if h.clusterBits < qcow2MinClusterBits || h.clusterBits > qcow2MaxClusterBits {
+		return nil, errors.Errorf("invalid qcow2 cluster bits %d", h.clusterBits)
+	}
+	if h.size < 0 || h.l1TableOffset < 0 || h.l1TableOffset%(int64(1)<<h.clusterBits) != 0 {
+		return nil, errors.New("invalid qcow2 header")
+	}
+	if h.l1Size > 0 && h.l1TableOffset == 0 {
+		return nil, errors.New("invalid qcow2 L1 table offset")
+	}
+	// The L1 table is read in one piece, it can't be larger than the image needs
+	clusterSize := int64(1) << h.clusterBits
+	l2Size := clusterSize / 8 * clusterSize
+	maxL1Size := h.size / l2Size
+	if h.size%l2Size != 0 {
+		maxL1Size++
+	}
+	if h.l1Size > maxL1Size {
+		return nil, errors.Wrapf(ErrQCOW2NotStreamable, "L1 table of %d entries is larger than the %d entries the image needs", h.l1Size, maxL1Size)
+	}
+	return h, nil
+}
+
+// NewQCOW2Stream reads the qcow2 header from r. Up to bufferLimit bytes of clusters are buffered when they precede
+// the tables referencing them.
+func NewQCOW2Stream(r io.Reader, bufferLimit int64) (*QCOW2Stream, error) {
+	hdr := make([]byte, MaxExpectedHdrSize)
+	n, err := io.ReadFull(r, hdr)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(err, "unable to read qcow2 header")
+	}
+	h, err := parseQCOW2Header(hdr[:n])
+	if err != nil {
+		return nil, err
+	}
+	s := &QCOW2Stream{
+		r:           r,
+		hdr:         h,
+		clusterSize: int64(1) << h.clusterBits,
+		bufferLimit: bufferLimit,
+		buffered:    make(map[int64][]byte),
+		refs:        make(map[int64]int),
+		pending:     make(map[int64][]*qcow2Request),
+	}
+	// The header cluster is buffered as any cluster read before the tables
+	s.cluster = make([]byte, s.clusterSize)
+	copy(s.cluster, hdr[:n])
+	if int64(n) < s.clusterSize {
+		m, err := io.ReadFull(r, s.cluster[n:])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			s.eof = true
+		} else if err != nil {
+			return nil, errors.Wrap(err, "unable to read qcow2 header")
+		}
+		n += m
+	}
+	s.pos = int64(n)
+	s.buffered[0] = append([]byte(nil), s.cluster[:n]...)
+	s.bufferedSize = int64(n)
+	return s, nil
+}
+
+// VirtualSize returns the virtual size of the image
+func (s *QCOW2Stream) VirtualSize() int64 {
+	return s.hdr.size
+}
+
+// Convert writes the guest clusters of the image to w. zeroRange is called for the guest ranges that are not
+// written, so it can be nil if w already reads as zeros.
+func (s *QCOW2Stream) Convert(w io.WriterAt, zeroRange func(offset, length int64) error) error {
+	s.w = w
+	s.zeroRange = zeroRange
+	s.zeroCluster = make([]byte, s.clusterSize)
+	if s.hdr.compressionType == qcow2CompressionZstd {
+		decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		s.zstd = decoder
+		defer decoder.Close()
+	}
+
+	if s.hdr.l1Size > 0 {
+		l1 := &qcow2Request{
+			kind:  qcow2L1Table,
+			start: s.hdr.l1TableOffset,
+			end:   s.hdr.l1TableOffset + s.hdr.l1Size*8,
+		}
+		if err := s.addRequest(l1); err != nil {
+			return err
+		}
+	} else {
+		s.l1Done = true
+		if err := s.addZeroRange(0, s.hdr.size); err != nil {
+			return err
+		}
+	}
+	for !s.done() {
+		if s.eof {
+			return errors.Wrapf(io.ErrUnexpectedEOF, "qcow2 image ended at %d with %d clusters missing", s.pos, s.numPending)
+		}
+		if err := s.readCluster(); err != nil {
+			return err
+		}
+	}
+	klog.V(3).Infof("Converted qcow2 stream after reading %d bytes", s.pos)
+	return s.flushZeroRange()
+}
+
+func (s *QCOW2Stream) done() bool {
+	return s.metadataDone() && s.numPending == 0
+}
+
+func (s *QCOW2Stream) metadataDone() bool {
+	return s.l1Done && s.pendingL2 == 0
+}
+
+func (s *QCOW2Stream) readCluster() error {
+	n, err := io.ReadFull(s.r, s.cluster)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		s.eof = true
+	} else if err != nil {
+		return errors.Wrap(err, "unable to read qcow2 image")
+	}
+	index := s.pos / s.clusterSize
+	s.current, s.currentIndex = s.cluster[:n], index
+	s.pos += int64(n)
+	defer func() { s.current = nil }()
+
+	requests := s.pending[index]
+	delete(s.pending, index)
+	if s.eof {
+		// Whatever is pending gets what could be read
+		indexes := make([]int64, 0, len(s.pending))
+		for i := range s.pending {
+			indexes = append(indexes, i)
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+		for _, i := range indexes {
+			requests = append(requests, s.pending[i]...)
+			delete(s.pending, i)
+		}
+	}
+	for _, req := range requests {
+		s.numPending--
+		if err := s.execute(req); err != nil {
+			return err
+		}
+	}
+
+	// Keep the cluster while the tables are incomplete, or while a request spanning it is pending
+	if n > 0 && (s.refs[index] > 0 || !s.metadataDone()) {
+		s.buffered[index] = append([]byte(nil), s.current...)
+		s.bufferedSize += int64(n)
+		if s.bufferedSize > s.bufferLimit {
+			return errors.Wrapf(ErrQCOW2NotStreamable, "clusters out of order exceed the %d bytes buffer", s.bufferLimit)
+		}
+	}
+	return nil
+}
+
+func (s *QCOW2Stream) addRequest(req *qcow2Request) error {
+	first, last := req.start/s.clusterSize, (req.end-1)/s.clusterSize
+	for i := first; i <= last; i++ {
+		s.refs[i]++
+	}
+	if req.end <= s.pos || s.eof {
+		return s.execute(req)
+	}
+	s.pending[last] = append(s.pending[last], req)
+	s.numPending++
+	return nil
+}
+
+func (s *QCOW2Stream) execute(req *qcow2Request) error {
+	data, err := s.gather(req.start, req.end)
+	if err != nil {
+		return err
+	}
+	switch req.kind {
+	case qcow2L1Table:
+		err = s.parseL1Table(data, req)
+	case qcow2L2Table:
+		err = s.parseL2Table(data, req)
+	case qcow2DataCluster:
+		err = s.writeDataCluster(data, req)
+	case qcow2CompressedCluster:
+		err = s.writeCompressedCluster(data, req)
+	}
+	if err != nil {
+		return err
+	}
+	s.release(req)
+	return nil
+}
+
+// gather returns the bytes of the host range that were read
+func (s *QCOW2Stream) gather(start, end int64) ([]byte, error) {
+	if end > s.pos {
+		end = s.pos
+	}
+	if start >= end {
+		return nil, nil
+	}
+	first, last := start/s.clusterSize, (end-1)/s.clusterSize
+	// A range spanning clusters is copied, which counts as buffered
+	if first != last && s.bufferedSize+end-start > s.bufferLimit {
+		return nil, errors.Wrapf(ErrQCOW2NotStreamable, "%d bytes at %d exceed the %d bytes buffer", end-start, start, s.bufferLimit)
+	}
+	var data []byte
+	for i := first; i <= last; i++ {
+		cluster := s.buffered[i]
+		if i == s.currentIndex && s.current != nil {
+			cluster = s.current
+		}
+		if cluster == nil {
+			return nil, errors.Wrapf(ErrQCOW2NotStreamable, "cluster at %d is referenced after it was read", i*s.clusterSize)
+		}
+		from, to := int64(0), int64(len(cluster))
+		if i == first {
+			from = start - i*s.clusterSize
+		}
+		if i == last && end-i*s.clusterSize < to {
+			to = end - i*s.clusterSize
+		}
+		if first == last {
+			return cluster[from:to], nil
+		}
+		data = append(data, cluster[from:to]...)
+	}
+	return data, nil
+}
+
+// release drops the buffered clusters of the request that nothing else needs
+func (s *QCOW2Stream) release(req *qcow2Request) {
+	first, last := req.start/s.clusterSize, (req.end-1)/s.clusterSize
+	for i := first; i <= last; i++ {
+		if s.refs[i]--; s.refs[i] > 0 {
+			continue
+		}
+		delete(s.refs, i)
+		if s.metadataDone() {
+			s.dropCluster(i)
+		}
+	}
+}
+
+func (s *QCOW2Stream) dropCluster(index int64) {
+	if cluster, ok := s.buffered[index]; ok {
+		s.bufferedSize -= int64(len(cluster))
+		delete(s.buffered, index)
+	}
+}
+
+// once all tables are read, only the clusters of pending requests are still needed
+func (s *QCOW2Stream) dropUnreferenced() {
+	for i := range s.buffered {
+		if s.refs[i] == 0 {
+			s.dropCluster(i)
+		}
+	}
+}
+
+func (s *QCOW2Stream) parseL1Table(data []byte, req *qcow2Request) error {
+	if int64(len(data)) < req.end-req.start {
+		return errors.Wrap(io.ErrUnexpectedEOF, "qcow2 L1 table is truncated")
+	}
+	l2Size := s.clusterSize / 8 * s.clusterSize
+	for i := int64(0); i < s.hdr.l1Size && i*l2Size < s.hdr.size; i++ {
+		offset := int64(binary.BigEndian.Uint64(data[i*8:]) & qcow2OffsetMask)
+		if offset == 0 {
+			if err := s.addZeroRange(i*l2Size, l2Size); err != nil {
+				return err
+			}
+			continue
+		}
+		if offset%s.clusterSize != 0 {
+			return errors.Errorf("invalid qcow2 L2 table offset %d", offset)
+		}
+		s.pendingL2++
+		l2 := &qcow2Request{
+			kind:    qcow2L2Table,
+			start:   offset,
+			end:     offset + s.clusterSize,
+			l1Index: i,
+		}
+		if err := s.addRequest(l2); err != nil {
+			return err
+		}
+	}
+	// The tables are complete only once all the L2 tables are queued
+	s.l1Done = true
+	if s.metadataDone() {
+		s.dropUnreferenced()
+	}
+	return nil
+}
+
+func (s *QCOW2Stream) parseL2Table(data []byte, req *qcow2Request) error {
+	if int64(len(data)) < s.clusterSize {
+		return errors.Wrap(io.ErrUnexpectedEOF, "qcow2 L2 table is truncated")
+	}
+	entries := s.clusterSize / 8
+	for j := int64(0); j < entries; j++ {
+		guestOffset := (req.l1Index*entries + j) * s.clusterSize
+		if guestOffset >= s.hdr.size {
+			break
+		}
+		entry := binary.BigEndian.Uint64(data[j*8:])
+		var err error
+		switch {
+		case entry&qcow2CompressedFlag != 0:
+			err = s.addRequest(s.compressedRequest(entry, guestOffset))
+		case entry&qcow2ZeroFlag != 0 || entry&qcow2OffsetMask == 0:
+			err = s.addZeroRange(guestOffset, s.clusterSize)
+		default:
+			offset := int64(entry & qcow2OffsetMask)
+			if offset%s.clusterSize != 0 {
+				return errors.Errorf("invalid qcow2 cluster offset %d", offset)
+			}
+			err = s.addRequest(&qcow2Request{
+				kind:        qcow2DataCluster,
+				start:       offset,
+				end:         offset + s.clusterSize,
+				guestOffset: guestOffset,
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	s.pendingL2--
+	if s.metadataDone() {
+		s.dropUnreferenced()
+	}
+	return nil
+}
+
+func (s *QCOW2Stream) compressedRequest(entry uint64, guestOffset int64) *qcow2Request {
+	// Compressed cluster descriptor: the host offset in the low x bits, then the number of additional sectors
+	x := 62 - (s.hdr.clusterBits - 8)
+	offset := int64(entry & (uint64(1)<<x - 1))
+	sectors := int64((entry>>x)&(uint64(1)<<(s.hdr.clusterBits-8)-1)) + 1
+	return &qcow2Request{
+		kind:        qcow2CompressedCluster,
+		start:       offset,
+		end:         offset + sectors*qcow2SectorSize - offset%qcow2SectorSize,
+		guestOffset: guestOffset,
+	}
+}
+
+func (s *QCOW2Stream) writeDataCluster(data []byte, req *qcow2Request) error {
+	if int64(len(data)) < s.clusterSize {
+		return errors.Wrap(io.ErrUnexpectedEOF, "qcow2 data cluster is truncated")
+	}
+	return s.writeGuestCluster(data, req.guestOffset)
+}
+
+func (s *QCOW2Stream) writeCompressedCluster(data []byte, req *qcow2Request) error {
+	var r io.Reader
+	if s.zstd != nil {
+		if err := s.zstd.Reset(bytes.NewReader(data)); err != nil {
+			return errors.Wrap(err, "unable to decompress qcow2 cluster")
+		}
+		r = s.zstd
+	} else {
+		if s.flate == nil {
+			s.flate = flate.NewReader(bytes.NewReader(data))
+		} else if err := s.flate.(flate.Resetter).Reset(bytes.NewReader(data), nil); err != nil {
+			return errors.Wrap(err, "unable to decompress qcow2 cluster")
+		}
+		r = s.flate
+	}
+	cluster := make([]byte, s.clusterSize)
+	if _, err := io.ReadFull(r, cluster); err != nil {
+		return errors.Wrapf(err, "unable to decompress qcow2 cluster at %d", req.start)
+	}
+	return s.writeGuestCluster(cluster, req.guestOffset)
+}
+
+func (s *QCOW2Stream) writeGuestCluster(cluster []byte, guestOffset int64) error {
+	if remaining := s.hdr.size - guestOffset; remaining < int64(len(cluster)) {
+		cluster = cluster[:remaining]
+	}
+	// Skipping zero clusters keeps the target sparse
+	if bytes.Equal(cluster, s.zeroCluster[:len(cluster)]) {
+		return s.addZeroRange(guestOffset, int64(len(cluster)))
+	}
+	if _, err := s.w.WriteAt(cluster, guestOffset); err != nil {
+		return errors.Wrapf(err, "unable to write guest cluster at %d", guestOffset)
+	}
+	return nil
+}
+
+// addZeroRange merges adjacent ranges, as the tables are mostly parsed in guest order
+func (s *QCOW2Stream) addZeroRange(offset, length int64) error {
+	if offset+length > s.hdr.size {
+		length = s.hdr.size - offset
+	}
+	if s.zeroRange == nil || length <= 0 {
+		return nil
+	}
+	if offset == s.zeroEnd && s.zeroEnd > s.zeroStart {
+		s.zeroEnd += length
+		return nil
+	}
+	if err := s.flushZeroRange(); err != nil {
+		return err
+	}
+	s.zeroStart, s.zeroEnd = offset, offset+length
+	return nil
+}
+
+func (s *QCOW2Stream) flushZeroRange() error {
+	if s.zeroRange == nil || s.zeroEnd <= s.zeroStart {
+		return nil
+	}
+	start, end := s.zeroStart, s.zeroEnd
+	s.zeroStart, s.zeroEnd = 0, 0
+	if err := s.zeroRange(start, end-start); err != nil {
+		return errors.Wrapf(err, "unable to zero guest range %d-%d", start, end)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package image
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+const testClusterBits = 9
+
+type testQCOW2Cluster struct {
+	data       []byte
+	compressed bool
+	zero       bool
+}
+
+type testQCOW2 struct {
+	size     int64
+	clusters map[int64]testQCOW2Cluster // by guest cluster index
+	// tables after the data clusters they reference
+	tablesLast bool
+	// each L2 table right after its data clusters, the L1 table first; without compressed clusters
+	l2Interleaved bool
+	zstd          bool
+	header        func(hdr []byte)
+}
+
+// build lays out a qcow2 v3 image without refcounts, which converting it doesn't need
+func (q *testQCOW2) build() []byte {
+	cs := int64(1) << testClusterBits
+	l2Entries := cs / 8
+	l1Size := (q.size + cs*l2Entries - 1) / (cs * l2Entries)
+	l1Clusters := (l1Size*8 + cs - 1) / cs
+
+	guest := make([]int64, 0, len(q.clusters))
+	l2Tables := map[int64][]byte{}
+	for i := range q.clusters {
+		guest = append(guest, i)
+		l2Tables[i/l2Entries] = make([]byte, cs)
+	}
+	sort.Slice(guest, func(i, j int) bool { return guest[i] < guest[j] })
+
+	img := make([]byte, cs)
+	appendCluster := func(data []byte) int64 {
+		offset := int64(len(img))
+		img = append(img, make([]byte, cs)...)
+		copy(img[offset:], data)
+		return offset
+	}
+	l1 := make([]byte, l1Clusters*cs)
+	appendL1 := func() int64 {
+		l1Offset := appendCluster(nil)
+		for i := int64(1); i < l1Clusters; i++ {
+			appendCluster(nil)
+		}
+		return l1Offset
+	}
+	appendTables := func() int64 {
+		l2Indexes := make([]int64, 0, len(l2Tables))
+		for i := range l2Tables {
+			l2Indexes = append(l2Indexes, i)
+		}
+		sort.Slice(l2Indexes, func(i, j int) bool { return l2Indexes[i] < l2Indexes[j] })
+		l1Offset := appendL1()
+		for _, i := range l2Indexes {
+			binary.BigEndian.PutUint64(l1[i*8:], uint64(appendCluster(nil)))
+		}
+		return l1Offset
+	}
+	var l1Offset int64
+	if q.l2Interleaved {
+		l1Offset = appendL1()
+	} else if !q.tablesLast {
+		l1Offset = appendTables()
+	}
+	l2Entry := func(i int64) []byte {
+		l1Entry := int64(binary.BigEndian.Uint64(l1[(i/l2Entries)*8:]))
+		if q.tablesLast || q.l2Interleaved {
+			return l2Tables[i/l2Entries][(i%l2Entries)*8:]
+		}
+		return img[l1Entry+(i%l2Entries)*8:]
+	}
+	var compressed []int64
+	for n, i := range guest {
+		if q.l2Interleaved && n > 0 && guest[n-1]/l2Entries != i/l2Entries {
+			binary.BigEndian.PutUint64(l1[(guest[n-1]/l2Entries)*8:], uint64(appendCluster(l2Tables[guest[n-1]/l2Entries])))
+		}
+		switch cluster := q.clusters[i]; {
+		case cluster.zero:
+			binary.BigEndian.PutUint64(l2Entry(i), qcow2ZeroFlag)
+		case cluster.compressed:
+			compressed = append(compressed, i)
+		default:
+			offset := appendCluster(cluster.data)
+			binary.BigEndian.PutUint64(l2Entry(i), uint64(offset))
+		}
+	}
+	if q.l2Interleaved && len(guest) > 0 {
+		last := guest[len(guest)-1] / l2Entries
+		binary.BigEndian.PutUint64(l1[last*8:], uint64(appendCluster(l2Tables[last])))
+	}
+	// Compressed clusters are packed, and may span host clusters
+	x := 62 - (testClusterBits - 8)
+	for _, i := range compressed {
+		data := q.compress(q.clusters[i].data)
+		offset := int64(len(img))
+		img = append(img, data...)
+		sectors := (offset+int64(len(data))-1)/qcow2SectorSize - offset/qcow2SectorSize
+		binary.BigEndian.PutUint64(l2Entry(i), qcow2CompressedFlag|uint64(sectors)<<x|uint64(offset))
+	}
+	if padding := int64(len(img)) % cs; padding != 0 {
+		img = append(img, make([]byte, cs-padding)...)
+	}
+	if q.tablesLast {
+		l1Offset = appendTables()
+		for i, table := range l2Tables {
+			copy(img[binary.BigEndian.Uint64(l1[i*8:]):], table)
+		}
+	}
+	copy(img[l1Offset:], l1)
+
+	hdr := img[:qcow2V3HeaderLen+8]
+	copy(hdr, knownHeaders["qcow2"].magicNumber)
+	binary.BigEndian.PutUint32(hdr[4:], 3)
+	binary.BigEndian.PutUint32(hdr[20:], testClusterBits)
+	binary.BigEndian.PutUint64(hdr[24:], uint64(q.size))
+	binary.BigEndian.PutUint32(hdr[36:], uint32(l1Size))
+	binary.BigEndian.PutUint64(hdr[40:], uint64(l1Offset))
+	binary.BigEndian.PutUint32(hdr[96:], 4)
+	binary.BigEndian.PutUint32(hdr[100:], qcow2V3HeaderLen+8)
+	if q.zstd {
+		binary.BigEndian.PutUint64(hdr[72:], qcow2IncompatCompression)
+		hdr[qcow2CompressionTypeOffset] = qcow2CompressionZstd
+	}
+	if q.header != nil {
+		q.header(hdr)
+	}
+	return img
+}
+
+func (q *testQCOW2) compress(data []byte) []byte {
+	var buf bytes.Buffer
+	if q.zstd {
+		encoder, err := zstd.NewWriter(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = encoder.Write(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encoder.Close()).To(Succeed())
+		return buf.Bytes()
+	}
+	writer, err := flate.NewWriter(&buf, flate.BestCompression)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = writer.Write(data)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(writer.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+// raw returns the guest content of the image
+func (q *testQCOW2) raw() []byte {
+	cs := int64(1) << testClusterBits
+	raw := make([]byte, q.size)
+	for i, cluster := range q.clusters {
+		if !cluster.zero {
+			copy(raw[i*cs:], cluster.data)
+		}
+	}
+	return raw
+}
+
+// testRawTarget starts dirty, so that the ranges which aren't written must be zeroed
+type testRawTarget struct {
+	data   []byte
+	zeroed int64
+}
+
+func newTestRawTarget(size int64) *testRawTarget {
+	return &testRawTarget{data: bytes.Repeat([]byte{0xaa}, int(size))}
+}
+
+func (t *testRawTarget) WriteAt(p []byte, off int64) (int, error) {
+	return copy(t.data[off:], p), nil
+}
+
+func (t *testRawTarget) zeroRange(offset, length int64) error {
+	copy(t.data[offset:offset+length], make([]byte, length))
+	t.zeroed += length
+	return nil
+}
+
+func testClusterData(seed byte) []byte {
+	data := make([]byte, 1<<testClusterBits)
+	for i := range data {
+		data[i] = seed + byte(i%7)
+	}
+	return data
+}
+
+// half random, so that compressed clusters span host clusters
+func testCompressibleClusterData(seed int64) []byte {
+	data := make([]byte, 1<<testClusterBits)
+	rand.New(rand.NewSource(seed)).Read(data[:len(data)/2])
+	return data
+}
+
+func convertTestQCOW2(q *testQCOW2, bufferLimit int64) (*testRawTarget, error) {
+	s, err := NewQCOW2Stream(bytes.NewReader(q.build()), bufferLimit)
+	if err != nil {
+		return nil, err
+	}
+	Expect(s.VirtualSize()).To(Equal(q.size))
+	target := newTestRawTarget(q.size)
+	return target, s.Convert(target, target.zeroRange)
+}
+
+var _ = Describe("QCOW2 stream", func() {
+	cs := int64(1) << testClusterBits
+	l2Range := cs / 8 * cs
+
+	newTestImage := func() *testQCOW2 {
+		return &testQCOW2{
+			// Two L2 tables, the second one is partially used
+			size: l2Range + 3*cs + 100,
+			clusters: map[int64]testQCOW2Cluster{
+				0:                 {data: testClusterData(1)},
+				2:                 {data: testClusterData(2)},
+				3:                 {zero: true},
+				5:                 {data: make([]byte, cs)},
+				l2Range / cs:      {data: testClusterData(3)},
+				l2Range/cs + 3:    {data: testClusterData(4)},
+				l2Range/cs - 1:    {data: testClusterData(5)},
+				l2Range/cs/2 + 10: {data: testClusterData(6)},
+			},
+		}
+	}
+
+	DescribeTable("should convert", func(modify func(q *testQCOW2)) {
+		q := newTestImage()
+		modify(q)
+		target, err := convertTestQCOW2(q, 1<<20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target.data).To(Equal(q.raw()))
+		Expect(target.zeroed).To(BeNumerically(">", 0))
+	},
+		Entry("an image with its tables first", func(q *testQCOW2) {}),
+		Entry("an image with its tables last", func(q *testQCOW2) {
+			q.tablesLast = true
+		}),
+		Entry("an image with its L2 tables after the clusters they reference", func(q *testQCOW2) {
+			q.l2Interleaved = true
+		}),
+		Entry("an image with an L1 table spanning clusters", func(q *testQCOW2) {
+			q.size = 128 * l2Range
+		}),
+		Entry("deflate compressed clusters", func(q *testQCOW2) {
+			for _, i := range []int64{0, 2, l2Range / cs} {
+				q.clusters[i] = testQCOW2Cluster{data: testCompressibleClusterData(i), compressed: true}
+			}
+		}),
+		Entry("zstd compressed clusters", func(q *testQCOW2) {
+			q.zstd = true
+			for _, i := range []int64{0, 2, l2Range / cs} {
+				q.clusters[i] = testQCOW2Cluster{data: testCompressibleClusterData(i), compressed: true}
+			}
+		}),
+		Entry("compressed clusters before their tables", func(q *testQCOW2) {
+			q.tablesLast = true
+			for _, i := range []int64{0, 2, l2Range/cs + 3} {
+				q.clusters[i] = testQCOW2Cluster{data: testCompressibleClusterData(i), compressed: true}
+			}
+		}),
+		Entry("an empty image", func(q *testQCOW2) {
+			q.clusters = map[int64]testQCOW2Cluster{}
+		}),
+	)
+
+	It("should not zero ranges of a target without zeroRange", func() {
+		q := newTestImage()
+		s, err := NewQCOW2Stream(bytes.NewReader(q.build()), 1<<20)
+		Expect(err).ToNot(HaveOccurred())
+		target := newTestRawTarget(q.size)
+		Expect(s.Convert(target, nil)).To(Succeed())
+		Expect(target.data[:cs]).To(Equal(testClusterData(1)))
+		Expect(target.data[cs : 2*cs]).To(Equal(bytes.Repeat([]byte{0xaa}, int(cs))))
+	})
+
+	It("should fail when clusters before their tables exceed the buffer", func() {
+		q := newTestImage()
+		q.tablesLast = true
+		_, err := convertTestQCOW2(q, 4*cs)
+		Expect(errors.Is(err, ErrQCOW2NotStreamable)).To(BeTrue())
+	})
+
+	It("should fail when clusters before their L2 tables exceed the buffer", func() {
+		q := newTestImage()
+		q.l2Interleaved = true
+		_, err := convertTestQCOW2(q, 4*cs)
+		Expect(errors.Is(err, ErrQCOW2NotStreamable)).To(BeTrue())
+	})
+
+	It("should fail when the L1 table exceeds the buffer", func() {
+		q := &testQCOW2{size: 128 * l2Range}
+		_, err := convertTestQCOW2(q, 1<<20)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = convertTestQCOW2(q, 3*cs)
+		Expect(errors.Is(err, ErrQCOW2NotStreamable)).To(BeTrue())
+	})
+
+	It("should fail on a truncated image", func() {
+		img := newTestImage().build()
+		s, err := NewQCOW2Stream(bytes.NewReader(img[:len(img)-int(cs)]), 1<<20)
+		Expect(err).ToNot(HaveOccurred())
+		err = s.Convert(newTestRawTarget(s.VirtualSize()), nil)
+		Expect(errors.Is(err, io.ErrUnexpectedEOF)).To(BeTrue())
+	})
+
+	DescribeTable("should not stream an image", func(header func(hdr []byte)) {
+		q := newTestImage()
+		q.header = header
+		img := q.build()
+		Expect(IsQCOW2Streamable(img[:MaxExpectedHdrSize])).To(BeFalse())
+		_, err := NewQCOW2Stream(bytes.NewReader(img), 1<<20)
+		Expect(errors.Is(err, ErrQCOW2NotStreamable)).To(BeTrue())
+	},
+		Entry("with a backing file", func(hdr []byte) {
+			binary.BigEndian.PutUint64(hdr[8:], 512)
+		}),
+		Entry("which is encrypted", func(hdr []byte) {
+			binary.BigEndian.PutUint32(hdr[32:], 2)
+		}),
+		Entry("with an external data file", func(hdr []byte) {
+			binary.BigEndian.PutUint64(hdr[72:], 1<<2)
+		}),
+		Entry("with extended L2 entries", func(hdr []byte) {
+			binary.BigEndian.PutUint64(hdr[72:], 1<<4)
+		}),
+		Entry("with an L1 table larger than the image needs", func(hdr []byte) {
+			binary.BigEndian.PutUint32(hdr[36:], 1<<30)
+		}),
+	)
+
+	It("should stream a dirty image", func() {
+		q := newTestImage()
+		q.header = func(hdr []byte) {
+			binary.BigEndian.PutUint64(hdr[72:], qcow2IncompatDirty)
+		}
+		Expect(IsQCOW2Streamable(q.build()[:MaxExpectedHdrSize])).To(BeTrue())
+	})
+})
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importcache
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"kubevirt.io/containerized-data-importer/pkg/common"
+)
+
+const (
+	// The cache Service only routes to the cache pod of the node, connecting fails fast without one
+	dialTimeout = 2 * time.Second
+	// putTimeout bounds waiting for the cache to store a blob once it was read
+	putTimeout = time.Minute
+)
+
+var errIncompleteBlob = errors.New("blob was not read to the end")
+
+// Client reads from and populates the node-local import cache. The cache is best effort, a cache which is not
+// available is a miss.
+type Client struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewClientFromEnv returns a client of the cache configured for the importer pod, or nil if there is none
+func NewClientFromEnv() *Client {
+	cacheURL := os.Getenv(common.ImportCacheURL)
+	if cacheURL == "" {
+		return nil
+	}
+	return NewClient(cacheURL, os.Getenv(common.ImportCacheToken))
+}
+
+// NewClient returns a client of the cache at the URL, authorized by the token
+func NewClient(cacheURL, token string) *Client {
+	// No proxy, the cache is local to the node
+	transport := &http.Transport{
+		DialContext: (&net.Dialer{Timeout: dialTimeout}).DialContext,
+	}
+	return &Client{
+		url:    strings.TrimSuffix(cacheURL, "/"),
+		token:  token,
+		client: &http.Client{Transport: transport},
+	}
+}
+
+// GetBlob returns the cached registry blob of the digest, or nil on a miss
+func (c *Client) GetBlob(ctx context.Context, digest string) io.ReadCloser {
+	key := BlobKey(digest)
+	if key == "" {
+		return nil
+	}
+	resp := c.get(ctx, c.url+blobsPath+key)
+	if resp == nil {
+		return nil
+	}
+	klog.Infof("Reading blob %s from the import cache", digest)
+	return resp.Body
+}
+
+// GetHTTP returns the response of the URL served through the cache, or nil if it can't be cached
+func (c *Client) GetHTTP(ctx context.Context, sourceURL string) *http.Response {
+	resp := c.get(ctx, c.url+httpPath+"?url="+url.QueryEscape(sourceURL))
+	if resp != nil {
+		klog.Infof("Reading %s through the import cache, cache %s", sourceURL, resp.Header.Get(CacheStatusHeader))
+	}
+	return resp
+}
+
+func (c *Client) get(ctx context.Context, cacheURL string) *http.Response {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cacheURL, nil)
+	if err != nil {
+		klog.Warningf("Unable to read from the import cache: %v", err)
+		return nil
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		klog.Warningf("Import cache is not available: %v", err)
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil
+	}
+	return resp
+}
+
+// CacheBlob returns a reader of the blob, which also writes it to the cache. The blob is cached once read to the end,
+// closing the reader reads the rest of the blob. Failing to cache the blob doesn't fail reading it.
+func (c *Client) CacheBlob(digest string, reader io.ReadCloser) io.ReadCloser {
+	key := BlobKey(digest)
+	if key == "" {
+		return reader
+	}
+	pr, pw := io.Pipe()
+	cr := &cachingReader{reader: reader, pipe: pw, done: make(chan struct{})}
+	go func() {
+		defer close(cr.done)
+		req, err := http.NewRequest(http.MethodPut, c.url+blobsPath+key, pr)
+		if err != nil {
+			pr.CloseWithError(err)
+			return
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		resp, err := c.client.Do(req)
+		if err != nil {
+			klog.Warningf("Unable to cache blob %s: %v", digest, err)
+			pr.CloseWithError(err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			klog.Warningf("Unable to cache blob %s: %s", digest, resp.Status)
+		}
+		pr.CloseWithError(errors.Errorf("blob upload completed with %s", resp.Status))
+	}()
+	return cr
+}
+
+// cachingReader writes what it reads to the cache upload
+type cachingReader struct {
+	reader io.ReadCloser
+	pipe   *io.PipeWriter
+	done   chan struct{}
+	eof    bool
+	failed bool
+}
+
+func (r *cachingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 && !r.failed {
+		if _, werr := r.pipe.Write(p[:n]); werr != nil {
+			r.failed = true
+		}
+	}
+	if err == io.EOF && !r.eof {
+		r.eof = true
+		r.pipe.Close()
+	}
+	return n, err
+}
+
+func (r *cachingReader) Close() error {
+	if !r.eof && !r.failed {
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			klog.Warningf("Unable to read the rest of the blob to cache it: %v", err)
+		}
+	}
+	if !r.eof {
+		r.pipe.CloseWithError(errIncompleteBlob)
+	}
+	select {
+	case <-r.done:
+	case <-time.After(putTimeout):
+		klog.Warningf("Timed out caching the blob")
+	}
+	return r.reader.Close()
+}
@@ -0,0 +1,13 @@
+package importcache
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestImportCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Import Cache Suite")
+}
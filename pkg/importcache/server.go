@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importcache
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"kubevirt.io/containerized-data-importer/pkg/token"
+)
+
+const (
+	blobsPath   = "/blobs/"
+	httpPath    = "/http"
+	healthzPath = "/healthz"
+
+	// CacheStatusHeader tells whether a response was served from the cache
+	CacheStatusHeader = "X-Cdi-Import-Cache"
+	cacheHit          = "hit"
+	cacheMiss         = "miss"
+
+	// TokenURLParam is the token parameter holding the only HTTP source the importer may read through the cache
+	TokenURLParam = "url"
+)
+
+// Server serves a store over HTTP:
+//
+//	GET /blobs/<key> serves a cached registry blob, or 404 on a miss
+//	PUT /blobs/<key> caches a registry blob, which must match the digest of its key
+//	GET /http?url=<url> serves the URL through the cache, keyed by the URL and its ETag, or 404 if it can't be cached
+//
+// Requests carry the import cache token the controller gave the importer pod, and an HTTP source is only served to
+// the importer whose token holds its URL. HTTP entries are only written by the server itself, so importer pods can't
+// put content under another URL.
+type Server struct {
+	store     *Store
+	client    *http.Client
+	validator token.Validator
+}
+
+// NewServer creates a server of the store, serving the requests whose token the validator accepts
+func NewServer(store *Store, validator token.Validator) *Server {
+	return &Server{
+		store:     store,
+		client:    &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
+		validator: validator,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == healthzPath {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	payload, ok := s.authorize(r)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case strings.HasPrefix(r.URL.Path, blobsPath):
+		key := strings.TrimPrefix(r.URL.Path, blobsPath)
+		if !strings.HasPrefix(key, blobKeyPrefix) || !ValidKey(key) {
+			http.Error(w, "invalid blob key", http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			if !s.serveEntry(w, r, key) {
+				http.NotFound(w, r)
+			}
+		case http.MethodPut:
+			s.putBlob(w, r, key)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	case r.URL.Path == httpPath && r.Method == http.MethodGet:
+		s.serveHTTPSource(w, r, payload)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// authorize returns the payload of the import cache token of the request
+func (s *Server) authorize(r *http.Request) (*token.Payload, bool) {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, false
+	}
+	payload, err := s.validator.Validate(strings.TrimPrefix(authHeader, "Bearer "))
+	if err != nil {
+		klog.V(1).Infof("Rejected import cache token: %v", err)
+		return nil, false
+	}
+	return payload, payload.Operation == token.OperationImportCache
+}
+
+// serveEntry serves the entry of the key, returning false on a miss
+func (s *Server) serveEntry(w http.ResponseWriter, r *http.Request, key string) bool {
+	file, err := s.store.Open(key)
+	if os.IsNotExist(err) {
+		return false
+	} else if err != nil {
+		klog.Errorf("Unable to open cache entry %s: %v", key, err)
+		return false
+	}
+	defer file.Close()
+	klog.V(1).Infof("Serving cache entry %s", key)
+	w.Header().Set(CacheStatusHeader, cacheHit)
+	http.ServeContent(w, r, "", time.Time{}, file)
+	return true
+}
+
+func (s *Server) putBlob(w http.ResponseWriter, r *http.Request, key string) {
+	entry, err := s.store.Create(key)
+	if err != nil {
+		klog.Errorf("Unable to create cache entry %s: %v", key, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := io.Copy(entry, r.Body); err != nil {
+		entry.Abort()
+		klog.Errorf("Unable to cache blob %s: %v", key, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := entry.Commit(); err != nil {
+		klog.Errorf("Unable to cache blob %s: %v", key, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// serveHTTPSource serves the URL from the cache if its current ETag is cached, otherwise it downloads it
+// while caching it. URLs without a strong ETag are not cached, and the importer reads them directly.
+func (s *Server) serveHTTPSource(w http.ResponseWriter, r *http.Request, payload *token.Payload) {
+	source := r.URL.Query().Get("url")
+	sourceURL, err := url.Parse(source)
+	if err != nil || (sourceURL.Scheme != "http" && sourceURL.Scheme != "https") {
+		http.Error(w, "invalid url", http.StatusBadRequest)
+		return
+	}
+	if payload.Params[TokenURLParam] != source {
+		http.Error(w, "url not allowed by the token", http.StatusForbidden)
+		return
+	}
+
+	etag, err := s.getETag(r, source)
+	if err != nil || etag == "" {
+		klog.V(1).Infof("Not caching %s: %v", source, err)
+		http.Error(w, "not cacheable", http.StatusNotFound)
+		return
+	}
+	key := HTTPKey(source, etag)
+	if s.serveEntry(w, r, key) {
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, source, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		klog.Errorf("Unable to download %s: %v", source, err)
+		http.Error(w, "not cacheable", http.StatusNotFound)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") != etag {
+		// Changed meanwhile, let the importer read it
+		http.Error(w, "not cacheable", http.StatusNotFound)
+		return
+	}
+
+	entry, err := s.store.Create(key)
+	if err != nil {
+		klog.Errorf("Unable to create cache entry %s: %v", key, err)
+		http.Error(w, "not cacheable", http.StatusNotFound)
+		return
+	}
+	cacheWriter := &bestEffortWriter{writer: entry}
+	if resp.ContentLength >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(resp.ContentLength, 10))
+	}
+	w.Header().Set(CacheStatusHeader, cacheMiss)
+	w.WriteHeader(http.StatusOK)
+	n, err := io.Copy(w, io.TeeReader(resp.Body, cacheWriter))
+	if err != nil || cacheWriter.err != nil || (resp.ContentLength >= 0 && n != resp.ContentLength) {
+		klog.Errorf("Not caching %s, download incomplete: %v %v", source, err, cacheWriter.err)
+		entry.Abort()
+		return
+	}
+	if err := entry.Commit(); err != nil {
+		klog.Errorf("Unable to cache %s: %v", source, err)
+	}
+}
+
+// getETag returns the strong ETag of the URL, or an empty string if it has none
+func (s *Server) getETag(r *http.Request, source string) (string, error) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodHead, source, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil
+	}
+	etag := resp.Header.Get("ETag")
+	if strings.HasPrefix(etag, "W/") {
+		return "", nil
+	}
+	return etag, nil
+}
+
+// bestEffortWriter stops writing on the first error instead of failing the copy it is part of
+type bestEffortWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (w *bestEffortWriter) Write(p []byte) (int, error) {
+	if w.err == nil {
+		_, w.err = w.writer.Write(p)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,181 @@
+package importcache
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	"kubevirt.io/containerized-data-importer/pkg/token"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		cacheServer *httptest.Server
+		client      *Client
+		tokenKey    *rsa.PrivateKey
+	)
+
+	newToken := func(operation token.Operation, params map[string]string) string {
+		generator := token.NewGenerator(common.ImportCacheTokenIssuer, tokenKey, time.Minute)
+		tok, err := generator.Generate(&token.Payload{Operation: operation, Name: "target", Namespace: "default", Params: params})
+		Expect(err).ToNot(HaveOccurred())
+		return tok
+	}
+
+	BeforeEach(func() {
+		var err error
+		tokenKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		store, err := NewStore(GinkgoT().TempDir(), 1024*1024)
+		Expect(err).ToNot(HaveOccurred())
+		cacheServer = httptest.NewServer(NewServer(store, token.NewValidator(common.ImportCacheTokenIssuer, &tokenKey.PublicKey, time.Second)))
+		client = NewClient(cacheServer.URL, newToken(token.OperationImportCache, nil))
+	})
+
+	AfterEach(func() {
+		cacheServer.Close()
+	})
+
+	readAll := func(reader io.ReadCloser) []byte {
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		return data
+	}
+
+	Context("registry blobs", func() {
+		layer := bytes.Repeat([]byte("layer"), 1000)
+		digest := blobDigest(layer)
+
+		It("Should cache a blob once read", func() {
+			Expect(client.GetBlob(context.Background(), digest)).To(BeNil())
+			Expect(readAll(client.CacheBlob(digest, io.NopCloser(bytes.NewReader(layer))))).To(Equal(layer))
+			Expect(readAll(client.GetBlob(context.Background(), digest))).To(Equal(layer))
+		})
+
+		It("Should cache a blob which is partially read before closing", func() {
+			reader := client.CacheBlob(digest, io.NopCloser(bytes.NewReader(layer)))
+			_, err := reader.Read(make([]byte, 10))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reader.Close()).To(Succeed())
+			Expect(readAll(client.GetBlob(context.Background(), digest))).To(Equal(layer))
+		})
+
+		It("Should not cache a blob which doesn't match its digest", func() {
+			tampered := bytes.Repeat([]byte("tampered"), 1000)
+			Expect(readAll(client.CacheBlob(digest, io.NopCloser(bytes.NewReader(tampered))))).To(Equal(tampered))
+			Expect(client.GetBlob(context.Background(), digest)).To(BeNil())
+		})
+
+		DescribeTable("Should reject a request without an import cache token", func(tok func() string) {
+			reader := NewClient(cacheServer.URL, tok()).CacheBlob(digest, io.NopCloser(bytes.NewReader(layer)))
+			Expect(readAll(reader)).To(Equal(layer))
+			Expect(client.GetBlob(context.Background(), digest)).To(BeNil())
+
+			resp, err := http.Get(cacheServer.URL + blobsPath + BlobKey(digest))
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+		},
+			Entry("without token", func() string { return "" }),
+			Entry("with an invalid token", func() string { return "invalid" }),
+			Entry("with an upload token", func() string { return newToken(token.OperationUpload, nil) }),
+		)
+
+		It("Should read the blob when the cache is not available", func() {
+			cacheServer.Close()
+			Expect(client.GetBlob(context.Background(), digest)).To(BeNil())
+			Expect(readAll(client.CacheBlob(digest, io.NopCloser(bytes.NewReader(layer))))).To(Equal(layer))
+		})
+	})
+
+	Context("HTTP sources", func() {
+		var (
+			origin *httptest.Server
+			etag   string
+			gets   int32
+		)
+		image := bytes.Repeat([]byte("image"), 1000)
+
+		BeforeEach(func() {
+			etag = `"v1"`
+			atomic.StoreInt32(&gets, 0)
+			origin = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if etag != "" {
+					w.Header().Set("ETag", etag)
+				}
+				if r.Method == http.MethodGet {
+					atomic.AddInt32(&gets, 1)
+				}
+				http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(image))
+			}))
+			client = NewClient(cacheServer.URL, newToken(token.OperationImportCache, map[string]string{TokenURLParam: origin.URL + "/disk.img"}))
+		})
+
+		AfterEach(func() {
+			origin.Close()
+		})
+
+		getHTTP := func() (string, []byte) {
+			resp := client.GetHTTP(context.Background(), origin.URL+"/disk.img")
+			Expect(resp).ToNot(BeNil())
+			Expect(resp.ContentLength).To(Equal(int64(len(image))))
+			return resp.Header.Get(CacheStatusHeader), readAll(resp.Body)
+		}
+
+		It("Should download the URL once", func() {
+			status, data := getHTTP()
+			Expect(status).To(Equal(cacheMiss))
+			Expect(data).To(Equal(image))
+			status, data = getHTTP()
+			Expect(status).To(Equal(cacheHit))
+			Expect(data).To(Equal(image))
+			Expect(atomic.LoadInt32(&gets)).To(Equal(int32(1)))
+		})
+
+		It("Should download the URL again when its ETag changes", func() {
+			getHTTP()
+			etag = `"v2"`
+			status, _ := getHTTP()
+			Expect(status).To(Equal(cacheMiss))
+			Expect(atomic.LoadInt32(&gets)).To(Equal(int32(2)))
+		})
+
+		DescribeTable("Should not cache a URL", func(urlETag string) {
+			etag = urlETag
+			Expect(client.GetHTTP(context.Background(), origin.URL+"/disk.img")).To(BeNil())
+			Expect(atomic.LoadInt32(&gets)).To(BeZero())
+		},
+			Entry("without ETag", ""),
+			Entry("with a weak ETag", `W/"v1"`),
+		)
+
+		It("Should not cache a URL which is not found", func() {
+			notFound := httptest.NewServer(http.NotFoundHandler())
+			defer notFound.Close()
+			client = NewClient(cacheServer.URL, newToken(token.OperationImportCache, map[string]string{TokenURLParam: notFound.URL + "/disk.img"}))
+			Expect(client.GetHTTP(context.Background(), notFound.URL+"/disk.img")).To(BeNil())
+		})
+
+		It("Should not serve a URL which the token doesn't hold", func() {
+			Expect(client.GetHTTP(context.Background(), origin.URL+"/other.img")).To(BeNil())
+			client = NewClient(cacheServer.URL, newToken(token.OperationImportCache, nil))
+			Expect(client.GetHTTP(context.Background(), origin.URL+"/disk.img")).To(BeNil())
+			Expect(atomic.LoadInt32(&gets)).To(BeZero())
+		})
+
+		It("Should reject URLs which are not HTTP", func() {
+			Expect(client.GetHTTP(context.Background(), "file:///etc/passwd")).To(BeNil())
+		})
+	})
+})
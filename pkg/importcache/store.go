@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	blobKeyPrefix = "sha256-"
+	httpKeyPrefix = "http-"
+	tempPrefix    = ".tmp-"
+)
+
+var keyMatch = regexp.MustCompile(`^(sha256|http)-[0-9a-f]{64}$`)
+
+// BlobKey returns the cache key of a registry blob digest, or an empty string if the digest is not a sha256 one
+func BlobKey(digest string) string {
+	hexDigest := strings.TrimPrefix(digest, "sha256:")
+	if hexDigest == digest || !keyMatch.MatchString(blobKeyPrefix+hexDigest) {
+		return ""
+	}
+	return blobKeyPrefix + hexDigest
+}
+
+// HTTPKey returns the cache key of the content served at the URL with the ETag
+func HTTPKey(url, etag string) string {
+	sum := sha256.Sum256([]byte(url + "\n" + etag))
+	return httpKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// ValidKey tells whether the key is a blob or HTTP cache key
+func ValidKey(key string) bool {
+	return keyMatch.MatchString(key)
+}
+
+// Store keeps the cache entries as files in a directory, evicting the least recently used ones beyond its size limit.
+// The entries being written are bounded by the size limit too, so the directory never holds more than twice the limit.
+type Store struct {
+	dir       string
+	sizeLimit int64
+	lock      sync.Mutex
+	pending   int64
+}
+
+// NewStore creates a store in the directory, removing the entries left over by interrupted writes
+func NewStore(dir string, sizeLimit int64) (*Store, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, errors.Wrap(err, "could not create the cache directory")
+	}
+	temps, err := filepath.Glob(filepath.Join(dir, tempPrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, temp := range temps {
+		if err := os.Remove(temp); err != nil {
+			return nil, errors.Wrap(err, "could not remove a leftover cache entry")
+		}
+	}
+	s := &Store{dir: dir, sizeLimit: sizeLimit}
+	if err := s.evict(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Open opens the entry of the key, marking it as recently used, or returns an os.ErrNotExist error on a miss
+func (s *Store) Open(key string) (*os.File, error) {
+	if !ValidKey(key) {
+		return nil, errors.Errorf("invalid cache key %q", key)
+	}
+	path := filepath.Join(s.dir, key)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		klog.Warningf("Unable to mark cache entry %s as used: %v", key, err)
+	}
+	return file, nil
+}
+
+// Create starts writing the entry of the key, which is only visible once committed
+func (s *Store) Create(key string) (*EntryWriter, error) {
+	if !ValidKey(key) {
+		return nil, errors.Errorf("invalid cache key %q", key)
+	}
+	file, err := os.CreateTemp(s.dir, tempPrefix+key+"-")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create a cache entry")
+	}
+	entry := &EntryWriter{store: s, key: key, file: file}
+	if strings.HasPrefix(key, blobKeyPrefix) {
+		entry.digest = sha256.New()
+	}
+	return entry, nil
+}
+
+// reserve counts the bytes written to entries which are not committed yet
+func (s *Store) reserve(n int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending+n > s.sizeLimit {
+		return errors.New("cache entries being written exceed the cache size limit")
+	}
+	s.pending += n
+	return nil
+}
+
+func (s *Store) release(n int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pending -= n
+}
+
+// evict removes the least recently used entries until the entries fit in the size limit
+func (s *Store) evict() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	var entries []os.FileInfo
+	var total int64
+	for _, dirEntry := range dirEntries {
+		if !ValidKey(dirEntry.Name()) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			// Removed meanwhile
+			continue
+		}
+		entries = append(entries, info)
+		total += info.Size()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime().Before(entries[j].ModTime())
+	})
+	for _, entry := range entries {
+		if total <= s.sizeLimit {
+			break
+		}
+		// Readers of the entry keep reading the removed file
+		if err := os.Remove(filepath.Join(s.dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		klog.V(1).Infof("Evicted cache entry %s", entry.Name())
+		total -= entry.Size()
+	}
+	return nil
+}
+
+// EntryWriter writes a cache entry
+type EntryWriter struct {
+	store    *Store
+	key      string
+	file     *os.File
+	digest   hash.Hash
+	size     int64
+	reserved int64
+}
+
+func (e *EntryWriter) Write(p []byte) (int, error) {
+	if err := e.store.reserve(int64(len(p))); err != nil {
+		return 0, err
+	}
+	e.reserved += int64(len(p))
+	n, err := e.file.Write(p)
+	if e.digest != nil {
+		e.digest.Write(p[:n])
+	}
+	e.size += int64(n)
+	return n, err
+}
+
+// Commit makes the entry visible, after checking that a blob matches its digest
+func (e *EntryWriter) Commit() error {
+	if e.digest != nil && blobKeyPrefix+hex.EncodeToString(e.digest.Sum(nil)) != e.key {
+		e.Abort()
+		return errors.Errorf("cache entry does not match digest %s", e.key)
+	}
+	if e.size > e.store.sizeLimit {
+		e.Abort()
+		return errors.Errorf("cache entry of %d bytes exceeds the cache size limit", e.size)
+	}
+	if err := e.file.Sync(); err != nil {
+		e.Abort()
+		return err
+	}
+	if err := e.file.Close(); err != nil {
+		e.Abort()
+		return err
+	}
+	if err := os.Rename(e.file.Name(), filepath.Join(e.store.dir, e.key)); err != nil {
+		e.Abort()
+		return err
+	}
+	e.releaseReserved()
+	klog.V(1).Infof("Cached entry %s of %d bytes", e.key, e.size)
+	return e.store.evict()
+}
+
+// Abort discards the entry
+func (e *EntryWriter) Abort() {
+	e.releaseReserved()
+	e.file.Close()
+	if err := os.Remove(e.file.Name()); err != nil && !os.IsNotExist(err) {
+		klog.Warningf("Unable to remove cache entry %s: %v", e.file.Name(), err)
+	}
+}
+
+func (e *EntryWriter) releaseReserved() {
+	e.store.release(e.reserved)
+	e.reserved = 0
+}
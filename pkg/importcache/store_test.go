@@ -0,0 +1,147 @@
+package importcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func blobDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func putEntry(store *Store, key string, data []byte) error {
+	entry, err := store.Create(key)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = entry.Write(data)
+	Expect(err).ToNot(HaveOccurred())
+	return entry.Commit()
+}
+
+func readEntry(store *Store, key string) []byte {
+	file, err := store.Open(key)
+	Expect(err).ToNot(HaveOccurred())
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	Expect(err).ToNot(HaveOccurred())
+	return data
+}
+
+var _ = Describe("Store", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	DescribeTable("BlobKey", func(digest, expected string) {
+		Expect(BlobKey(digest)).To(Equal(expected))
+	},
+		Entry("sha256 digest", "sha256:"+hex.EncodeToString(make([]byte, 32)), "sha256-"+hex.EncodeToString(make([]byte, 32))),
+		Entry("sha512 digest", "sha512:"+hex.EncodeToString(make([]byte, 64)), ""),
+		Entry("malformed digest", "sha256:../../etc", ""),
+	)
+
+	It("Should key HTTP entries by URL and ETag", func() {
+		key := HTTPKey("http://example.com/disk.img", `"v1"`)
+		Expect(ValidKey(key)).To(BeTrue())
+		Expect(HTTPKey("http://example.com/disk.img", `"v2"`)).ToNot(Equal(key))
+	})
+
+	It("Should store an entry which matches its digest", func() {
+		store, err := NewStore(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		key := BlobKey(blobDigest([]byte("layer")))
+		_, err = store.Open(key)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(putEntry(store, key, []byte("layer"))).To(Succeed())
+		Expect(readEntry(store, key)).To(Equal([]byte("layer")))
+	})
+
+	It("Should not store a blob which doesn't match its digest", func() {
+		store, err := NewStore(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		key := BlobKey(blobDigest([]byte("layer")))
+		Expect(putEntry(store, key, []byte("tampered"))).ToNot(Succeed())
+		_, err = store.Open(key)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		files, err := os.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+
+	It("Should not store an entry larger than the size limit", func() {
+		store, err := NewStore(dir, 4)
+		Expect(err).ToNot(HaveOccurred())
+		entry, err := store.Create(HTTPKey("http://example.com/disk.img", `"v1"`))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = entry.Write([]byte("too large"))
+		Expect(err).To(HaveOccurred())
+		entry.Abort()
+	})
+
+	It("Should bound the entries being written by the size limit", func() {
+		store, err := NewStore(dir, 10)
+		Expect(err).ToNot(HaveOccurred())
+		first, err := store.Create(HTTPKey("http://example.com/1", `"v1"`))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = first.Write([]byte("aaaaaaaa"))
+		Expect(err).ToNot(HaveOccurred())
+		second, err := store.Create(HTTPKey("http://example.com/2", `"v1"`))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = second.Write([]byte("bbbb"))
+		Expect(err).To(HaveOccurred())
+		second.Abort()
+
+		By("Writing again once the first entry is committed")
+		Expect(first.Commit()).To(Succeed())
+		Expect(putEntry(store, HTTPKey("http://example.com/2", `"v1"`), []byte("bbbb"))).To(Succeed())
+	})
+
+	It("Should evict the least recently used entries beyond the size limit", func() {
+		store, err := NewStore(dir, 10)
+		Expect(err).ToNot(HaveOccurred())
+		first, second, third := HTTPKey("http://example.com/1", `"v1"`), HTTPKey("http://example.com/2", `"v1"`), HTTPKey("http://example.com/3", `"v1"`)
+		Expect(putEntry(store, first, []byte("aaaa"))).To(Succeed())
+		Expect(putEntry(store, second, []byte("bbbb"))).To(Succeed())
+		past := time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(filepath.Join(dir, first), past, past)).To(Succeed())
+		Expect(os.Chtimes(filepath.Join(dir, second), past.Add(-time.Minute), past.Add(-time.Minute))).To(Succeed())
+		// Reading the second entry makes it the most recently used
+		Expect(readEntry(store, second)).To(Equal([]byte("bbbb")))
+
+		Expect(putEntry(store, third, []byte("cccc"))).To(Succeed())
+		_, err = store.Open(first)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(readEntry(store, second)).To(Equal([]byte("bbbb")))
+		Expect(readEntry(store, third)).To(Equal([]byte("cccc")))
+	})
+
+	It("Should remove entries left over by interrupted writes", func() {
+		store, err := NewStore(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = store.Create(BlobKey(blobDigest([]byte("layer"))))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = NewStore(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		files, err := os.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+
+	It("Should reject invalid keys", func() {
+		store, err := NewStore(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = store.Open("../secret")
+		Expect(err).To(HaveOccurred())
+		_, err = store.Create("../secret")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
+	"syscall"
 
 	"github.com/pkg/errors"
 
@@ -52,6 +54,8 @@ const (
 	ProcessingPhaseConvert ProcessingPhase = "Convert"
 	// ProcessingPhaseResize the disk image, this is only needed when the target contains a file system (block device do not need a resize)
 	ProcessingPhaseResize ProcessingPhase = "Resize"
+	// ProcessingPhaseSparsify deallocates the all-zero blocks of the target, so thin-provisioned storage only keeps the data
+	ProcessingPhaseSparsify ProcessingPhase = "Sparsify"
 	// ProcessingPhaseComplete is the phase where the entire process completed successfully and we can exit gracefully.
 	ProcessingPhaseComplete ProcessingPhase = "Complete"
 	// ProcessingPhasePause is the phase where we pause processing and end the loop, and expect something to call the process loop again.
@@ -72,12 +76,16 @@ func (e ValidationSizeError) Error() string { return e.err.Error() }
 // ErrRequiresScratchSpace indicates that we require scratch space.
 var ErrRequiresScratchSpace = fmt.Errorf(common.ScratchSpaceRequired)
 
+// ErrScratchSpaceTooSmall indicates that the scratch space sized from an estimate is too small.
+var ErrScratchSpaceTooSmall = fmt.Errorf(common.ScratchSpaceTooSmall)
+
 // ErrInvalidPath indicates that the path is invalid.
 var ErrInvalidPath = fmt.Errorf("invalid transfer path")
 
 // may be overridden in tests
 var getAvailableSpaceBlockFunc = util.GetAvailableSpaceBlock
 var getAvailableSpaceFunc = util.GetAvailableSpace
+var sparsifyFunc = Sparsify
 
 // DataSourceInterface is the interface all data sources should implement.
 type DataSourceInterface interface {
@@ -93,6 +101,13 @@ type DataSourceInterface interface {
 	Close() error
 }
 
+// ScratchSizeEstimator is implemented by data sources which can estimate the size of the data they write to scratch
+// space, so that the scratch space doesn't have to be as large as the target.
+type ScratchSizeEstimator interface {
+	// ScratchSizeEstimate returns the estimated size in bytes, or 0 if unknown.
+	ScratchSizeEstimate() int64
+}
+
 // ResumableDataSource is the interface all resumeable data sources should implement
 type ResumableDataSource interface {
 	DataSourceInterface
@@ -121,12 +136,20 @@ type DataProcessor struct {
 	preallocation bool
 	// preallocationApplied is used to pass information whether preallocation has been performed, or not
 	preallocationApplied bool
+	// sparsify is the flag enabling the sparsify phase after the resize
+	sparsify bool
+	// sparsifyApplied is used to pass information whether the sparsify phase ran, or not
+	sparsifyApplied bool
+	// sparsifyResult is what the sparsify phase deallocated
+	sparsifyResult SparsifyResult
+	// scratchSizeEstimate is the size of the scratch space the source estimated when there was none
+	scratchSizeEstimate int64
 	// phaseExecutors is a mapping from the given processing phase to its execution function. The function returns the next processing phase or error.
 	phaseExecutors map[ProcessingPhase]func() (ProcessingPhase, error)
 }
 
 // NewDataProcessor create a new instance of a data processor using the passed in data provider.
-func NewDataProcessor(dataSource DataSourceInterface, dataFile, dataDir, scratchDataDir, requestImageSize string, filesystemOverhead float64, preallocation bool) *DataProcessor {
+func NewDataProcessor(dataSource DataSourceInterface, dataFile, dataDir, scratchDataDir, requestImageSize string, filesystemOverhead float64, preallocation, sparsify bool) *DataProcessor {
 	dp := &DataProcessor{
 		currentPhase:       ProcessingPhaseInfo,
 		source:             dataSource,
@@ -136,6 +159,7 @@ func NewDataProcessor(dataSource DataSourceInterface, dataFile, dataDir, scratch
 		requestImageSize:   requestImageSize,
 		filesystemOverhead: filesystemOverhead,
 		preallocation:      preallocation,
+		sparsify:           sparsify,
 	}
 	// Calculate available space before doing anything.
 	dp.availableSpace = dp.calculateTargetSize()
@@ -181,7 +205,12 @@ func (dp *DataProcessor) initDefaultPhases() {
 		pp, err := dp.source.Transfer(dp.scratchDataDir)
 		if err == ErrInvalidPath {
 			// Passed in invalid scratch space path, return scratch space needed error.
+			dp.estimateScratchSize()
 			err = ErrRequiresScratchSpace
+		} else if errors.Is(err, syscall.ENOSPC) && isScratchSizeEstimated() {
+			// The estimate was wrong, the import is retried with scratch space as large as the target.
+			klog.Errorf("%+v", err)
+			err = ErrScratchSpaceTooSmall
 		} else if err != nil {
 			err = errors.Wrap(err, "Unable to transfer source data to scratch space")
 		}
@@ -196,7 +225,10 @@ func (dp *DataProcessor) initDefaultPhases() {
 	})
 	dp.RegisterPhaseExecutor(ProcessingPhaseTransferDataFile, func() (ProcessingPhase, error) {
 		pp, err := dp.source.TransferFile(dp.dataFile)
-		if err != nil {
+		if err == ErrRequiresScratchSpace {
+			// The source could not be written to the target while it is read after all
+			dp.estimateScratchSize()
+		} else if err != nil {
 			err = errors.Wrap(err, "Unable to transfer source data to target file")
 		}
 		return pp, err
@@ -223,6 +255,13 @@ func (dp *DataProcessor) initDefaultPhases() {
 		}
 		return pp, err
 	})
+	dp.RegisterPhaseExecutor(ProcessingPhaseSparsify, func() (ProcessingPhase, error) {
+		pp, err := dp.sparsifyTarget()
+		if err != nil {
+			err = errors.Wrap(err, "Unable to sparsify disk image")
+		}
+		return pp, err
+	})
 	dp.RegisterPhaseExecutor(ProcessingPhaseMergeDelta, func() (ProcessingPhase, error) {
 		pp, err := dp.merge()
 		if err != nil {
@@ -232,6 +271,23 @@ func (dp *DataProcessor) initDefaultPhases() {
 	})
 }
 
+// ScratchSizeEstimate returns the size of the scratch space estimated by the data source when processing failed
+// with ErrRequiresScratchSpace, or 0 if unknown.
+func (dp *DataProcessor) ScratchSizeEstimate() int64 {
+	return dp.scratchSizeEstimate
+}
+
+func (dp *DataProcessor) estimateScratchSize() {
+	if estimator, ok := dp.source.(ScratchSizeEstimator); ok {
+		dp.scratchSizeEstimate = estimator.ScratchSizeEstimate()
+	}
+}
+
+func isScratchSizeEstimated() bool {
+	estimated, _ := strconv.ParseBool(os.Getenv(common.ImporterScratchSizeEstimated))
+	return estimated
+}
+
 // ProcessDataWithPause is the main processing loop.
 func (dp *DataProcessor) ProcessDataWithPause() error {
 	visited := make(map[ProcessingPhase]bool, len(dp.phaseExecutors))
@@ -317,6 +373,29 @@ func (dp *DataProcessor) resize() (ProcessingPhase, error) {
 		}
 	}
 
+	// A preallocated target is meant to keep all its blocks allocated
+	if dp.sparsify && !dp.preallocation && dp.dataFile != "" {
+		return ProcessingPhaseSparsify, nil
+	}
+	return ProcessingPhaseComplete, nil
+}
+
+func (dp *DataProcessor) sparsifyTarget() (ProcessingPhase, error) {
+	klog.V(1).Infoln("Sparsifying image")
+	result, err := sparsifyFunc(dp.dataFile)
+	if errors.Is(err, ErrSparsifyNotSupported) {
+		// Sparsifying is an optimization, the import is complete without it
+		klog.Warningf("Not sparsifying image: %v", err)
+		return ProcessingPhaseComplete, nil
+	} else if err != nil {
+		return ProcessingPhaseError, err
+	}
+	klog.V(1).Infof("Sparsify unmapped %d bytes", result.Unmapped)
+	if result.ReclaimedKnown {
+		klog.V(1).Infof("Sparsify reclaimed %d bytes", result.Reclaimed)
+	}
+	dp.sparsifyApplied = true
+	dp.sparsifyResult = result
 	return ProcessingPhaseComplete, nil
 }
 
@@ -388,6 +467,11 @@ func (dp *DataProcessor) PreallocationApplied() bool {
 	return dp.preallocationApplied
 }
 
+// SparsifyResult returns what the sparsify phase deallocated, and whether it ran
+func (dp *DataProcessor) SparsifyResult() (SparsifyResult, bool) {
+	return dp.sparsifyResult, dp.sparsifyApplied
+}
+
 func (dp *DataProcessor) getUsableSpace() int64 {
 	return util.GetUsableSpace(dp.filesystemOverhead, dp.availableSpace)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"syscall"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	"kubevirt.io/containerized-data-importer/pkg/image"
 )
 
@@ -94,6 +96,29 @@ func (m *MockDataProvider) Close() error {
 	return nil
 }
 
+type MockScratchDataProvider struct {
+	MockDataProvider
+	transferErr         error
+	scratchSizeEstimate int64
+}
+
+// Transfer is called to transfer the data from the source to the passed in path.
+func (m *MockScratchDataProvider) Transfer(path string) (ProcessingPhase, error) {
+	m.calledPhases = append(m.calledPhases, m.infoResponse)
+	return ProcessingPhaseError, m.transferErr
+}
+
+// TransferFile is called to transfer the data from the source to the passed in file.
+func (m *MockScratchDataProvider) TransferFile(fileName string) (ProcessingPhase, error) {
+	m.calledPhases = append(m.calledPhases, ProcessingPhaseTransferDataFile)
+	return ProcessingPhaseError, m.transferErr
+}
+
+// ScratchSizeEstimate returns the estimated size of the scratch space.
+func (m *MockScratchDataProvider) ScratchSizeEstimate() int64 {
+	return m.scratchSizeEstimate
+}
+
 type MockAsyncDataProvider struct {
 	MockDataProvider
 	ResumePhase ProcessingPhase
@@ -147,7 +172,7 @@ var _ = Describe("Data Processor", func() {
 			infoResponse:     ProcessingPhaseTransferScratch,
 			transferResponse: ProcessingPhaseComplete,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).ToNot(HaveOccurred())
 		Expect(2).To(Equal(len(mdp.calledPhases)))
@@ -161,7 +186,7 @@ var _ = Describe("Data Processor", func() {
 			infoResponse:     ProcessingPhaseTransferDataDir,
 			transferResponse: ProcessingPhaseComplete,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).ToNot(HaveOccurred())
 		Expect(2).To(Equal(len(mdp.calledPhases)))
@@ -175,7 +200,7 @@ var _ = Describe("Data Processor", func() {
 			infoResponse:     ProcessingPhaseTransferScratch,
 			transferResponse: ProcessingPhaseError,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).To(HaveOccurred())
 		Expect(2).To(Equal(len(mdp.calledPhases)))
@@ -189,7 +214,7 @@ var _ = Describe("Data Processor", func() {
 			transferResponse: ProcessingPhaseError,
 			needsScratch:     true,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).To(HaveOccurred())
 		Expect(ErrRequiresScratchSpace).To(Equal(err))
@@ -198,12 +223,54 @@ var _ = Describe("Data Processor", func() {
 		Expect(ProcessingPhaseTransferScratch).To(Equal(mdp.calledPhases[1]))
 	})
 
+	It("should estimate the scratch space size if scratch space is required", func() {
+		mdp := &MockScratchDataProvider{
+			MockDataProvider:    MockDataProvider{infoResponse: ProcessingPhaseTransferScratch},
+			transferErr:         ErrInvalidPath,
+			scratchSizeEstimate: 1024,
+		}
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
+		Expect(dp.ProcessData()).To(Equal(ErrRequiresScratchSpace))
+		Expect(dp.ScratchSizeEstimate()).To(Equal(int64(1024)))
+	})
+
+	It("should require scratch space if the source can't be written to the target while it is read", func() {
+		mdp := &MockScratchDataProvider{
+			MockDataProvider:    MockDataProvider{infoResponse: ProcessingPhaseTransferDataFile},
+			transferErr:         ErrRequiresScratchSpace,
+			scratchSizeEstimate: 1024,
+		}
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
+		Expect(dp.ProcessData()).To(Equal(ErrRequiresScratchSpace))
+		Expect(dp.ScratchSizeEstimate()).To(Equal(int64(1024)))
+	})
+
+	DescribeTable("should error on Transfer phase when out of space", func(estimated string, expectedErr error) {
+		os.Setenv(common.ImporterScratchSizeEstimated, estimated)
+		defer os.Unsetenv(common.ImporterScratchSizeEstimated)
+		mdp := &MockScratchDataProvider{
+			MockDataProvider: MockDataProvider{infoResponse: ProcessingPhaseTransferScratch},
+			transferErr:      errors.Wrap(&os.PathError{Op: "write", Path: "scratch", Err: syscall.ENOSPC}, "unable to write to file"),
+		}
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
+		err := dp.ProcessData()
+		if expectedErr != nil {
+			Expect(err).To(Equal(expectedErr))
+		} else {
+			Expect(err).ToNot(Equal(ErrScratchSpaceTooSmall))
+			Expect(errors.Is(err, syscall.ENOSPC)).To(BeTrue())
+		}
+	},
+		Entry("with scratch space sized from an estimate", "true", ErrScratchSpaceTooSmall),
+		Entry("with full size scratch space", "", nil),
+	)
+
 	It("should call the right phases based on the responses from the provider, TransferDataFile should pass the data file", func() {
 		mdp := &MockDataProvider{
 			infoResponse:     ProcessingPhaseTransferDataFile,
 			transferResponse: ProcessingPhaseComplete,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, errors.New("Scratch space required, and none found ")}, nil, nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			err := dp.ProcessData()
@@ -219,7 +286,7 @@ var _ = Describe("Data Processor", func() {
 			infoResponse:     ProcessingPhaseTransferDataFile,
 			transferResponse: ProcessingPhaseError,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewQEMUAllErrors()
 		replaceQEMUOperations(qemuOperations, func() {
 			err := dp.ProcessData()
@@ -234,7 +301,7 @@ var _ = Describe("Data Processor", func() {
 		mdp := &MockDataProvider{
 			infoResponse: ProcessingPhase("invalidphase"),
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).To(HaveOccurred())
 		Expect(1).To(Equal(len(mdp.calledPhases)))
@@ -253,7 +320,7 @@ var _ = Describe("Data Processor", func() {
 			transferResponse: ProcessingPhaseConvert,
 			url:              url,
 		}
-		dp := NewDataProcessor(mdp, "", "dataDir", tmpDir, "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "", "dataDir", tmpDir, "1G", 0.055, false, false)
 		dp.availableSpace = int64(1536000)
 		usableSpace := dp.getUsableSpace()
 
@@ -276,7 +343,7 @@ var _ = Describe("Data Processor", func() {
 			},
 			fooResponse: ProcessingPhaseComplete,
 		}
-		dp := NewDataProcessor(mcdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mcdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		dp.RegisterPhaseExecutor(ProcessingPhaseFoo, func() (ProcessingPhase, error) {
 			return mcdp.Foo()
 		})
@@ -298,7 +365,7 @@ var _ = Describe("Data Processor", func() {
 			},
 			fooResponse: ProcessingPhaseInfo,
 		}
-		dp := NewDataProcessor(mcdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mcdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		dp.RegisterPhaseExecutor(ProcessingPhaseFoo, func() (ProcessingPhase, error) {
 			return mcdp.Foo()
 		})
@@ -310,7 +377,7 @@ var _ = Describe("Data Processor", func() {
 		mdp := &MockDataProvider{
 			infoResponse: "unknown",
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		err := dp.ProcessData()
 		Expect(err).To(HaveOccurred())
 	})
@@ -323,7 +390,7 @@ var _ = Describe("Convert", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, errors.New("Scratch space required, and none found ")}, nil, nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.convert(mdp.GetURL())
@@ -338,7 +405,7 @@ var _ = Describe("Convert", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, errors.New("Scratch space required, and none found ")}, errors.New("Validation failure"), nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.convert(mdp.GetURL())
@@ -353,7 +420,7 @@ var _ = Describe("Convert", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(errors.New("Conversion failure"), nil, fakeInfoOpRetVal{&fakeZeroImageInfo, errors.New("Scratch space required, and none found ")}, nil, nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.convert(mdp.GetURL())
@@ -372,7 +439,7 @@ var _ = Describe("Resize", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, tempDir, "dataDir", "scratchDataDir", "", 0.055, false)
+		dp := NewDataProcessor(mdp, tempDir, "dataDir", "scratchDataDir", "", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, nil}, nil, nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.resize()
@@ -394,7 +461,7 @@ var _ = Describe("Resize", func() {
 			mdp := &MockDataProvider{
 				url: url,
 			}
-			dp := NewDataProcessor(mdp, tempDir, "dataDir", "scratchDataDir", "1G", 0.055, false)
+			dp := NewDataProcessor(mdp, tempDir, "dataDir", "scratchDataDir", "1G", 0.055, false, false)
 			qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, nil}, nil, nil, nil)
 			replaceQEMUOperations(qemuOperations, func() {
 				nextPhase, err := dp.resize()
@@ -412,7 +479,7 @@ var _ = Describe("Resize", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, tmpDir, tmpDir, "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, tmpDir, tmpDir, "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, nil}, nil, nil, nil)
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.resize()
@@ -429,7 +496,7 @@ var _ = Describe("Resize", func() {
 		mdp := &MockDataProvider{
 			url: url,
 		}
-		dp := NewDataProcessor(mdp, "dest", tmpDir, "scratchDataDir", "1G", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", tmpDir, "scratchDataDir", "1G", 0.055, false, false)
 		qemuOperations := NewQEMUAllErrors()
 		replaceQEMUOperations(qemuOperations, func() {
 			nextPhase, err := dp.resize()
@@ -443,7 +510,7 @@ var _ = Describe("Resize", func() {
 			return int64(100000), nil
 		}, func() {
 			mdp := &MockDataProvider{}
-			dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false)
+			dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false, false)
 			Expect(int64(100000)).To(Equal(dp.calculateTargetSize()))
 		})
 	})
@@ -453,7 +520,7 @@ var _ = Describe("Resize", func() {
 			return int64(-1), errors.New("error")
 		}, func() {
 			mdp := &MockDataProvider{}
-			dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false)
+			dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false, false)
 			// We just log the error if one happens.
 			Expect(int64(-1)).To(Equal(dp.calculateTargetSize()))
 
@@ -461,6 +528,72 @@ var _ = Describe("Resize", func() {
 	})
 })
 
+var _ = Describe("Sparsify", func() {
+	It("Should sparsify after the resize, when sparsify is requested", func() {
+		tempDir, err := os.MkdirTemp(os.TempDir(), "dest")
+		Expect(err).ToNot(HaveOccurred())
+		dp := NewDataProcessor(&MockDataProvider{}, tempDir, "dataDir", "scratchDataDir", "", 0.055, false, true)
+		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, nil}, nil, nil, nil)
+		replaceQEMUOperations(qemuOperations, func() {
+			nextPhase, err := dp.resize()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextPhase).To(Equal(ProcessingPhaseSparsify))
+		})
+	})
+
+	It("Should not sparsify a preallocated target", func() {
+		tempDir, err := os.MkdirTemp(os.TempDir(), "dest")
+		Expect(err).ToNot(HaveOccurred())
+		dp := NewDataProcessor(&MockDataProvider{}, tempDir, "dataDir", "scratchDataDir", "", 0.055, true, true)
+		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&fakeZeroImageInfo, nil}, nil, nil, nil)
+		replaceQEMUOperations(qemuOperations, func() {
+			nextPhase, err := dp.resize()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextPhase).To(Equal(ProcessingPhaseComplete))
+		})
+	})
+
+	It("Should record the deallocated bytes", func() {
+		dp := NewDataProcessor(&MockDataProvider{}, "dest", "dataDir", "scratchDataDir", "", 0.055, false, true)
+		sparsified := SparsifyResult{Unmapped: 2 << 20, Reclaimed: 1 << 20, ReclaimedKnown: true}
+		replaceSparsifyFunc(func(dataFile string) (SparsifyResult, error) {
+			Expect(dataFile).To(Equal("dest"))
+			return sparsified, nil
+		}, func() {
+			nextPhase, err := dp.sparsifyTarget()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextPhase).To(Equal(ProcessingPhaseComplete))
+		})
+		result, applied := dp.SparsifyResult()
+		Expect(applied).To(BeTrue())
+		Expect(result).To(Equal(sparsified))
+	})
+
+	It("Should complete when the target storage can't deallocate ranges", func() {
+		dp := NewDataProcessor(&MockDataProvider{}, "dest", "dataDir", "scratchDataDir", "", 0.055, false, true)
+		replaceSparsifyFunc(func(string) (SparsifyResult, error) {
+			return SparsifyResult{}, ErrSparsifyNotSupported
+		}, func() {
+			nextPhase, err := dp.sparsifyTarget()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextPhase).To(Equal(ProcessingPhaseComplete))
+		})
+		_, applied := dp.SparsifyResult()
+		Expect(applied).To(BeFalse())
+	})
+
+	It("Should fail when sparsifying fails", func() {
+		dp := NewDataProcessor(&MockDataProvider{}, "dest", "dataDir", "scratchDataDir", "", 0.055, false, true)
+		replaceSparsifyFunc(func(string) (SparsifyResult, error) {
+			return SparsifyResult{}, errors.New("read error")
+		}, func() {
+			nextPhase, err := dp.sparsifyTarget()
+			Expect(err).To(HaveOccurred())
+			Expect(nextPhase).To(Equal(ProcessingPhaseError))
+		})
+	})
+})
+
 var _ = Describe("ResizeImage", func() {
 	//fakeInfoRet has info.VirtualSize=1024
 	DescribeTable("calling ResizeImage", func(qemuOperations image.QEMUOperations, imageSize string, totalSpace int64, wantErr bool) {
@@ -484,7 +617,7 @@ var _ = Describe("ResizeImage", func() {
 var _ = Describe("DataProcessorResume", func() {
 	It("Should fail with an error if the data provider cannot resume", func() {
 		mdp := &MockDataProvider{}
-		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false)
+		dp := NewDataProcessor(mdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false, false)
 		err := dp.ProcessDataResume()
 		Expect(err).To(HaveOccurred())
 	})
@@ -493,7 +626,7 @@ var _ = Describe("DataProcessorResume", func() {
 		amdp := &MockAsyncDataProvider{
 			ResumePhase: ProcessingPhaseComplete,
 		}
-		dp := NewDataProcessor(amdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false)
+		dp := NewDataProcessor(amdp, "dest", "dataDir", "scratchDataDir", "", 0.055, false, false)
 		err := dp.ProcessDataResume()
 		Expect(err).ToNot(HaveOccurred())
 	})
@@ -514,7 +647,7 @@ var _ = Describe("MergeDelta", func() {
 			url:              url,
 		}
 
-		dp := NewDataProcessor(mdp, expectedBackingFile, "dataDir", "scratchDataDir", "", 0.055, false)
+		dp := NewDataProcessor(mdp, expectedBackingFile, "dataDir", "scratchDataDir", "", 0.055, false, false)
 		err := errors.New("this operation should not be called")
 		info := &image.ImgInfo{
 			Format:      "",
@@ -613,3 +746,12 @@ func replaceAvailableSpaceBlockFunc(replacement func(string) (int64, error), f f
 	}()
 	f()
 }
+
+func replaceSparsifyFunc(replacement func(string) (SparsifyResult, error), f func()) {
+	origFunc := sparsifyFunc
+	sparsifyFunc = replacement
+	defer func() {
+		sparsifyFunc = origFunc
+	}()
+	f()
+}
@@ -67,13 +67,19 @@ type reader struct {
 
 // FormatReaders contains the stack of readers needed to get information from the input stream (io.ReadCloser)
 type FormatReaders struct {
-	readers        []reader
-	buf            []byte // holds file headers
-	Convert        bool
-	Archived       bool
-	ArchiveXz      bool
-	ArchiveGz      bool
-	ArchiveZstd    bool
+	readers     []reader
+	buf         []byte // holds file headers
+	Convert     bool
+	Archived    bool
+	ArchiveXz   bool
+	ArchiveGz   bool
+	ArchiveZstd bool
+	// VirtualSize is the virtual size of a qcow2 image, read from its header
+	VirtualSize int64
+	// QCOW2Streamable is true if the qcow2 image can be converted while it is read
+	QCOW2Streamable bool
+	// Format is the format of an image which needs to be converted, like qcow2 or vmdk
+	Format         string
 	progressReader *prometheusutil.ProgressReader
 }
 
@@ -197,6 +203,9 @@ func (fr *FormatReaders) fileFormatSelector(hdr *image.Header) {
 		r = nil
 		fr.Convert = true
 	}
+	if fr.Convert && fr.Format == "" {
+		fr.Format = fFmt
+	}
 	if err == nil && r != nil {
 		fr.appendReader(rdrTypM[fFmt], r)
 	}
@@ -232,10 +241,12 @@ func (fr *FormatReaders) zstReader() (io.ReadCloser, error) {
 // Note: size is stored at offset 24 in the qcow2 header.
 func (fr *FormatReaders) qcow2NopReader(h *image.Header) (io.Reader, error) {
 	s := hex.EncodeToString(fr.buf[h.SizeOff : h.SizeOff+h.SizeLen])
-	_, err := strconv.ParseInt(s, 16, 64)
+	size, err := strconv.ParseInt(s, 16, 64)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to determine original qcow2 file size from %+v", s)
 	}
+	fr.VirtualSize = size
+	fr.QCOW2Streamable = image.IsQCOW2Streamable(fr.buf)
 	return nil, nil
 }
 
@@ -296,6 +307,16 @@ func (fr *FormatReaders) Close() (rtnerr error) {
 	return rtnerr
 }
 
+// ScratchSizeEstimate estimates the size of the image the readers write to scratch space, given the size of the
+// source, or returns 0 if it can't be estimated. An uncompressed source is written as is. A compressed qcow2 image is
+// not larger than its virtual size, besides its metadata.
+func (fr *FormatReaders) ScratchSizeEstimate(sourceSize int64) int64 {
+	if !fr.Archived && sourceSize > 0 {
+		return sourceSize
+	}
+	return fr.VirtualSize
+}
+
 // StartProgressUpdate starts the go routine to automatically update the progress on a set interval.
 func (fr *FormatReaders) StartProgressUpdate() {
 	if fr.progressReader != nil {
@@ -89,6 +89,32 @@ var _ = Describe("Format Readers", func() {
 		Entry("should append io.Multireader", rdrMulti, stringRdr, 3, false),
 	)
 
+	DescribeTable("can estimate the scratch space size", func(filename string, sourceSize int64, fromVirtualSize bool) {
+		f, err := os.Open(filename)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		fr, err = NewFormatReaders(f, uint64(0))
+		Expect(err).ToNot(HaveOccurred())
+		if fromVirtualSize {
+			Expect(fr.VirtualSize).To(BeNumerically(">", 0))
+			Expect(fr.ScratchSizeEstimate(sourceSize)).To(Equal(fr.VirtualSize))
+		} else {
+			Expect(fr.ScratchSizeEstimate(sourceSize)).To(Equal(sourceSize))
+		}
+	},
+		Entry("from the source size of an uncompressed image", cirrosFilePath, int64(1024), false),
+		Entry("from the virtual size of a qcow2 image of unknown size", cirrosFilePath, int64(0), true),
+	)
+
+	It("should not estimate the scratch space size of a compressed image which is not qcow2", func() {
+		f, err := os.Open(tinyCoreGzFilePath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		fr, err = NewFormatReaders(f, uint64(0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fr.ScratchSizeEstimate(1024)).To(BeZero())
+	})
+
 	It("should not crash on no progress reader", func() {
 		stringReader := io.NopCloser(strings.NewReader("This is a test string"))
 		testReader, err := NewFormatReaders(stringReader, uint64(0))
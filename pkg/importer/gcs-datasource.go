@@ -38,6 +38,8 @@ type GCSDataSource struct {
 	keyFile string
 	// Reader
 	gcsReader io.ReadCloser
+	// Size of the object
+	objectSize int64
 	// stack of readers
 	readers *FormatReaders
 	// The image file in scratch space.
@@ -86,10 +88,16 @@ func NewGCSDataSource(endpoint, keyFile string) (*GCSDataSource, error) {
 		return nil, err
 	}
 
+	var objectSize int64
+	if storageReader, ok := gcsReader.(*storage.Reader); ok {
+		objectSize = storageReader.Attrs.Size
+	}
+
 	return &GCSDataSource{
-		ep:        ep,
-		keyFile:   keyFile,
-		gcsReader: gcsReader,
+		ep:         ep,
+		keyFile:    keyFile,
+		gcsReader:  gcsReader,
+		objectSize: objectSize,
 	}, nil
 
 }
@@ -151,6 +159,14 @@ func (sd *GCSDataSource) TransferFile(fileName string) (ProcessingPhase, error)
 	return ProcessingPhaseResize, nil
 }
 
+// ScratchSizeEstimate estimates the scratch space from the object size or the qcow2 virtual size.
+func (sd *GCSDataSource) ScratchSizeEstimate() int64 {
+	if sd.readers == nil {
+		return 0
+	}
+	return sd.readers.ScratchSizeEstimate(sd.objectSize)
+}
+
 // GetURL returns the url that the data processor can use when converting the data.
 func (sd *GCSDataSource) GetURL() *url.URL {
 	return sd.url
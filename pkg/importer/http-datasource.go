@@ -40,6 +40,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"kubevirt.io/containerized-data-importer/pkg/common"
 	"kubevirt.io/containerized-data-importer/pkg/image"
+	"kubevirt.io/containerized-data-importer/pkg/importcache"
 	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
@@ -59,6 +60,7 @@ const (
 // 1c. Info -> Transfer in all other cases.
 // 2a. Transfer -> Convert if content type is kube virt
 // 2b. Transfer -> Complete if content type is archive (Transfer is called with the target instead of the scratch space). Non block PVCs only.
+// 2c. Transfer -> TransferFile if there is no scratch space and the qcow2 image can be converted while it is downloaded.
 type HTTPDataSource struct {
 	httpReader io.ReadCloser
 	ctx        context.Context
@@ -78,6 +80,10 @@ type HTTPDataSource struct {
 	brokenForQemuImg bool
 	// the content length reported by the http server.
 	contentLength uint64
+	// true if the qcow2 image is converted to the target while it is downloaded
+	streamQCOW2 bool
+	// true if the data is verified against a checksum or a source version, so it has to be read from httpReader
+	pinned bool
 
 	n image.NbdkitOperation
 }
@@ -104,6 +110,8 @@ func NewHTTPDataSource(endpoint, accessKey, secKey, certDir string, contentType
 		return nil, err
 	}
 
+	checksum, _ := util.ParseEnvVar(common.ImporterSourceChecksum, false)
+	sourceVersion, _ := util.ParseEnvVar(common.ImporterSourceVersion, false)
 	httpSource := &HTTPDataSource{
 		ctx:              ctx,
 		cancel:           cancel,
@@ -113,6 +121,7 @@ func NewHTTPDataSource(endpoint, accessKey, secKey, certDir string, contentType
 		customCA:         certDir,
 		brokenForQemuImg: brokenForQemuImg,
 		contentLength:    contentLength,
+		pinned:           checksum != "" || sourceVersion != "",
 	}
 	httpSource.n = createNbdkitCurl(nbdkitPid, accessKey, secKey, certDir, nbdkitSocket, extraHeaders, secretExtraHeaders)
 	// We know this is a counting reader, so no need to check.
@@ -135,7 +144,8 @@ func (hs *HTTPDataSource) Info() (ProcessingPhase, error) {
 	if !hs.readers.Convert {
 		return ProcessingPhaseTransferDataFile, nil
 	}
-	if pullMethod, _ := util.ParseEnvVar(common.ImporterPullMethod, false); pullMethod == string(cdiv1.RegistryPullNode) {
+	// nbdkit requests the source again, so a pinned source is read through scratch space
+	if pullMethod, _ := util.ParseEnvVar(common.ImporterPullMethod, false); pullMethod == string(cdiv1.RegistryPullNode) && !hs.pinned {
 		hs.url, _ = url.Parse(fmt.Sprintf("nbd+unix:///?socket=%s", nbdkitSocket))
 		if err = hs.n.StartNbdkit(hs.endpoint.String()); err != nil {
 			return ProcessingPhaseError, err
@@ -157,12 +167,20 @@ func (hs *HTTPDataSource) Transfer(path string) (ProcessingPhase, error) {
 		}
 		size, err := util.GetAvailableSpace(path)
 		if err != nil || size <= 0 {
+			if hs.readers.QCOW2Streamable {
+				// Without scratch space, convert the image while it is downloaded
+				hs.streamQCOW2 = true
+				return ProcessingPhaseTransferDataFile, nil
+			}
 			return ProcessingPhaseError, ErrInvalidPath
 		}
 		err = util.StreamDataToFile(hs.readers.TopReader(), file)
 		if err != nil {
 			return ProcessingPhaseError, err
 		}
+		if err := hs.readToEnd(); err != nil {
+			return ProcessingPhaseError, err
+		}
 		// If we successfully wrote to the file, then the parse will succeed.
 		hs.url, _ = url.Parse(file)
 		return ProcessingPhaseConvert, nil
@@ -170,6 +188,9 @@ func (hs *HTTPDataSource) Transfer(path string) (ProcessingPhase, error) {
 		if err := util.UnArchiveTar(hs.readers.TopReader(), path); err != nil {
 			return ProcessingPhaseError, errors.Wrap(err, "unable to untar files from endpoint")
 		}
+		if err := hs.readToEnd(); err != nil {
+			return ProcessingPhaseError, err
+		}
 		hs.url = nil
 		return ProcessingPhaseComplete, nil
 	}
@@ -182,13 +203,48 @@ func (hs *HTTPDataSource) TransferFile(fileName string) (ProcessingPhase, error)
 		return ProcessingPhaseError, err
 	}
 	hs.readers.StartProgressUpdate()
+	if hs.streamQCOW2 {
+		err := streamQCOW2ToFile(hs.readers.TopReader(), fileName)
+		if errors.Is(err, image.ErrQCOW2NotStreamable) {
+			klog.Errorf("%+v", err)
+			return ProcessingPhaseError, ErrRequiresScratchSpace
+		} else if err != nil {
+			return ProcessingPhaseError, err
+		}
+		if err := hs.readToEnd(); err != nil {
+			return ProcessingPhaseError, err
+		}
+		return ProcessingPhaseResize, nil
+	}
 	err := util.StreamDataToFile(hs.readers.TopReader(), fileName)
 	if err != nil {
 		return ProcessingPhaseError, err
 	}
+	if err := hs.readToEnd(); err != nil {
+		return ProcessingPhaseError, err
+	}
 	return ProcessingPhaseResize, nil
 }
 
+// readToEnd reads what is left of a pinned source, the image may end before the data and the checksum covers all of it
+func (hs *HTTPDataSource) readToEnd() error {
+	if !hs.pinned {
+		return nil
+	}
+	if _, err := io.Copy(io.Discard, hs.httpReader); err != nil {
+		return errors.Wrap(err, "unable to verify the source")
+	}
+	return nil
+}
+
+// ScratchSizeEstimate estimates the scratch space from the Content-Length or the qcow2 virtual size.
+func (hs *HTTPDataSource) ScratchSizeEstimate() int64 {
+	if hs.readers == nil {
+		return 0
+	}
+	return hs.readers.ScratchSizeEstimate(int64(hs.contentLength))
+}
+
 // GetURL returns the URI that the data processor can use when converting the data.
 func (hs *HTTPDataSource) GetURL() *url.URL {
 	return hs.url
@@ -304,6 +360,27 @@ func createHTTPReader(ctx context.Context, ep *url.URL, accessKey, secKey, certD
 	}
 
 	allExtraHeaders := append(extraHeaders, secretExtraHeaders...)
+	checksum, _ := util.ParseEnvVar(common.ImporterSourceChecksum, false)
+	// the cache doesn't keep the source version, so a version pinned source is not read from it
+	sourceVersion, _ := util.ParseEnvVar(common.ImporterSourceVersion, false)
+
+	if importCache := importcache.NewClientFromEnv(); importCache != nil && accessKey == "" && secKey == "" && certDir == "" && len(allExtraHeaders) == 0 && sourceVersion == "" {
+		if resp := importCache.GetHTTP(ctx, ep.String()); resp != nil {
+			total := uint64(0)
+			if resp.ContentLength > 0 {
+				total = uint64(resp.ContentLength)
+			}
+			body, err := verifyHTTPResponse(resp, checksum, "")
+			if err != nil {
+				return nil, uint64(0), true, err
+			}
+			countingReader := &util.CountingReader{
+				Reader:  body,
+				Current: 0,
+			}
+			return countingReader, total, true, nil
+		}
+	}
 
 	client.CheckRedirect = func(r *http.Request, via []*http.Request) error {
 		if len(accessKey) > 0 && len(secKey) > 0 {
@@ -333,7 +410,7 @@ func createHTTPReader(ctx context.Context, ep *url.URL, accessKey, secKey, certD
 	}
 	if resp.StatusCode != 200 {
 		klog.Errorf("http: expected status code 200, got %d", resp.StatusCode)
-		return nil, uint64(0), true, errors.Errorf("expected status code 200, got %d. Status: %s", resp.StatusCode, resp.Status)
+		return nil, uint64(0), true, errors.WithStack(&util.HTTPStatusError{StatusCode: resp.StatusCode, Status: resp.Status})
 	}
 
 	if contentType == cdiv1.DataVolumeKubeVirt {
@@ -356,13 +433,36 @@ func createHTTPReader(ctx context.Context, ep *url.URL, accessKey, secKey, certD
 		// The total seems bogus. Let's try the GET Content-Length header
 		total = parseHTTPHeader(resp)
 	}
+	body, err := verifyHTTPResponse(resp, checksum, sourceVersion)
+	if err != nil {
+		return nil, uint64(0), true, err
+	}
 	countingReader := &util.CountingReader{
-		Reader:  resp.Body,
+		Reader:  body,
 		Current: 0,
 	}
 	return countingReader, total, brokenForQemuImg, nil
 }
 
+// verifyHTTPResponse fails if the response is not the polled version of the source, and verifies the checksum of its body
+func verifyHTTPResponse(resp *http.Response, checksum, sourceVersion string) (io.ReadCloser, error) {
+	if sourceVersion != "" {
+		if err := verifySourceVersion(sourceVersion, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	if checksum == "" {
+		return resp.Body, nil
+	}
+	body, err := newChecksumReader(resp.Body, checksum)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return body, nil
+}
+
 func (hs *HTTPDataSource) pollProgress(reader *util.CountingReader, idleTime, pollInterval time.Duration) {
 	count := reader.Current
 	lastUpdate := time.Now()
@@ -409,7 +509,7 @@ func getContentLength(client *http.Client, ep *url.URL, accessKey, secKey string
 
 	if resp.StatusCode != 200 {
 		klog.Errorf("http: expected status code 200, got %d", resp.StatusCode)
-		return uint64(0), errors.Errorf("expected status code 200, got %d. Status: %s", resp.StatusCode, resp.Status)
+		return uint64(0), errors.WithStack(&util.HTTPStatusError{StatusCode: resp.StatusCode, Status: resp.Status})
 	}
 
 	for k, v := range resp.Header {
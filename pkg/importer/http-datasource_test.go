@@ -16,6 +16,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"kubevirt.io/containerized-data-importer/pkg/common"
@@ -141,7 +142,8 @@ var _ = Describe("Http data source", func() {
 			Expect(err).To(HaveOccurred())
 		}
 	},
-		Entry("return Error with missing scratch space", cirrosFileName, cdiv1.DataVolumeKubeVirt, ProcessingPhaseError, "/imaninvalidpath", cirrosData, true),
+		Entry("return Error with missing scratch space", tinyCoreGz, cdiv1.DataVolumeKubeVirt, ProcessingPhaseError, "/imaninvalidpath", nil, true),
+		Entry("return TransferDataFile with missing scratch space and a streamable qcow file", cirrosFileName, cdiv1.DataVolumeKubeVirt, ProcessingPhaseTransferDataFile, "/imaninvalidpath", cirrosData, false),
 		Entry("return Error with invalid content type ", cirrosFileName, cdiv1.DataVolumeContentType("invalid"), ProcessingPhaseError, "", cirrosData, true),
 		Entry("return Complete with archive content type and archive endpoint ", diskimageTarFileName, cdiv1.DataVolumeArchive, ProcessingPhaseComplete, "", diskimageArchiveData, false),
 		Entry("return Error with invalid target path and archive", diskimageTarFileName, cdiv1.DataVolumeArchive, ProcessingPhaseError, "/imaninvalidpath", cirrosData, true),
@@ -164,6 +166,41 @@ var _ = Describe("Http data source", func() {
 		Expect(ProcessingPhaseTransferDataFile).To(Equal(result))
 	})
 
+	It("TransferFile should convert a qcow2 image while it is read without scratch space", func() {
+		flushRead = cirrosData
+		dp, err = NewHTTPDataSource(ts.URL+"/"+cirrosFileName, "", "", "", cdiv1.DataVolumeKubeVirt)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dp.Info()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dp.readers.QCOW2Streamable).To(BeTrue())
+		result, err := dp.Transfer("/imaninvalidpath")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ProcessingPhaseTransferDataFile))
+		fileName := filepath.Join(tmpDir, "disk.img")
+		result, err = dp.TransferFile(fileName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ProcessingPhaseResize))
+		info, err := os.Stat(fileName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Size()).To(Equal(dp.readers.VirtualSize))
+	})
+
+	It("TransferFile should require scratch space when a qcow2 image can't be converted while it is read", func() {
+		defer func(limit int64) { qcow2StreamBufferLimit = limit }(qcow2StreamBufferLimit)
+		qcow2StreamBufferLimit = 0
+		flushRead = nil
+		dp, err = NewHTTPDataSource(ts.URL+"/"+cirrosFileName, "", "", "", cdiv1.DataVolumeKubeVirt)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dp.Info()
+		Expect(err).NotTo(HaveOccurred())
+		result, err := dp.Transfer("/imaninvalidpath")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ProcessingPhaseTransferDataFile))
+		result, err = dp.TransferFile(filepath.Join(tmpDir, "disk.img"))
+		Expect(err).To(Equal(ErrRequiresScratchSpace))
+		Expect(result).To(Equal(ProcessingPhaseError))
+	})
+
 	It("should get extra headers on creation of new HTTP data source", func() {
 		os.Setenv(common.ImporterExtraHeader+"0", "Extra-Header: 321")
 		os.Setenv(common.ImporterExtraHeader+"1", "Second-Extra-Header: 321")
@@ -397,6 +434,37 @@ var _ = Describe("Http reader", func() {
 		err = r.Close()
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("should fail if the source changed since it was polled", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"v2"`)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+		polled, err := sourceVersionDigest(`"v1"`, "")
+		Expect(err).ToNot(HaveOccurred())
+		GinkgoT().Setenv(common.ImporterSourceVersion, polled)
+		ep, err := url.Parse(ts.URL)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, _, err = createHTTPReader(context.Background(), ep, "", "", "", nil, nil, cdiv1.DataVolumeKubeVirt)
+		Expect(errors.Is(err, util.ErrSourceChanged)).To(BeTrue())
+	})
+
+	It("should fail at the end of data not matching the checksum", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("changed data"))
+		}))
+		defer ts.Close()
+		GinkgoT().Setenv(common.ImporterSourceChecksum, "sha256:"+strings.Repeat("0", 64))
+		ep, err := url.Parse(ts.URL)
+		Expect(err).ToNot(HaveOccurred())
+		r, _, _, err := createHTTPReader(context.Background(), ep, "", "", "", nil, nil, cdiv1.DataVolumeKubeVirt)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = io.ReadAll(r)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("checksum mismatch"))
+		Expect(r.Close()).To(Succeed())
+	})
 })
 
 var _ = Describe("http pollprogress", func() {
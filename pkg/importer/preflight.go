@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+// preflightHeaderSize is the size of the image prefix inspected by qemu-img, which holds the header of the formats it converts
+const preflightHeaderSize = 4 << 20
+
+// Preflight inspects the source of a dry run, without transferring it
+func Preflight(ds DataSourceInterface) (*util.PreflightInfo, error) {
+	if _, err := ds.Info(); err != nil {
+		return nil, err
+	}
+	switch s := ds.(type) {
+	case *HTTPDataSource:
+		info, err := preflightFormatReaders(s.readers, int64(s.contentLength))
+		if err == nil && s.contentType == cdiv1.DataVolumeArchive {
+			info.Format = string(cdiv1.DataVolumeArchive)
+		}
+		return info, err
+	case *S3DataSource:
+		return preflightFormatReaders(s.readers, s.objectSize)
+	case *GCSDataSource:
+		return preflightFormatReaders(s.readers, s.objectSize)
+	case *RegistryDataSource:
+		// The disk image is in a layer of the container image, only the header of the disk image is read
+		return InspectRegistryImage(s.endpoint, containerDiskImageDir, s.accessKey, s.secKey, s.certDir, s.insecureTLS)
+	}
+	return nil, errors.New("dry run is not supported for this source")
+}
+
+// preflightFormatReaders returns the format and virtual size of the image read by the readers
+func preflightFormatReaders(fr *FormatReaders, sourceSize int64) (*util.PreflightInfo, error) {
+	switch {
+	case fr.VirtualSize > 0:
+		return &util.PreflightInfo{Format: fr.Format, VirtualSize: fr.VirtualSize}, nil
+	case fr.Convert:
+		return preflightImageHeader(fr.TopReader())
+	case !fr.Archived:
+		return &util.PreflightInfo{Format: "raw", VirtualSize: sourceSize}, nil
+	}
+	// The size of a compressed raw image is only known once it is decompressed
+	return &util.PreflightInfo{Format: "raw"}, nil
+}
+
+// preflightImageHeader runs qemu-img info on the prefix of the image holding its header
+func preflightImageHeader(r io.Reader) (*util.PreflightInfo, error) {
+	file, err := os.CreateTemp("", "preflight")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err := io.CopyN(file, r, preflightHeaderSize); err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "unable to read the image header")
+	}
+	info, err := qemuOperations.Info(&url.URL{Path: file.Name()})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the image information")
+	}
+	return &util.PreflightInfo{Format: info.Format, VirtualSize: info.VirtualSize}, nil
+}
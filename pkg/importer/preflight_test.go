@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/image"
+)
+
+var _ = Describe("Preflight", func() {
+	var ds *HTTPDataSource
+
+	AfterEach(func() {
+		if ds != nil {
+			Expect(ds.Close()).To(Succeed())
+			ds = nil
+		}
+	})
+
+	DescribeTable("should inspect an http source", func(fileName, format string, sizeFromFile bool) {
+		ts := createTestServer(imageDir)
+		defer ts.Close()
+		var err error
+		ds, err = NewHTTPDataSource(ts.URL+"/"+fileName, "", "", "", cdiv1.DataVolumeKubeVirt)
+		Expect(err).ToNot(HaveOccurred())
+		info, err := Preflight(ds)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Format).To(Equal(format))
+		if sizeFromFile {
+			stat, err := os.Stat(tinyCoreFilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.VirtualSize).To(Equal(stat.Size()))
+		} else if format == "qcow2" {
+			Expect(info.VirtualSize).To(BeNumerically(">", 0))
+		} else {
+			Expect(info.VirtualSize).To(BeZero())
+		}
+	},
+		Entry("with a qcow2 image", cirrosFileName, "qcow2", false),
+		Entry("with a raw image", tinyCoreFileName, "raw", true),
+		Entry("with a compressed raw image of unknown size", tinyCoreGz, "raw", false),
+	)
+
+	It("should inspect the disk image of a registry source", func() {
+		info, err := Preflight(NewRegistryDataSource("oci-archive:"+imageFile, "", "", "", false))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Format).To(Equal("qcow2"))
+		Expect(info.VirtualSize).To(BeNumerically(">", 0))
+	})
+
+	It("should inspect the header of an image which is not qcow2 with qemu-img", func() {
+		header := make([]byte, 1<<20)
+		copy(header, "KDMV")
+		fr, err := NewFormatReaders(io.NopCloser(bytes.NewReader(header)), 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fr.Format).To(Equal("vmdk"))
+		qemuOperations := NewFakeQEMUOperations(nil, nil, fakeInfoOpRetVal{&image.ImgInfo{Format: "vmdk", VirtualSize: 1 << 30}, nil}, nil, nil, nil)
+		replaceQEMUOperations(qemuOperations, func() {
+			info, err := preflightFormatReaders(fr, int64(len(header)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Format).To(Equal("vmdk"))
+			Expect(info.VirtualSize).To(Equal(int64(1 << 30)))
+		})
+	})
+
+	It("should not inspect an unsupported source", func() {
+		_, err := Preflight(&MockDataProvider{infoResponse: ProcessingPhaseTransferDataFile})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/klog/v2"
+
+	"kubevirt.io/containerized-data-importer/pkg/image"
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+const zeroWriteBlockSize = 16 << 20
+
+// qcow2StreamBufferLimit bounds the memory holding the clusters of a qcow2 image read before its tables
+var qcow2StreamBufferLimit int64 = 64 << 20
+
+// streamQCOW2ToFile converts the qcow2 image read from r to the raw file or block device
+func streamQCOW2ToFile(r io.Reader, fileName string) error {
+	stream, err := image.NewQCOW2Stream(r, qcow2StreamBufferLimit)
+	if err != nil {
+		return err
+	}
+	blockSize, err := util.GetAvailableSpaceBlock(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "error determining if block device exists")
+	}
+	if blockSize >= 0 && blockSize < stream.VirtualSize() {
+		return errors.Errorf("virtual image size %d is larger than the block device size %d", stream.VirtualSize(), blockSize)
+	}
+	outFile, err := util.OpenFileOrBlockDevice(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	klog.V(1).Infof("Converting the qcow2 image to %s while it is read", fileName)
+	var zeroRange func(offset, length int64) error
+	if blockSize >= 0 {
+		// Unlike a new file, a block device may hold data from a previous use
+		zeroRange = func(offset, length int64) error {
+			return zeroBlockRange(outFile, offset, length)
+		}
+	} else if err := outFile.Truncate(stream.VirtualSize()); err != nil {
+		return errors.Wrapf(err, "unable to resize %s", fileName)
+	}
+	if err := stream.Convert(outFile, zeroRange); err != nil {
+		return err
+	}
+	return outFile.Sync()
+}
+
+// zeroBlockRange punches a hole in the block device range, or writes zeros if the device doesn't support it
+func zeroBlockRange(outFile *os.File, offset, length int64) error {
+	err := util.PunchHole(outFile, offset, length)
+	if err == nil {
+		return nil
+	}
+	klog.Errorf("Unable to punch hole at %d-%d, falling back to writing zeros: %v", offset, offset+length, err)
+	zeros := make([]byte, zeroWriteBlockSize)
+	for length > 0 {
+		n := length
+		if n > zeroWriteBlockSize {
+			n = zeroWriteBlockSize
+		}
+		if _, err := outFile.WriteAt(zeros[:n], offset); err != nil {
+			return err
+		}
+		offset += n
+		length -= n
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 
 	"k8s.io/klog/v2"
 
+	"kubevirt.io/containerized-data-importer/pkg/common"
 	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
@@ -28,6 +29,7 @@ const (
 // S3Client is the interface to the used S3 client.
 type S3Client interface {
 	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 }
 
 // may be overridden in tests
@@ -46,6 +48,8 @@ type S3DataSource struct {
 	secKey string
 	// Reader
 	s3Reader io.ReadCloser
+	// Size of the object
+	objectSize int64
 	// stack of readers
 	readers *FormatReaders
 	// The image file in scratch space.
@@ -58,15 +62,16 @@ func NewS3DataSource(endpoint, accessKey, secKey string, certDir string) (*S3Dat
 	if err != nil {
 		return nil, errors.Wrapf(err, fmt.Sprintf("unable to parse endpoint %q", endpoint))
 	}
-	s3Reader, err := createS3Reader(ep, accessKey, secKey, certDir)
+	s3Reader, objectSize, err := createS3Reader(ep, accessKey, secKey, certDir)
 	if err != nil {
 		return nil, err
 	}
 	return &S3DataSource{
-		ep:        ep,
-		accessKey: accessKey,
-		secKey:    secKey,
-		s3Reader:  s3Reader,
+		ep:         ep,
+		accessKey:  accessKey,
+		secKey:     secKey,
+		s3Reader:   s3Reader,
+		objectSize: objectSize,
 	}, nil
 }
 
@@ -121,6 +126,14 @@ func (sd *S3DataSource) TransferFile(fileName string) (ProcessingPhase, error) {
 	return ProcessingPhaseResize, nil
 }
 
+// ScratchSizeEstimate estimates the scratch space from the object size or the qcow2 virtual size.
+func (sd *S3DataSource) ScratchSizeEstimate() int64 {
+	if sd.readers == nil {
+		return 0
+	}
+	return sd.readers.ScratchSizeEstimate(sd.objectSize)
+}
+
 // GetURL returns the url that the data processor can use when converting the data.
 func (sd *S3DataSource) GetURL() *url.URL {
 	return sd.url
@@ -135,7 +148,7 @@ func (sd *S3DataSource) Close() error {
 	return err
 }
 
-func createS3Reader(ep *url.URL, accessKey, secKey string, certDir string) (io.ReadCloser, error) {
+func createS3Reader(ep *url.URL, accessKey, secKey string, certDir string) (io.ReadCloser, int64, error) {
 	klog.V(3).Infoln("Using S3 client to get data")
 
 	endpoint := ep.Host
@@ -148,7 +161,7 @@ func createS3Reader(ep *url.URL, accessKey, secKey string, certDir string) (io.R
 	klog.V(1).Infof("object %s", object)
 	svc, err := newClientFunc(endpoint, accessKey, secKey, certDir, urlScheme)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not build s3 client for %q", ep.Host)
+		return nil, 0, errors.Wrapf(err, "could not build s3 client for %q", ep.Host)
 	}
 
 	objInput := &s3.GetObjectInput{
@@ -157,10 +170,20 @@ func createS3Reader(ep *url.URL, accessKey, secKey string, certDir string) (io.R
 	}
 	objOutput, err := svc.GetObject(objInput)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not get s3 object: \"%s/%s\"", bucket, object)
+		return nil, 0, errors.Wrapf(err, "could not get s3 object: \"%s/%s\"", bucket, object)
+	}
+	if sourceVersion, _ := util.ParseEnvVar(common.ImporterSourceVersion, false); sourceVersion != "" {
+		var lastModified string
+		if objOutput.LastModified != nil {
+			lastModified = objOutput.LastModified.UTC().String()
+		}
+		if err := verifySourceVersion(sourceVersion, aws.StringValue(objOutput.ETag), lastModified); err != nil {
+			objOutput.Body.Close()
+			return nil, 0, err
+		}
 	}
 	objectReader := objOutput.Body
-	return objectReader, nil
+	return objectReader, aws.Int64Value(objOutput.ContentLength), nil
 }
 
 func getS3Client(endpoint, accessKey, secKey string, certDir string, urlScheme string) (S3Client, error) {
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"reflect"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -234,3 +235,10 @@ func (mc *MockS3Client) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput
 	}
 	return nil, errors.New("Failed to get object")
 }
+
+func (mc *MockS3Client) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	if !mc.doErr {
+		return &s3.HeadObjectOutput{ETag: aws.String("\"etag\"")}, nil
+	}
+	return nil, errors.New("Failed to head object")
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+
+	"k8s.io/klog/v2"
+
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+const (
+	sourceDigestPrefix = "sha256:"
+	// max size of a checksum file we are willing to read
+	maxChecksumFileSize = 1024 * 1024
+)
+
+var (
+	// <checksum> <name> or <checksum> *<name>
+	gnuChecksumLine = regexp.MustCompile(`^([0-9a-fA-F]{64})\s+\*?(.+)$`)
+	// SHA256 (<name>) = <checksum>
+	bsdChecksumLine = regexp.MustCompile(`^SHA256\s*\((.+)\)\s*=\s*([0-9a-fA-F]{64})$`)
+)
+
+// GetHTTPSourceDigest returns a digest identifying the current version of an http source.
+// If checksumURL is set, the digest is the sha256 checksum of the source listed in the checksum file,
+// otherwise it is derived from the ETag or Last-Modified response headers of the source.
+func GetHTTPSourceDigest(endpoint, checksumURL, accessKey, secKey, certDir string) (string, error) {
+	ep, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to parse endpoint %q", endpoint)
+	}
+	client, err := createHTTPClient(certDir)
+	if err != nil {
+		return "", errors.Wrap(err, "Error creating http client")
+	}
+	client.CheckRedirect = func(r *http.Request, via []*http.Request) error {
+		if len(accessKey) > 0 && len(secKey) > 0 {
+			r.SetBasicAuth(accessKey, secKey) // Redirects will lose basic auth, so reset them manually
+		}
+		return nil
+	}
+
+	if checksumURL != "" {
+		checksumEp, err := url.Parse(checksumURL)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to parse checksum url %q", checksumURL)
+		}
+		resp, err := doSourceDigestRequest(client, http.MethodGet, checksumEp, accessKey, secKey)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		checksum, err := findChecksum(io.LimitReader(resp.Body, maxChecksumFileSize), path.Base(ep.Path))
+		if err != nil {
+			return "", err
+		}
+		return sourceDigestPrefix + checksum, nil
+	}
+
+	resp, err := doSourceDigestRequest(client, http.MethodHead, ep, accessKey, secKey)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	return sourceVersionDigest(resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"))
+}
+
+// GetS3SourceDigest returns a digest identifying the current version of an S3 object, derived from its ETag or LastModified time
+func GetS3SourceDigest(endpoint, accessKey, secKey, certDir string) (string, error) {
+	ep, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to parse endpoint %q", endpoint)
+	}
+	bucket, object := extractBucketAndObject(strings.Trim(ep.Path, "/"))
+	svc, err := newClientFunc(ep.Host, accessKey, secKey, certDir, ep.Scheme)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not build s3 client for %q", ep.Host)
+	}
+	objOutput, err := svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(object),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get s3 object: \"%s/%s\"", bucket, object)
+	}
+	var lastModified string
+	if objOutput.LastModified != nil {
+		lastModified = objOutput.LastModified.UTC().String()
+	}
+	return sourceVersionDigest(aws.StringValue(objOutput.ETag), lastModified)
+}
+
+func doSourceDigestRequest(client *http.Client, method string, ep *url.URL, accessKey, secKey string) (*http.Response, error) {
+	req, err := http.NewRequest(method, ep.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create HTTP request")
+	}
+	if len(accessKey) > 0 && len(secKey) > 0 {
+		req.SetBasicAuth(accessKey, secKey)
+	}
+	req.Header.Add("User-Agent", defaultUserAgent)
+
+	klog.V(2).Infof("Attempting to %s %q via http client\n", method, ep.String())
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "HTTP request errored")
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.WithStack(&util.HTTPStatusError{StatusCode: resp.StatusCode, Status: resp.Status})
+	}
+	return resp, nil
+}
+
+// sourceVersionDigest creates a digest from the ETag, or the Last-Modified time if there is no ETag
+func sourceVersionDigest(etag, lastModified string) (string, error) {
+	version := strings.TrimPrefix(strings.Trim(etag, " "), "W/")
+	if version == "" {
+		version = lastModified
+	}
+	if version == "" {
+		return "", errors.New("source has no ETag or Last-Modified")
+	}
+	sum := sha256.Sum256([]byte(version))
+	return sourceDigestPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+// findChecksum finds the sha256 checksum of fileName in a checksum file in GNU (sha256sum) or BSD format
+func findChecksum(reader io.Reader, fileName string) (string, error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if m := gnuChecksumLine.FindStringSubmatch(line); m != nil && path.Base(m[2]) == fileName {
+			return strings.ToLower(m[1]), nil
+		}
+		if m := bsdChecksumLine.FindStringSubmatch(line); m != nil && path.Base(m[1]) == fileName {
+			return strings.ToLower(m[2]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", errors.Wrap(err, "could not read checksum file")
+	}
+	return "", errors.Errorf("no sha256 checksum for %q in checksum file", fileName)
+}
+
+// verifySourceVersion fails if the ETag or Last-Modified of the source doesn't match the version digest a DataImportCron polled
+func verifySourceVersion(expected, etag, lastModified string) error {
+	actual, err := sourceVersionDigest(etag, lastModified)
+	if err != nil {
+		return errors.Wrap(err, "unable to verify the source version")
+	}
+	if actual != expected {
+		return errors.Wrapf(util.ErrSourceChanged, "expected version %s, got %s", expected, actual)
+	}
+	return nil
+}
+
+// checksumReader verifies the checksum of the data read once it reaches the end of the data
+type checksumReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected string
+}
+
+// newChecksumReader returns a reader verifying a checksum in the <algorithm>:<hex digest> format
+func newChecksumReader(reader io.ReadCloser, checksum string) (io.ReadCloser, error) {
+	algorithm, digest, found := strings.Cut(checksum, ":")
+	if !found || digest == "" {
+		return nil, errors.Errorf("invalid checksum %q, expected <algorithm>:<hex digest>", checksum)
+	}
+	var h hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, errors.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+	return &checksumReader{ReadCloser: reader, hash: h, expected: strings.ToLower(digest)}, nil
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expected {
+			return n, errors.Errorf("source checksum mismatch, expected %s, got %s", r.expected, actual)
+		}
+	}
+	return n, err
+}
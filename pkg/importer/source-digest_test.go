@@ -0,0 +1,158 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"kubevirt.io/containerized-data-importer/pkg/util"
+)
+
+const testChecksum = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+var _ = Describe("HTTP source digest", func() {
+	var (
+		ts      *httptest.Server
+		headers map[string]string
+	)
+
+	BeforeEach(func() {
+		headers = map[string]string{}
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/SHA256SUMS" {
+				_, _ = w.Write([]byte(testChecksum + " *Fedora-Cloud.qcow2\n"))
+				return
+			}
+			for k, v := range headers {
+				w.Header().Set(k, v)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("Should change with the ETag", func() {
+		headers["ETag"] = `"v1"`
+		digest1, err := GetHTTPSourceDigest(ts.URL+"/Fedora-Cloud.qcow2", "", "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest1).To(HavePrefix("sha256:"))
+		headers["ETag"] = `"v2"`
+		digest2, err := GetHTTPSourceDigest(ts.URL+"/Fedora-Cloud.qcow2", "", "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest2).ToNot(Equal(digest1))
+	})
+
+	It("Should use Last-Modified if there is no ETag", func() {
+		headers["Last-Modified"] = "Wed, 21 Oct 2015 07:28:00 GMT"
+		digest, err := GetHTTPSourceDigest(ts.URL+"/Fedora-Cloud.qcow2", "", "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest).To(HavePrefix("sha256:"))
+	})
+
+	It("Should fail if there is no ETag and no Last-Modified", func() {
+		_, err := GetHTTPSourceDigest(ts.URL+"/Fedora-Cloud.qcow2", "", "", "", "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should use the checksum from the checksum file", func() {
+		digest, err := GetHTTPSourceDigest(ts.URL+"/Fedora-Cloud.qcow2", ts.URL+"/SHA256SUMS", "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest).To(Equal("sha256:" + testChecksum))
+	})
+})
+
+var _ = Describe("Checksum file", func() {
+	DescribeTable("Should find the checksum", func(content string) {
+		checksum, err := findChecksum(strings.NewReader(content), "disk.img")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checksum).To(Equal(testChecksum))
+	},
+		Entry("GNU format", testChecksum+"  disk.img\n"),
+		Entry("GNU binary format", "ffff"+testChecksum[4:]+" *other.img\n"+testChecksum+" *disk.img\n"),
+		Entry("BSD format", "-----BEGIN PGP SIGNED MESSAGE-----\n# disk.img: 1 bytes\nSHA256 (disk.img) = "+strings.ToUpper(testChecksum)+"\n"),
+	)
+
+	It("Should fail if the file is not listed", func() {
+		_, err := findChecksum(strings.NewReader(testChecksum+"  other.img\n"), "disk.img")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("S3 source digest", func() {
+	AfterEach(func() {
+		newClientFunc = getS3Client
+	})
+
+	It("Should derive the digest from the object ETag", func() {
+		newClientFunc = createMockS3Client
+		digest, err := GetS3SourceDigest("http://s3.example.com/bucket/disk.img", "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(digest).To(HavePrefix("sha256:"))
+	})
+
+	It("Should fail if the object can not be found", func() {
+		newClientFunc = createErrMockS3Client
+		_, err := GetS3SourceDigest("http://s3.example.com/bucket/disk.img", "", "", "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Source version", func() {
+	It("Should accept the polled version", func() {
+		digest, err := sourceVersionDigest(`"v1"`, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verifySourceVersion(digest, `"v1"`, "")).To(Succeed())
+	})
+
+	It("Should fail if the source changed", func() {
+		digest, err := sourceVersionDigest(`"v1"`, "")
+		Expect(err).ToNot(HaveOccurred())
+		err = verifySourceVersion(digest, `"v2"`, "")
+		Expect(errors.Is(err, util.ErrSourceChanged)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Checksum reader", func() {
+	const data = "disk image data"
+
+	checksumOf := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	readAll := func(checksum string) error {
+		reader, err := newChecksumReader(io.NopCloser(strings.NewReader(data)), checksum)
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(reader)
+		return err
+	}
+
+	It("Should accept data matching the checksum", func() {
+		Expect(readAll(checksumOf(data))).To(Succeed())
+	})
+
+	It("Should fail at the end of data not matching the checksum", func() {
+		err := readAll(checksumOf("other data"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("checksum mismatch"))
+	})
+
+	DescribeTable("Should reject an invalid checksum", func(checksum string) {
+		_, err := newChecksumReader(io.NopCloser(strings.NewReader(data)), checksum)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("without algorithm", "a1e4d2bb"),
+		Entry("unsupported algorithm", "crc32:a1e4d2bb"),
+	)
+})
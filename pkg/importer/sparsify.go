@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The CDI Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// sparsifyBlockSize is the granularity of the zero detection, the block size of most file systems and disks
+	sparsifyBlockSize = 4096
+	// sparsifyBufferSize is the size of the reads scanning the target for zeroes
+	sparsifyBufferSize = 1 << 20
+)
+
+// ErrSparsifyNotSupported is returned when the target storage can't deallocate ranges
+var ErrSparsifyNotSupported = errors.New("deallocating ranges is not supported by the target storage")
+
+// SparsifyResult is what sparsifying a target deallocated
+type SparsifyResult struct {
+	// Unmapped is the size of the all-zero ranges that were deallocated
+	Unmapped int64
+	// Reclaimed is the allocated space the file system of a target file really reclaimed
+	Reclaimed int64
+	// ReclaimedKnown is false for block devices, which don't report their allocation: their unmapped ranges may
+	// include ranges that were never allocated
+	ReclaimedKnown bool
+}
+
+// Sparsify deallocates the all-zero blocks of a target file or block device.
+// Ranges are deallocated with fallocate: files get holes punched in them, and block devices get their ranges zeroed
+// with unmap, which unlike BLKDISCARD guarantees the ranges still read as zeroes.
+func Sparsify(fileName string) (SparsifyResult, error) {
+	var result SparsifyResult
+	file, err := os.OpenFile(fileName, os.O_RDWR, 0)
+	if err != nil {
+		return result, errors.Wrap(err, "could not open target")
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return result, err
+	}
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return result, err
+	}
+
+	allocatedBefore := allocatedBytes(info)
+	if result.Unmapped, err = punchZeroBlocks(file, size); err != nil {
+		return result, err
+	}
+	if err := file.Sync(); err != nil {
+		return result, err
+	}
+	if !info.Mode().IsRegular() {
+		return result, nil
+	}
+	// File systems may deallocate more or less than the punched ranges, count what they really reclaimed
+	if info, err = file.Stat(); err != nil {
+		return result, err
+	}
+	result.ReclaimedKnown = true
+	if reclaimed := allocatedBefore - allocatedBytes(info); reclaimed > 0 {
+		result.Reclaimed = reclaimed
+	}
+	return result, nil
+}
+
+// punchZeroBlocks deallocates the runs of all-zero blocks in the data ranges of the file, skipping its holes
+func punchZeroBlocks(file *os.File, size int64) (int64, error) {
+	buf := make([]byte, sparsifyBufferSize)
+	zeroBlock := make([]byte, sparsifyBlockSize)
+	var punched int64
+	punch := func(start, end int64) error {
+		if end <= start {
+			return nil
+		}
+		flags := uint32(unix.FALLOC_FL_PUNCH_HOLE | unix.FALLOC_FL_KEEP_SIZE)
+		if err := syscall.Fallocate(int(file.Fd()), flags, start, end-start); err != nil {
+			if errors.Is(err, unix.EOPNOTSUPP) {
+				return ErrSparsifyNotSupported
+			}
+			return errors.Wrapf(err, "unable to deallocate %d bytes at offset %d", end-start, start)
+		}
+		klog.V(3).Infof("Deallocated %d zero bytes at offset %d", end-start, start)
+		punched += end - start
+		return nil
+	}
+
+	// Only whole blocks are deallocated, a partial last block is kept
+	end := size - size%sparsifyBlockSize
+	for offset := int64(0); offset < end; {
+		data := nextDataOffset(file, offset, end)
+		if data >= end {
+			break
+		}
+		dataEnd := nextHoleOffset(file, data, end)
+		// Data ranges start at a block boundary of the file system, which may be smaller than the zero detection blocks
+		start := data - data%sparsifyBlockSize
+		if start < offset {
+			start = offset
+		}
+		zeroStart := int64(-1)
+		for offset = start; offset < dataEnd; {
+			n := int64(len(buf))
+			if dataEnd-offset < n {
+				n = dataEnd - offset
+			}
+			if _, err := file.ReadAt(buf[:n], offset); err != nil {
+				return punched, errors.Wrapf(err, "unable to read target at offset %d", offset)
+			}
+			for i := int64(0); i < n; i += sparsifyBlockSize {
+				if bytes.Equal(buf[i:i+sparsifyBlockSize], zeroBlock) {
+					if zeroStart < 0 {
+						zeroStart = offset + i
+					}
+				} else if zeroStart >= 0 {
+					if err := punch(zeroStart, offset+i); err != nil {
+						return punched, err
+					}
+					zeroStart = -1
+				}
+			}
+			offset += n
+		}
+		if zeroStart >= 0 {
+			if err := punch(zeroStart, dataEnd); err != nil {
+				return punched, err
+			}
+		}
+	}
+	return punched, nil
+}
+
+// nextDataOffset returns the start of the next data range from offset, or end if there is none
+func nextDataOffset(file *os.File, offset, end int64) int64 {
+	data, err := unix.Seek(int(file.Fd()), offset, unix.SEEK_DATA)
+	switch {
+	case errors.Is(err, unix.ENXIO):
+		return end
+	case err != nil, data < offset:
+		// Without SEEK_DATA support everything is data
+		return offset
+	case data > end:
+		return end
+	}
+	return data
+}
+
+// nextHoleOffset returns the end of the data range at offset, rounded up to a whole zero detection block
+func nextHoleOffset(file *os.File, offset, end int64) int64 {
+	hole, err := unix.Seek(int(file.Fd()), offset, unix.SEEK_HOLE)
+	if err != nil || hole <= offset {
+		return end
+	}
+	if rem := hole % sparsifyBlockSize; rem != 0 {
+		hole += sparsifyBlockSize - rem
+	}
+	if hole > end {
+		return end
+	}
+	return hole
+}
+
+// allocatedBytes returns the bytes allocated to a file, 0 if unknown
+func allocatedBytes(info os.FileInfo) int64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Blocks * 512
+	}
+	return 0
+}
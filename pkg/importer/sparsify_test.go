@@ -0,0 +1,50 @@
+package importer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sparsify target", func() {
+	It("Should deallocate the zero blocks without changing the content", func() {
+		fileName := filepath.Join(GinkgoT().TempDir(), "disk.img")
+		data := bytes.Repeat([]byte{0x55}, 2*sparsifyBlockSize)
+		var content []byte
+		content = append(content, data...)
+		content = append(content, make([]byte, 2*sparsifyBufferSize+sparsifyBlockSize)...)
+		content = append(content, data...)
+		content = append(content, make([]byte, sparsifyBufferSize+100)...)
+		Expect(os.WriteFile(fileName, content, 0600)).To(Succeed())
+
+		result, err := Sparsify(fileName)
+		if err == ErrSparsifyNotSupported {
+			Skip("the test file system can't punch holes")
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Unmapped).To(BeNumerically(">=", 3*sparsifyBufferSize))
+		Expect(result.ReclaimedKnown).To(BeTrue())
+		Expect(result.Reclaimed).To(BeNumerically(">=", 3*sparsifyBufferSize))
+		data, err = os.ReadFile(fileName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes.Equal(data, content)).To(BeTrue())
+	})
+
+	It("Should not reclaim anything from a target without zero blocks", func() {
+		fileName := filepath.Join(GinkgoT().TempDir(), "disk.img")
+		Expect(os.WriteFile(fileName, bytes.Repeat([]byte{0x55}, sparsifyBufferSize), 0600)).To(Succeed())
+
+		result, err := Sparsify(fileName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Unmapped).To(BeZero())
+		Expect(result.Reclaimed).To(BeZero())
+	})
+
+	It("Should fail on a missing target", func() {
+		_, err := Sparsify(filepath.Join(GinkgoT().TempDir(), "missing.img"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -34,6 +34,7 @@ import (
 	"k8s.io/klog/v2"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"kubevirt.io/containerized-data-importer/pkg/importcache"
 	"kubevirt.io/containerized-data-importer/pkg/util"
 )
 
@@ -122,10 +123,10 @@ func processLayer(ctx context.Context,
 	destDir string,
 	pathPrefix string,
 	cache types.BlobInfoCache,
+	importCache *importcache.Client,
 	stopAtFirst bool) (bool, error) {
 
-	var reader io.ReadCloser
-	reader, _, err := src.GetBlob(ctx, layer, cache)
+	reader, err := getLayerReader(ctx, src, layer, cache, importCache)
 	if err != nil {
 		klog.Errorf("Could not read layer: %v", err)
 		return false, errors.Wrap(err, "Could not read layer")
@@ -172,6 +173,28 @@ func processLayer(ctx context.Context,
 	return found, nil
 }
 
+// getLayerReader reads the layer from the import cache if there is one, or from the registry, caching it
+func getLayerReader(ctx context.Context,
+	src types.ImageSource,
+	layer types.BlobInfo,
+	cache types.BlobInfoCache,
+	importCache *importcache.Client) (io.ReadCloser, error) {
+
+	if importCache != nil {
+		if reader := importCache.GetBlob(ctx, layer.Digest.String()); reader != nil {
+			return reader, nil
+		}
+	}
+	reader, _, err := src.GetBlob(ctx, layer, cache)
+	if err != nil {
+		return nil, err
+	}
+	if importCache != nil {
+		return importCache.CacheBlob(layer.Digest.String(), reader), nil
+	}
+	return reader, nil
+}
+
 func copyRegistryImage(url, destDir, pathPrefix, accessKey, secKey, certDir string, insecureRegistry, stopAtFirst bool) error {
 	klog.Infof("Downloading image from '%v', copying file from '%v' to '%v'", url, pathPrefix, destDir)
 
@@ -196,10 +219,16 @@ func copyRegistryImage(url, destDir, pathPrefix, accessKey, secKey, certDir stri
 	found := false
 	layers := imgCloser.LayerInfos()
 
+	// Layers of images pulled with credentials are not shared through the import cache
+	var importCache *importcache.Client
+	if accessKey == "" && secKey == "" {
+		importCache = importcache.NewClientFromEnv()
+	}
+
 	for _, layer := range layers {
 		klog.Infof("Processing layer %+v", layer)
 
-		found, err = processLayer(ctx, srcCtx, src, layer, destDir, pathPrefix, cache, stopAtFirst)
+		found, err = processLayer(ctx, srcCtx, src, layer, destDir, pathPrefix, cache, importCache, stopAtFirst)
 		if found {
 			break
 		}
@@ -250,6 +279,92 @@ func GetImageDigest(url, accessKey, secKey, certDir string, insecureRegistry boo
 	return digest.String(), nil
 }
 
+// InspectRegistryImage returns the format and virtual size of the first file under pathPrefix in the container
+// image at url. The layers are only read up to the header of that file.
+// url: source registry url.
+// pathPrefix: path of the file to inspect.
+// accessKey: accessKey for the registry described in url.
+// secKey: secretKey for the registry described in url.
+// certDir: directory public CA keys are stored for registry identity verification
+// insecureRegistry: boolean if true will allow insecure registries.
+func InspectRegistryImage(url, pathPrefix, accessKey, secKey, certDir string, insecureRegistry bool) (*util.PreflightInfo, error) {
+	klog.Infof("Inspecting file '%v' of image from '%v'", pathPrefix, url)
+
+	ctx, cancel := commandTimeoutContext()
+	defer cancel()
+	srcCtx := buildSourceContext(accessKey, secKey, certDir, insecureRegistry)
+
+	src, err := readImageSource(ctx, srcCtx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer closeImage(src)
+
+	imgCloser, err := image.FromSource(ctx, srcCtx, src)
+	if err != nil {
+		klog.Errorf("Error retrieving image: %v", err)
+		return nil, errors.Wrap(err, "Error retrieving image")
+	}
+	defer imgCloser.Close()
+
+	cache := blobinfocache.DefaultCache(srcCtx)
+	for _, layer := range imgCloser.LayerInfos() {
+		klog.Infof("Inspecting layer %+v", layer)
+
+		info, found, err := inspectLayer(ctx, src, layer, pathPrefix, cache)
+		if found {
+			return info, err
+		}
+		if err != nil {
+			// Skipping layer and trying the next one.
+			klog.Errorf("Could not inspect layer: %v", err)
+		}
+	}
+
+	klog.Errorf("Failed to find VM disk image file in the container image")
+	return nil, errors.New("Failed to find VM disk image file in the container image")
+}
+
+// inspectLayer returns the information of the first file under pathPrefix in the layer, if there is one
+func inspectLayer(ctx context.Context,
+	src types.ImageSource,
+	layer types.BlobInfo,
+	pathPrefix string,
+	cache types.BlobInfoCache) (*util.PreflightInfo, bool, error) {
+
+	reader, _, err := src.GetBlob(ctx, layer, cache)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "Could not read layer")
+	}
+	fr, err := NewFormatReaders(reader, 0)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "Could not read layer")
+	}
+	defer fr.Close()
+
+	tarReader := tar.NewReader(fr.TopReader())
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, errors.Wrap(err, "Error reading layer")
+		}
+
+		if hasPrefix(hdr.Name, pathPrefix) && !isWhiteout(hdr.Name) && !isDir(hdr) {
+			klog.Infof("File '%v' found in the layer", hdr.Name)
+			fileReaders, err := NewFormatReaders(io.NopCloser(tarReader), 0)
+			if err != nil {
+				return nil, true, errors.Wrap(err, "Error reading file")
+			}
+			defer fileReaders.Close()
+			info, err := preflightFormatReaders(fileReaders, hdr.Size)
+			return info, true, err
+		}
+	}
+}
+
 // CopyRegistryImage download image from registry with docker image API. It will extract first file under the pathPrefix
 // url: source registry url.
 // destDir: the scratch space destination.
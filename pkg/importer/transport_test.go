@@ -67,4 +67,14 @@ var _ = Describe("Registry Importer", func() {
 		err := CopyRegistryImageAll(source, tmpDir, "invalid/", "", "", "", false)
 		Expect(err).To(HaveOccurred())
 	})
+	It("Should inspect the header of a file", func() {
+		info, err := InspectRegistryImage(source, "disk/", "", "", "", false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Format).To(Equal("qcow2"))
+		Expect(info.VirtualSize).To(BeNumerically(">", 0))
+	})
+	It("Should return an error if the file to inspect is not found", func() {
+		_, err := InspectRegistryImage(source, "disk/invalid.img", "", "", "", false)
+		Expect(err).To(HaveOccurred())
+	})
 })
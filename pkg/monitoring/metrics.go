@@ -36,7 +36,7 @@ const (
 var MetricOptsList = map[MetricsKey]MetricOpts{
 	DataImportCronOutdated: {
 		Name: "kubevirt_cdi_dataimportcron_outdated",
-		Help: "DataImportCron has an outdated import",
+		Help: "DataImportCron has an outdated import, labeled with the reason of the cron UpToDate condition",
 		Type: "Gauge",
 	},
 	IncompleteProfile: {
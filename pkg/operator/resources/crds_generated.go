@@ -90,6 +90,30 @@ spec:
               config:
                 description: CDIConfig at CDI level
                 properties:
+                  cloneBandwidthLimit:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: CloneBandwidthLimit is the maximum rate, in bytes
+                      per second, at which host-assisted clones read from their source
+                      volume. Not limited by default.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  dataImportCronMaxConcurrentImports:
+                    description: DataImportCronMaxConcurrentImports is the maximum
+                      number of DataImportCron imports in progress in the cluster.
+                      Further imports are queued until others complete. Not limited
+                      by default.
+                    format: int32
+                    type: integer
+                  dataImportCronValidationImages:
+                    description: DataImportCronValidationImages are the container
+                      images DataImportCron validation Jobs are allowed to run. A
+                      validation Job with any other image is not run, and the import
+                      fails validation.
+                    items:
+                      type: string
+                    type: array
                   dataVolumeTTLSeconds:
                     description: DataVolumeTTLSeconds is the time in seconds after
                       DataVolume completion it can be garbage collected. Disabled
@@ -139,6 +163,21 @@ spec:
                       type: object
                       x-kubernetes-map-type: atomic
                     type: array
+                  importCache:
+                    description: ImportCache enables a node-local cache of imported
+                      registry image layers and HTTP images, shared by the importer
+                      pods running on each node
+                    properties:
+                      sizeLimit:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: SizeLimit is the maximum size of the cache on
+                          each node, the least recently used entries are evicted beyond
+                          it. Defaults to 10Gi.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    type: object
                   importProxy:
                     description: ImportProxy contains importer pod proxy configuration.
                     properties:
@@ -232,6 +271,43 @@ spec:
                     description: Preallocation controls whether storage for DataVolumes
                       should be allocated in advance.
                     type: boolean
+                  retryPolicy:
+                    description: RetryPolicy controls how failing imports are retried
+                      unless a DataVolume sets its own. Importer pods are restarted
+                      by Kubernetes indefinitely when neither sets a retry policy.
+                    properties:
+                      backoffBase:
+                        description: BackoffBase is the delay before the first retry,
+                          doubled on each further retry. Defaults to 10s.
+                        type: string
+                      backoffCap:
+                        description: BackoffCap is the maximum delay between retries.
+                          Defaults to 5m.
+                        type: string
+                      maxAttempts:
+                        description: MaxAttempts is the maximum number of import attempts,
+                          including the first one. Unlimited by default.
+                        format: int32
+                        type: integer
+                      retryOn:
+                        description: RetryOn is the list of error classes which are
+                          retried, any other error fails the import on its first occurrence.
+                          By default, the errors which the importer reports as retryable
+                          are retried.
+                        items:
+                          description: ImportErrorClass is the class of an import
+                            error
+                          enum:
+                          - Network
+                          - Auth
+                          - NotFound
+                          - InvalidImage
+                          - OutOfSpace
+                          - Unknown
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                    type: object
                   scratchSpaceStorageClass:
                     description: 'Override the storage class to used for scratch space
                       during transfer operations. The scratch space storage class
@@ -335,6 +411,40 @@ spec:
                   uploadProxyURLOverride:
                     description: Override the URL used when uploading to a DataVolume
                     type: string
+                  workerPodLimits:
+                    description: WorkerPodLimits limits the number of concurrent importer
+                      and host-assisted clone pods. PVCs waiting for a worker pod are queued.
+                      Not limited by default.
+                    properties:
+                      global:
+                        description: Global is the maximum number of worker pods in
+                          the cluster
+                        format: int32
+                        type: integer
+                      perNamespace:
+                        description: PerNamespace is the maximum number of worker
+                          pods in each namespace
+                        format: int32
+                        type: integer
+                      perSourceHost:
+                        description: PerSourceHost is the maximum number of importer
+                          pods reading from each source host
+                        format: int32
+                        type: integer
+                      perStorageClass:
+                        description: PerStorageClass is the maximum number of worker
+                          pods writing to PVCs of each storage class
+                        format: int32
+                        type: integer
+                      preemptLowerPriorityImports:
+                        description: PreemptLowerPriorityImports allows a queued worker
+                          pod to preempt the importer pods of lower priority PVCs,
+                          which restart their import from the beginning once admitted
+                          again. Only the imports annotated with cdi.kubevirt.io/storage.import.preemptible
+                          are preempted. The priority of a PVC is the value of its
+                          priority class.
+                        type: boolean
+                    type: object
                 type: object
               imagePullPolicy:
                 description: PullPolicy describes a policy for if/when to pull a container
@@ -2330,6 +2440,30 @@ spec:
               config:
                 description: CDIConfig at CDI level
                 properties:
+                  cloneBandwidthLimit:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: CloneBandwidthLimit is the maximum rate, in bytes
+                      per second, at which host-assisted clones read from their source
+                      volume. Not limited by default.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  dataImportCronMaxConcurrentImports:
+                    description: DataImportCronMaxConcurrentImports is the maximum
+                      number of DataImportCron imports in progress in the cluster.
+                      Further imports are queued until others complete. Not limited
+                      by default.
+                    format: int32
+                    type: integer
+                  dataImportCronValidationImages:
+                    description: DataImportCronValidationImages are the container
+                      images DataImportCron validation Jobs are allowed to run. A
+                      validation Job with any other image is not run, and the import
+                      fails validation.
+                    items:
+                      type: string
+                    type: array
                   dataVolumeTTLSeconds:
                     description: DataVolumeTTLSeconds is the time in seconds after
                       DataVolume completion it can be garbage collected. Disabled
@@ -2379,6 +2513,21 @@ spec:
                       type: object
                       x-kubernetes-map-type: atomic
                     type: array
+                  importCache:
+                    description: ImportCache enables a node-local cache of imported
+                      registry image layers and HTTP images, shared by the importer
+                      pods running on each node
+                    properties:
+                      sizeLimit:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: SizeLimit is the maximum size of the cache on
+                          each node, the least recently used entries are evicted beyond
+                          it. Defaults to 10Gi.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    type: object
                   importProxy:
                     description: ImportProxy contains importer pod proxy configuration.
                     properties:
@@ -2472,6 +2621,43 @@ spec:
                     description: Preallocation controls whether storage for DataVolumes
                       should be allocated in advance.
                     type: boolean
+                  retryPolicy:
+                    description: RetryPolicy controls how failing imports are retried
+                      unless a DataVolume sets its own. Importer pods are restarted
+                      by Kubernetes indefinitely when neither sets a retry policy.
+                    properties:
+                      backoffBase:
+                        description: BackoffBase is the delay before the first retry,
+                          doubled on each further retry. Defaults to 10s.
+                        type: string
+                      backoffCap:
+                        description: BackoffCap is the maximum delay between retries.
+                          Defaults to 5m.
+                        type: string
+                      maxAttempts:
+                        description: MaxAttempts is the maximum number of import attempts,
+                          including the first one. Unlimited by default.
+                        format: int32
+                        type: integer
+                      retryOn:
+                        description: RetryOn is the list of error classes which are
+                          retried, any other error fails the import on its first occurrence.
+                          By default, the errors which the importer reports as retryable
+                          are retried.
+                        items:
+                          description: ImportErrorClass is the class of an import
+                            error
+                          enum:
+                          - Network
+                          - Auth
+                          - NotFound
+                          - InvalidImage
+                          - OutOfSpace
+                          - Unknown
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                    type: object
                   scratchSpaceStorageClass:
                     description: 'Override the storage class to used for scratch space
                       during transfer operations. The scratch space storage class
@@ -2575,6 +2761,40 @@ spec:
                   uploadProxyURLOverride:
                     description: Override the URL used when uploading to a DataVolume
                     type: string
+                  workerPodLimits:
+                    description: WorkerPodLimits limits the number of concurrent importer
+                      and host-assisted clone pods. PVCs waiting for a worker pod are queued.
+                      Not limited by default.
+                    properties:
+                      global:
+                        description: Global is the maximum number of worker pods in
+                          the cluster
+                        format: int32
+                        type: integer
+                      perNamespace:
+                        description: PerNamespace is the maximum number of worker
+                          pods in each namespace
+                        format: int32
+                        type: integer
+                      perSourceHost:
+                        description: PerSourceHost is the maximum number of importer
+                          pods reading from each source host
+                        format: int32
+                        type: integer
+                      perStorageClass:
+                        description: PerStorageClass is the maximum number of worker
+                          pods writing to PVCs of each storage class
+                        format: int32
+                        type: integer
+                      preemptLowerPriorityImports:
+                        description: PreemptLowerPriorityImports allows a queued worker
+                          pod to preempt the importer pods of lower priority PVCs,
+                          which restart their import from the beginning once admitted
+                          again. Only the imports annotated with cdi.kubevirt.io/storage.import.preemptible
+                          are preempted. The priority of a PVC is the value of its
+                          priority class.
+                        type: boolean
+                    type: object
                 type: object
               imagePullPolicy:
                 description: PullPolicy describes a policy for if/when to pull a container
@@ -4544,6 +4764,28 @@ spec:
           spec:
             description: CDIConfigSpec defines specification for user configuration
             properties:
+              cloneBandwidthLimit:
+                anyOf:
+                - type: integer
+                - type: string
+                description: CloneBandwidthLimit is the maximum rate, in bytes per
+                  second, at which host-assisted clones read from their source volume.
+                  Not limited by default.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              dataImportCronMaxConcurrentImports:
+                description: DataImportCronMaxConcurrentImports is the maximum number
+                  of DataImportCron imports in progress in the cluster. Further imports
+                  are queued until others complete. Not limited by default.
+                format: int32
+                type: integer
+              dataImportCronValidationImages:
+                description: DataImportCronValidationImages are the container images
+                  DataImportCron validation Jobs are allowed to run. A validation Job
+                  with any other image is not run, and the import fails validation.
+                items:
+                  type: string
+                type: array
               dataVolumeTTLSeconds:
                 description: DataVolumeTTLSeconds is the time in seconds after DataVolume
                   completion it can be garbage collected. Disabled by default.
@@ -4591,6 +4833,21 @@ spec:
                   type: object
                   x-kubernetes-map-type: atomic
                 type: array
+              importCache:
+                description: ImportCache enables a node-local cache of imported registry
+                  image layers and HTTP images, shared by the importer pods running
+                  on each node
+                properties:
+                  sizeLimit:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: SizeLimit is the maximum size of the cache on each
+                      node, the least recently used entries are evicted beyond it.
+                      Defaults to 10Gi.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                type: object
               importProxy:
                 description: ImportProxy contains importer pod proxy configuration.
                 properties:
@@ -4681,6 +4938,42 @@ spec:
                 description: Preallocation controls whether storage for DataVolumes
                   should be allocated in advance.
                 type: boolean
+              retryPolicy:
+                description: RetryPolicy controls how failing imports are retried
+                  unless a DataVolume sets its own. Importer pods are restarted by
+                  Kubernetes indefinitely when neither sets a retry policy.
+                properties:
+                  backoffBase:
+                    description: BackoffBase is the delay before the first retry,
+                      doubled on each further retry. Defaults to 10s.
+                    type: string
+                  backoffCap:
+                    description: BackoffCap is the maximum delay between retries.
+                      Defaults to 5m.
+                    type: string
+                  maxAttempts:
+                    description: MaxAttempts is the maximum number of import attempts,
+                      including the first one. Unlimited by default.
+                    format: int32
+                    type: integer
+                  retryOn:
+                    description: RetryOn is the list of error classes which are retried,
+                      any other error fails the import on its first occurrence. By
+                      default, the errors which the importer reports as retryable
+                      are retried.
+                    items:
+                      description: ImportErrorClass is the class of an import error
+                      enum:
+                      - Network
+                      - Auth
+                      - NotFound
+                      - InvalidImage
+                      - OutOfSpace
+                      - Unknown
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                type: object
               scratchSpaceStorageClass:
                 description: 'Override the storage class to used for scratch space
                   during transfer operations. The scratch space storage class is determined
@@ -4778,6 +5071,40 @@ spec:
               uploadProxyURLOverride:
                 description: Override the URL used when uploading to a DataVolume
                 type: string
+              workerPodLimits:
+                description: WorkerPodLimits limits the number of concurrent importer
+                  and host-assisted clone pods. PVCs waiting for a worker pod are queued.
+                  Not limited by default.
+                properties:
+                  global:
+                    description: Global is the maximum number of worker pods in the
+                      cluster
+                    format: int32
+                    type: integer
+                  perNamespace:
+                    description: PerNamespace is the maximum number of worker pods
+                      in each namespace
+                    format: int32
+                    type: integer
+                  perSourceHost:
+                    description: PerSourceHost is the maximum number of importer pods
+                      reading from each source host
+                    format: int32
+                    type: integer
+                  perStorageClass:
+                    description: PerStorageClass is the maximum number of worker pods
+                      writing to PVCs of each storage class
+                    format: int32
+                    type: integer
+                  preemptLowerPriorityImports:
+                    description: PreemptLowerPriorityImports allows a queued worker
+                      pod to preempt the importer pods of lower priority PVCs, which
+                      restart their import from the beginning once admitted again.
+                      Only the imports annotated with cdi.kubevirt.io/storage.import.preemptible
+                      are preempted. The priority of a PVC is the value of its priority
+                      class.
+                    type: boolean
+                type: object
             type: object
           status:
             description: CDIConfigStatus provides the most recently observed status
@@ -4966,11 +5293,54 @@ spec:
           spec:
             description: DataImportCronSpec defines specification for DataImportCron
             properties:
+              checksumURL:
+                description: ChecksumURL is the URL of a checksum file, like SHA256SUMS,
+                  listing the sha256 checksum of the http source. When set, source
+                  updates are detected by checksum changes instead of the ETag or
+                  Last-Modified response headers.
+                type: string
               garbageCollect:
                 description: GarbageCollect specifies whether old PVCs should be cleaned
                   up after a new PVC is imported. Options are currently "Outdated"
                   and "Never", defaults to "Outdated".
                 type: string
+              importWindow:
+                description: ImportWindow restricts when new imports are started.
+                  Polls still run according to the schedule, and an updated source
+                  is imported once the window opens.
+                properties:
+                  days:
+                    description: Days are the week days the window starts on, e.g.
+                      Monday. Defaults to all days.
+                    items:
+                      type: string
+                    type: array
+                  end:
+                    description: End is the window end time of day, in HH:MM format.
+                      A window ending before its start ends on the next day.
+                    type: string
+                  start:
+                    description: Start is the window start time of day, in HH:MM format
+                    type: string
+                required:
+                - end
+                - start
+                type: object
+              importsMaxAge:
+                description: ImportsMaxAge is the age after which imports are garbage
+                  collected, based on their last use time. When set, ImportsToKeep
+                  is the minimum number of imports kept regardless of their age.
+                type: string
+              importsMaxTotalSize:
+                anyOf:
+                - type: integer
+                - type: string
+                description: ImportsMaxTotalSize is the total size budget of the imports.
+                  Older imports exceeding the budget are garbage collected. When set,
+                  ImportsToKeep is the minimum number of imports kept regardless of
+                  their size.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
               importsToKeep:
                 description: Number of import PVCs to keep when garbage collecting.
                   Default is 3.
@@ -4990,6 +5360,31 @@ spec:
                 description: Schedule specifies in cron format when and how often
                   to look for new imports
                 type: string
+              scheduleJitter:
+                description: ScheduleJitter is the maximum delay added to the scheduled
+                  polls, to spread the polls and imports of crons sharing the same
+                  schedule. The delay of each cron is fixed, and derived from its
+                  UID.
+                type: string
+              storageClassTargets:
+                description: StorageClassTargets are additional storage classes each
+                  new import is cloned to, each with its own managed DataSource
+                items:
+                  description: DataImportCronStorageClassTarget is an additional storage
+                    class a DataImportCron import is cloned to
+                  properties:
+                    managedDataSource:
+                      description: ManagedDataSource is the name of the DataSource
+                        managed for the storage class. Defaults to the cron managedDataSource
+                        suffixed with the storage class name.
+                      type: string
+                    storageClassName:
+                      description: StorageClassName is the name of the storage class
+                      type: string
+                  required:
+                  - storageClassName
+                  type: object
+                type: array
               template:
                 description: Template specifies template for the DVs to be created
                 properties:
@@ -5259,6 +5654,42 @@ spec:
                               PersistentVolume backing this claim.
                             type: string
                         type: object
+                      retryPolicy:
+                        description: RetryPolicy controls how a failing import is
+                          retried, overriding the CDIConfig retry policy
+                        properties:
+                          backoffBase:
+                            description: BackoffBase is the delay before the first
+                              retry, doubled on each further retry. Defaults to 10s.
+                            type: string
+                          backoffCap:
+                            description: BackoffCap is the maximum delay between retries.
+                              Defaults to 5m.
+                            type: string
+                          maxAttempts:
+                            description: MaxAttempts is the maximum number of import
+                              attempts, including the first one. Unlimited by default.
+                            format: int32
+                            type: integer
+                          retryOn:
+                            description: RetryOn is the list of error classes which
+                              are retried, any other error fails the import on its
+                              first occurrence. By default, the errors which the importer
+                              reports as retryable are retried.
+                            items:
+                              description: ImportErrorClass is the class of an import
+                                error
+                              enum:
+                              - Network
+                              - Auth
+                              - NotFound
+                              - InvalidImage
+                              - OutOfSpace
+                              - Unknown
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: set
+                        type: object
                       source:
                         description: Source is the src of the data for the requested
                           DataVolume
@@ -5292,6 +5723,12 @@ spec:
                                   containing a Certificate Authority(CA) public key,
                                   and a base64 encoded pem certificate
                                 type: string
+                              checksum:
+                                description: Checksum is the checksum of the image
+                                  in the format <algorithm>:<hex digest>, e.g. sha256:1234...
+                                  It identifies the image when the ImportDeduplication
+                                  feature gate is enabled
+                                type: string
                               extraHeaders:
                                 description: ExtraHeaders is a list of strings containing
                                   extra headers to include with HTTP transfer requests
@@ -5348,6 +5785,25 @@ spec:
                               namespace:
                                 description: The namespace of the source PVC
                                 type: string
+                              pathFilter:
+                                description: PathFilter selects the paths copied from
+                                  the source PVC, only valid for filesystem clones
+                                  with archive content
+                                properties:
+                                  exclude:
+                                    description: Exclude is the list of paths or patterns,
+                                      relative to the root of the source PVC, to skip
+                                    items:
+                                      type: string
+                                    type: array
+                                  include:
+                                    description: Include is the list of paths, relative
+                                      to the root of the source PVC, to copy. Everything
+                                      is copied if empty
+                                    items:
+                                      type: string
+                                    type: array
+                                type: object
                             required:
                             - name
                             - namespace
@@ -5718,6 +6174,201 @@ spec:
                 required:
                 - spec
                 type: object
+              validation:
+                description: Validation specifies a Job that validates each new import
+                  before the managed DataSource is updated to point to it.
+                properties:
+                  activeDeadlineSeconds:
+                    description: ActiveDeadlineSeconds is the duration in seconds
+                      the validation Job may run before it is considered failed
+                    format: int64
+                    type: integer
+                  args:
+                    description: Args are the arguments to the entrypoint of the validation
+                      container
+                    items:
+                      type: string
+                    type: array
+                  backoffLimit:
+                    description: BackoffLimit is the number of retries before the
+                      validation is considered failed. Default is 0.
+                    format: int32
+                    type: integer
+                  command:
+                    description: Command is the entrypoint of the validation container
+                    items:
+                      type: string
+                    type: array
+                  env:
+                    description: Env is a list of environment variables to set in
+                      the validation container
+                    items:
+                      description: EnvVar represents an environment variable present
+                        in a Container.
+                      properties:
+                        name:
+                          description: Name of the environment variable. Must be a
+                            C_IDENTIFIER.
+                          type: string
+                        value:
+                          description: 'Variable references $(VAR_NAME) are expanded
+                            using the previously defined environment variables in
+                            the container and any service environment variables. If
+                            a variable cannot be resolved, the reference in the input
+                            string will be unchanged. Double $$ are reduced to a single
+                            $, which allows for escaping the $(VAR_NAME) syntax: i.e.
+                            "$$(VAR_NAME)" will produce the string literal "$(VAR_NAME)".
+                            Escaped references will never be expanded, regardless
+                            of whether the variable exists or not. Defaults to "".'
+                          type: string
+                        valueFrom:
+                          description: Source for the environment variable's value.
+                            Cannot be used if value is not empty.
+                          properties:
+                            configMapKeyRef:
+                              description: Selects a key of a ConfigMap.
+                              properties:
+                                key:
+                                  description: The key to select.
+                                  type: string
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                                optional:
+                                  description: Specify whether the ConfigMap or its
+                                    key must be defined
+                                  type: boolean
+                              required:
+                              - key
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            fieldRef:
+                              description: 'Selects a field of the pod: supports metadata.name,
+                                metadata.namespace, ` + "`" + `metadata.labels[''<KEY>'']` + "`" + `,
+                                ` + "`" + `metadata.annotations[''<KEY>'']` + "`" + `, spec.nodeName,
+                                spec.serviceAccountName, status.hostIP, status.podIP,
+                                status.podIPs.'
+                              properties:
+                                apiVersion:
+                                  description: Version of the schema the FieldPath
+                                    is written in terms of, defaults to "v1".
+                                  type: string
+                                fieldPath:
+                                  description: Path of the field to select in the
+                                    specified API version.
+                                  type: string
+                              required:
+                              - fieldPath
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            resourceFieldRef:
+                              description: 'Selects a resource of the container: only
+                                resources limits and requests (limits.cpu, limits.memory,
+                                limits.ephemeral-storage, requests.cpu, requests.memory
+                                and requests.ephemeral-storage) are currently supported.'
+                              properties:
+                                containerName:
+                                  description: 'Container name: required for volumes,
+                                    optional for env vars'
+                                  type: string
+                                divisor:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Specifies the output format of the
+                                    exposed resources, defaults to "1"
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                resource:
+                                  description: 'Required: resource to select'
+                                  type: string
+                              required:
+                              - resource
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secretKeyRef:
+                              description: Selects a key of a secret in the pod's
+                                namespace
+                              properties:
+                                key:
+                                  description: The key of the secret to select from.  Must
+                                    be a valid secret key.
+                                  type: string
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                                optional:
+                                  description: Specify whether the Secret or its key
+                                    must be defined
+                                  type: boolean
+                              required:
+                              - key
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
+                      required:
+                      - name
+                      type: object
+                    type: array
+                  image:
+                    description: Image is the container image of the validation Job
+                    type: string
+                  resources:
+                    description: Resources are the compute resources required by the
+                      validation container
+                    properties:
+                      claims:
+                        description: "Claims lists the names of resources, defined
+                          in spec.resourceClaims, that are used by this container.
+                          \n This is an alpha field and requires enabling the DynamicResourceAllocation
+                          feature gate. \n This field is immutable. It can only be
+                          set for containers."
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: Name must match the name of one entry in
+                                pod.spec.resourceClaims of the Pod where this field
+                                is used. It makes that resource available inside a
+                                container.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: 'Limits describes the maximum amount of compute
+                          resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: 'Requests describes the minimum amount of compute
+                          resources required. If Requests is omitted for a container,
+                          it defaults to Limits if that is explicitly specified, otherwise
+                          to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                        type: object
+                    type: object
+                required:
+                - image
+                type: object
             required:
             - managedDataSource
             - schedule
@@ -5771,6 +6422,31 @@ spec:
                   - Digest
                   type: object
                 type: array
+              history:
+                description: History is a bounded list of the recent polls and imports,
+                  most recent first
+                items:
+                  description: DataImportCronRun is a single poll or import of a DataImportCron
+                  properties:
+                    action:
+                      description: Action is the outcome of the run
+                      type: string
+                    digest:
+                      description: Digest is the source digest the run was for
+                      type: string
+                    message:
+                      description: Message is the error reported by the poller or
+                        importer of a failed run
+                      type: string
+                    time:
+                      description: Time of the run
+                      format: date-time
+                      type: string
+                  required:
+                  - action
+                  - time
+                  type: object
+                type: array
               lastExecutionTimestamp:
                 description: LastExecutionTimestamp is the time of the last polling
                 format: date-time
@@ -5788,6 +6464,24 @@ spec:
                   namespace:
                     description: The namespace of the source PVC
                     type: string
+                  pathFilter:
+                    description: PathFilter selects the paths copied from the source
+                      PVC, only valid for filesystem clones with archive content
+                    properties:
+                      exclude:
+                        description: Exclude is the list of paths or patterns, relative
+                          to the root of the source PVC, to skip
+                        items:
+                          type: string
+                        type: array
+                      include:
+                        description: Include is the list of paths, relative to the
+                          root of the source PVC, to copy. Everything is copied if
+                          empty
+                        items:
+                          type: string
+                        type: array
+                    type: object
                 required:
                 - name
                 - namespace
@@ -5796,6 +6490,62 @@ spec:
                 description: SourceFormat defines the format of the DataImportCron-created
                   disk image sources
                 type: string
+              storageClassTargets:
+                description: StorageClassTargets is the state of the copies of the
+                  last import in the storage class targets
+                items:
+                  description: DataImportCronStorageClassTargetStatus is the state
+                    of a DataImportCron storage class target
+                  properties:
+                    lastImportedPVC:
+                      description: LastImportedPVC is the last copy of the import
+                        in the storage class
+                      properties:
+                        name:
+                          description: The name of the source PVC
+                          type: string
+                        namespace:
+                          description: The namespace of the source PVC
+                          type: string
+                        pathFilter:
+                          description: PathFilter selects the paths copied from the
+                            source PVC, only valid for filesystem clones with archive
+                            content
+                          properties:
+                            exclude:
+                              description: Exclude is the list of paths or patterns,
+                                relative to the root of the source PVC, to skip
+                              items:
+                                type: string
+                              type: array
+                            include:
+                              description: Include is the list of paths, relative
+                                to the root of the source PVC, to copy. Everything
+                                is copied if empty
+                              items:
+                                type: string
+                              type: array
+                          type: object
+                      required:
+                      - name
+                      - namespace
+                      type: object
+                    managedDataSource:
+                      description: ManagedDataSource is the name of the DataSource
+                        managed for the storage class
+                      type: string
+                    sourceFormat:
+                      description: SourceFormat is the format of the disk image sources
+                        in the storage class
+                      type: string
+                    storageClassName:
+                      description: StorageClassName is the name of the storage class
+                      type: string
+                  required:
+                  - managedDataSource
+                  - storageClassName
+                  type: object
+                type: array
             type: object
         required:
         - spec
@@ -5850,9 +6600,132 @@ spec:
           spec:
             description: DataSourceSpec defines specification for DataSource
             properties:
+              cache:
+                description: Cache is the storage of the cache PVC a remote source
+                  is imported to. Required for a remote source.
+                properties:
+                  size:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: Size is the storage size of the cache PVC
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  storageClassName:
+                    description: StorageClassName is the storage class of the cache
+                      PVC. The default storage class is used if not set.
+                    type: string
+                required:
+                - size
+                type: object
+              grant:
+                description: Grant shares the DataSource with other namespaces. DataVolumes
+                  in the granted namespaces may clone the DataSource source without
+                  permissions on it, and DataVolumes in other namespaces may not reference
+                  the DataSource.
+                properties:
+                  namespaceSelector:
+                    description: NamespaceSelector selects the granted namespaces
+                      by their labels
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: A label selector requirement is a selector
+                            that contains values, a key, and an operator that relates
+                            the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: operator represents a key's relationship
+                                to a set of values. Valid operators are In, NotIn,
+                                Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: values is an array of string values. If
+                                the operator is In or NotIn, the values array must
+                                be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced
+                                during a strategic merge patch.
+                              items:
+                                type: string
+                              type: array
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: matchLabels is a map of {key,value} pairs. A
+                          single {key,value} in the matchLabels map is equivalent
+                          to an element of matchExpressions, whose key field is "key",
+                          the operator is "In", and the values array contains only
+                          "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  namespaces:
+                    description: Namespaces are the names of the granted namespaces
+                    items:
+                      type: string
+                    type: array
+                type: object
+              pinnedRevision:
+                description: PinnedRevision pins the DataSource source to an earlier
+                  revision listed in status.revisions, e.g. to roll back a bad image.
+                  A pinned DataSource is not updated by its DataImportCron.
+                format: int64
+                type: integer
               source:
                 description: Source is the source of the data referenced by the DataSource
                 properties:
+                  http:
+                    description: HTTP is a remote http source. The first DataVolume
+                      referencing the DataSource imports it to a cache PVC in the
+                      DataSource namespace, which is cloned by the DataVolumes referencing
+                      the DataSource.
+                    properties:
+                      certConfigMap:
+                        description: CertConfigMap is a configmap reference, containing
+                          a Certificate Authority(CA) public key, and a base64 encoded
+                          pem certificate
+                        type: string
+                      checksum:
+                        description: Checksum is the checksum of the image in the
+                          format <algorithm>:<hex digest>, e.g. sha256:1234... It
+                          identifies the image when the ImportDeduplication feature
+                          gate is enabled
+                        type: string
+                      extraHeaders:
+                        description: ExtraHeaders is a list of strings containing
+                          extra headers to include with HTTP transfer requests
+                        items:
+                          type: string
+                        type: array
+                      secretExtraHeaders:
+                        description: SecretExtraHeaders is a list of Secret references,
+                          each containing an extra HTTP header that may include sensitive
+                          information
+                        items:
+                          type: string
+                        type: array
+                      secretRef:
+                        description: SecretRef A Secret reference, the secret should
+                          contain accessKeyId (user name) base64 encoded, and secretKey
+                          (password) also base64 encoded
+                        type: string
+                      url:
+                        description: URL is the URL of the http(s) endpoint
+                        type: string
+                    required:
+                    - url
+                    type: object
                   pvc:
                     description: DataVolumeSourcePVC provides the parameters to create
                       a Data Volume from an existing PVC
@@ -5863,10 +6736,53 @@ spec:
                       namespace:
                         description: The namespace of the source PVC
                         type: string
+                      pathFilter:
+                        description: PathFilter selects the paths copied from the
+                          source PVC, only valid for filesystem clones with archive
+                          content
+                        properties:
+                          exclude:
+                            description: Exclude is the list of paths or patterns,
+                              relative to the root of the source PVC, to skip
+                            items:
+                              type: string
+                            type: array
+                          include:
+                            description: Include is the list of paths, relative to
+                              the root of the source PVC, to copy. Everything is copied
+                              if empty
+                            items:
+                              type: string
+                            type: array
+                        type: object
                     required:
                     - name
                     - namespace
                     type: object
+                  registry:
+                    description: Registry is a remote registry source, imported to
+                      a cache PVC like the HTTP source
+                    properties:
+                      certConfigMap:
+                        description: CertConfigMap provides a reference to the Registry
+                          certs
+                        type: string
+                      imageStream:
+                        description: ImageStream is the name of image stream for import
+                        type: string
+                      pullMethod:
+                        description: PullMethod can be either "pod" (default import),
+                          or "node" (node docker cache based import)
+                        type: string
+                      secretRef:
+                        description: SecretRef provides the secret reference needed
+                          to access the Registry source
+                        type: string
+                      url:
+                        description: 'URL is the url of the registry source (starting
+                          with the scheme: docker, oci-archive)'
+                        type: string
+                    type: object
                   snapshot:
                     description: DataVolumeSourceSnapshot provides the parameters
                       to create a Data Volume from an existing VolumeSnapshot
@@ -5915,10 +6831,198 @@ spec:
                   - type
                   type: object
                 type: array
+              revision:
+                description: Revision is the revision number of the current source
+                format: int64
+                type: integer
+              revisions:
+                description: Revisions is the bounded history of the DataSource sources,
+                  oldest first. Revisions whose source no longer exists are removed.
+                items:
+                  description: DataSourceRevision is a source the DataSource has pointed
+                    to
+                  properties:
+                    digest:
+                      description: Digest is the digest of the DataImportCron import
+                        of the source, if any
+                      type: string
+                    revision:
+                      description: Revision is the revision number, increasing with
+                        every new source
+                      format: int64
+                      type: integer
+                    source:
+                      description: Source is the source of the revision
+                      properties:
+                        http:
+                          description: HTTP is a remote http source. The first DataVolume
+                            referencing the DataSource imports it to a cache PVC in
+                            the DataSource namespace, which is cloned by the DataVolumes
+                            referencing the DataSource.
+                          properties:
+                            certConfigMap:
+                              description: CertConfigMap is a configmap reference,
+                                containing a Certificate Authority(CA) public key,
+                                and a base64 encoded pem certificate
+                              type: string
+                            checksum:
+                              description: Checksum is the checksum of the image in
+                                the format <algorithm>:<hex digest>, e.g. sha256:1234...
+                                It identifies the image when the ImportDeduplication
+                                feature gate is enabled
+                              type: string
+                            extraHeaders:
+                              description: ExtraHeaders is a list of strings containing
+                                extra headers to include with HTTP transfer requests
+                              items:
+                                type: string
+                              type: array
+                            secretExtraHeaders:
+                              description: SecretExtraHeaders is a list of Secret
+                                references, each containing an extra HTTP header that
+                                may include sensitive information
+                              items:
+                                type: string
+                              type: array
+                            secretRef:
+                              description: SecretRef A Secret reference, the secret
+                                should contain accessKeyId (user name) base64 encoded,
+                                and secretKey (password) also base64 encoded
+                              type: string
+                            url:
+                              description: URL is the URL of the http(s) endpoint
+                              type: string
+                          required:
+                          - url
+                          type: object
+                        pvc:
+                          description: DataVolumeSourcePVC provides the parameters
+                            to create a Data Volume from an existing PVC
+                          properties:
+                            name:
+                              description: The name of the source PVC
+                              type: string
+                            namespace:
+                              description: The namespace of the source PVC
+                              type: string
+                            pathFilter:
+                              description: PathFilter selects the paths copied from
+                                the source PVC, only valid for filesystem clones with
+                                archive content
+                              properties:
+                                exclude:
+                                  description: Exclude is the list of paths or patterns,
+                                    relative to the root of the source PVC, to skip
+                                  items:
+                                    type: string
+                                  type: array
+                                include:
+                                  description: Include is the list of paths, relative
+                                    to the root of the source PVC, to copy. Everything
+                                    is copied if empty
+                                  items:
+                                    type: string
+                                  type: array
+                              type: object
+                          required:
+                          - name
+                          - namespace
+                          type: object
+                        registry:
+                          description: Registry is a remote registry source, imported
+                            to a cache PVC like the HTTP source
+                          properties:
+                            certConfigMap:
+                              description: CertConfigMap provides a reference to the
+                                Registry certs
+                              type: string
+                            imageStream:
+                              description: ImageStream is the name of image stream
+                                for import
+                              type: string
+                            pullMethod:
+                              description: PullMethod can be either "pod" (default
+                                import), or "node" (node docker cache based import)
+                              type: string
+                            secretRef:
+                              description: SecretRef provides the secret reference
+                                needed to access the Registry source
+                              type: string
+                            url:
+                              description: 'URL is the url of the registry source
+                                (starting with the scheme: docker, oci-archive)'
+                              type: string
+                          type: object
+                        snapshot:
+                          description: DataVolumeSourceSnapshot provides the parameters
+                            to create a Data Volume from an existing VolumeSnapshot
+                          properties:
+                            name:
+                              description: The name of the source VolumeSnapshot
+                              type: string
+                            namespace:
+                              description: The namespace of the source VolumeSnapshot
+                              type: string
+                          required:
+                          - name
+                          - namespace
+                          type: object
+                      type: object
+                    timestamp:
+                      description: Timestamp is the time the revision was created
+                      format: date-time
+                      type: string
+                  required:
+                  - revision
+                  - source
+                  - timestamp
+                  type: object
+                type: array
               source:
                 description: Source is the current source of the data referenced by
                   the DataSource
                 properties:
+                  http:
+                    description: HTTP is a remote http source. The first DataVolume
+                      referencing the DataSource imports it to a cache PVC in the
+                      DataSource namespace, which is cloned by the DataVolumes referencing
+                      the DataSource.
+                    properties:
+                      certConfigMap:
+                        description: CertConfigMap is a configmap reference, containing
+                          a Certificate Authority(CA) public key, and a base64 encoded
+                          pem certificate
+                        type: string
+                      checksum:
+                        description: Checksum is the checksum of the image in the
+                          format <algorithm>:<hex digest>, e.g. sha256:1234... It
+                          identifies the image when the ImportDeduplication feature
+                          gate is enabled
+                        type: string
+                      extraHeaders:
+                        description: ExtraHeaders is a list of strings containing
+                          extra headers to include with HTTP transfer requests
+                        items:
+                          type: string
+                        type: array
+                      secretExtraHeaders:
+                        description: SecretExtraHeaders is a list of Secret references,
+                          each containing an extra HTTP header that may include sensitive
+                          information
+                        items:
+                          type: string
+                        type: array
+                      secretRef:
+                        description: SecretRef A Secret reference, the secret should
+                          contain accessKeyId (user name) base64 encoded, and secretKey
+                          (password) also base64 encoded
+                        type: string
+                      url:
+                        description: URL is the URL of the http(s) endpoint
+                        type: string
+                    required:
+                    - url
+                    type: object
                   pvc:
                     description: DataVolumeSourcePVC provides the parameters to create
                       a Data Volume from an existing PVC
@@ -5929,10 +7033,53 @@ spec:
                       namespace:
                         description: The namespace of the source PVC
                         type: string
+                      pathFilter:
+                        description: PathFilter selects the paths copied from the
+                          source PVC, only valid for filesystem clones with archive
+                          content
+                        properties:
+                          exclude:
+                            description: Exclude is the list of paths or patterns,
+                              relative to the root of the source PVC, to skip
+                            items:
+                              type: string
+                            type: array
+                          include:
+                            description: Include is the list of paths, relative to
+                              the root of the source PVC, to copy. Everything is copied
+                              if empty
+                            items:
+                              type: string
+                            type: array
+                        type: object
                     required:
                     - name
                     - namespace
                     type: object
+                  registry:
+                    description: Registry is a remote registry source, imported to
+                      a cache PVC like the HTTP source
+                    properties:
+                      certConfigMap:
+                        description: CertConfigMap provides a reference to the Registry
+                          certs
+                        type: string
+                      imageStream:
+                        description: ImageStream is the name of image stream for import
+                        type: string
+                      pullMethod:
+                        description: PullMethod can be either "pod" (default import),
+                          or "node" (node docker cache based import)
+                        type: string
+                      secretRef:
+                        description: SecretRef provides the secret reference needed
+                          to access the Registry source
+                        type: string
+                      url:
+                        description: 'URL is the url of the registry source (starting
+                          with the scheme: docker, oci-archive)'
+                        type: string
+                    type: object
                   snapshot:
                     description: DataVolumeSourceSnapshot provides the parameters
                       to create a Data Volume from an existing VolumeSnapshot
@@ -6256,6 +7403,41 @@ spec:
                       backing this claim.
                     type: string
                 type: object
+              retryPolicy:
+                description: RetryPolicy controls how a failing import is retried,
+                  overriding the CDIConfig retry policy
+                properties:
+                  backoffBase:
+                    description: BackoffBase is the delay before the first retry,
+                      doubled on each further retry. Defaults to 10s.
+                    type: string
+                  backoffCap:
+                    description: BackoffCap is the maximum delay between retries.
+                      Defaults to 5m.
+                    type: string
+                  maxAttempts:
+                    description: MaxAttempts is the maximum number of import attempts,
+                      including the first one. Unlimited by default.
+                    format: int32
+                    type: integer
+                  retryOn:
+                    description: RetryOn is the list of error classes which are retried,
+                      any other error fails the import on its first occurrence. By
+                      default, the errors which the importer reports as retryable
+                      are retried.
+                    items:
+                      description: ImportErrorClass is the class of an import error
+                      enum:
+                      - Network
+                      - Auth
+                      - NotFound
+                      - InvalidImage
+                      - OutOfSpace
+                      - Unknown
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                type: object
               source:
                 description: Source is the src of the data for the requested DataVolume
                 properties:
@@ -6287,6 +7469,12 @@ spec:
                           a Certificate Authority(CA) public key, and a base64 encoded
                           pem certificate
                         type: string
+                      checksum:
+                        description: Checksum is the checksum of the image in the
+                          format <algorithm>:<hex digest>, e.g. sha256:1234... It
+                          identifies the image when the ImportDeduplication feature
+                          gate is enabled
+                        type: string
                       extraHeaders:
                         description: ExtraHeaders is a list of strings containing
                           extra headers to include with HTTP transfer requests
@@ -6343,6 +7531,25 @@ spec:
                       namespace:
                         description: The namespace of the source PVC
                         type: string
+                      pathFilter:
+                        description: PathFilter selects the paths copied from the
+                          source PVC, only valid for filesystem clones with archive
+                          content
+                        properties:
+                          exclude:
+                            description: Exclude is the list of paths or patterns,
+                              relative to the root of the source PVC, to skip
+                            items:
+                              type: string
+                            type: array
+                          include:
+                            description: Include is the list of paths, relative to
+                              the root of the source PVC, to copy. Everything is copied
+                              if empty
+                            items:
+                              type: string
+                            type: array
+                        type: object
                     required:
                     - name
                     - namespace
@@ -6899,6 +8106,15 @@ spec:
                       type: string
                   type: object
                 type: array
+              cloneBandwidthLimit:
+                anyOf:
+                - type: integer
+                - type: string
+                description: CloneBandwidthLimit is the maximum rate, in bytes per
+                  second, at which host-assisted clones read from source volumes of
+                  this storage class. Overrides the CDIConfig limit.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
               cloneStrategy:
                 description: CloneStrategy defines the preferred method for performing
                   a CDI clone
@@ -6937,6 +8153,15 @@ spec:
                       type: string
                   type: object
                 type: array
+              cloneBandwidthLimit:
+                anyOf:
+                - type: integer
+                - type: string
+                description: CloneBandwidthLimit is the maximum rate, in bytes per
+                  second, at which host-assisted clones read from source volumes of
+                  this storage class. Overrides the CDIConfig limit.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
               cloneStrategy:
                 description: CloneStrategy defines the preferred method for performing
                   a CDI clone
@@ -7149,6 +8374,12 @@ spec:
                           a Certificate Authority(CA) public key, and a base64 encoded
                           pem certificate
                         type: string
+                      checksum:
+                        description: Checksum is the checksum of the image in the
+                          format <algorithm>:<hex digest>, e.g. sha256:1234... It
+                          identifies the image when the ImportDeduplication feature
+                          gate is enabled
+                        type: string
                       extraHeaders:
                         description: ExtraHeaders is a list of strings containing
                           extra headers to include with HTTP transfer requests
@@ -91,6 +91,22 @@ func getControllerNamespacedRules() []rbacv1.PolicyRule {
 				"watch",
 			},
 		},
+		{
+			APIGroups: []string{
+				"apps",
+			},
+			Resources: []string{
+				"daemonsets",
+			},
+			Verbs: []string{
+				"get",
+				"list",
+				"watch",
+				"create",
+				"update",
+				"delete",
+			},
+		},
 		{
 			APIGroups: []string{
 				"batch",
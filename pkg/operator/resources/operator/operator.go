@@ -130,6 +130,7 @@ func getClusterPolicyRules() []rbacv1.PolicyRule {
 			},
 			ResourceNames: []string{
 				"cdi-api-dataimportcron-validate",
+				"cdi-api-datasource-validate",
 				"cdi-api-populator-validate",
 				"cdi-api-datavolume-validate",
 				"cdi-api-validate",
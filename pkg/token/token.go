@@ -37,6 +37,9 @@ const (
 
 	// OperationUpload is the type of token for uploading to a PVC
 	OperationUpload Operation = "Upload"
+
+	// OperationImportCache is the type of token for reading and populating the import cache
+	OperationImportCache Operation = "ImportCache"
 )
 
 // Operation is the type of the token
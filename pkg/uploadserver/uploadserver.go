@@ -31,7 +31,6 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 	"sync"
 
 	"github.com/golang/snappy"
@@ -439,33 +438,35 @@ func newAsyncUploadStreamProcessor(stream io.ReadCloser, dest, imageSize string,
 	}
 
 	uds := importer.NewAsyncUploadDataSource(newContentReader(stream, sourceContentType))
-	processor := importer.NewDataProcessor(uds, dest, common.ImporterVolumePath, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation)
+	processor := importer.NewDataProcessor(uds, dest, common.ImporterVolumePath, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation, false)
 	return processor, processor.ProcessDataWithPause()
 }
 
 func newUploadStreamProcessor(stream io.ReadCloser, dest, imageSize string, filesystemOverhead float64, preallocation bool, sourceContentType string, dvContentType cdiv1.DataVolumeContentType) (bool, error) {
 	if sourceContentType == common.FilesystemCloneContentType {
-		return false, filesystemCloneProcessor(stream, dest)
+		if dest != common.WriteBlockPath {
+			return false, filesystemCloneProcessor(stream, dest)
+		}
+
+		// Clone file system to block device, the disk image may need conversion
+		diskImage, err := newTarDiskImageReader(newSnappyReadCloser(stream))
+		if err != nil {
+			return false, errors.Wrapf(err, "error reading %s from clone source", common.DiskImageName)
+		}
+		stream = diskImage
+	} else {
+		stream = newContentReader(stream, sourceContentType)
 	}
 
 	// Clone block device to block device or file system
-	uds := importer.NewUploadDataSource(newContentReader(stream, sourceContentType), dvContentType)
-	processor := importer.NewDataProcessor(uds, dest, common.ImporterVolumePath, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation)
+	uds := importer.NewUploadDataSource(stream, dvContentType)
+	processor := importer.NewDataProcessor(uds, dest, common.ImporterVolumePath, common.ScratchDataDir, imageSize, filesystemOverhead, preallocation, false)
 	err := processor.ProcessData()
 	return processor.PreallocationApplied(), err
 }
 
-// Clone file system to block device or file system
+// Clone file system to file system
 func filesystemCloneProcessor(stream io.ReadCloser, dest string) error {
-	// Clone to block device
-	if dest == common.WriteBlockPath {
-		if err := untarToBlockdev(newSnappyReadCloser(stream), dest); err != nil {
-			return errors.Wrapf(err, "error unarchiving to %s", dest)
-		}
-		return nil
-	}
-
-	// Clone to file system
 	destDir := common.ImporterVolumePath
 	if err := util.UnArchiveTar(newSnappyReadCloser(stream), destDir); err != nil {
 		return errors.Wrapf(err, "error unarchiving to %s", destDir)
@@ -473,32 +474,36 @@ func filesystemCloneProcessor(stream io.ReadCloser, dest string) error {
 	return nil
 }
 
-func untarToBlockdev(stream io.Reader, dest string) error {
+type tarEntryReadCloser struct {
+	io.Reader
+	stream io.ReadCloser
+}
+
+func (r *tarEntryReadCloser) Close() error {
+	return r.stream.Close()
+}
+
+// newTarDiskImageReader returns a reader for the disk image in a file system clone stream
+func newTarDiskImageReader(stream io.ReadCloser) (io.ReadCloser, error) {
 	tr := tar.NewReader(stream)
 	for {
 		header, err := tr.Next()
 		switch {
 		case err == io.EOF:
-			return nil
+			return nil, errors.Errorf("%s not found", common.DiskImageName)
 		case err != nil:
-			return err
+			return nil, err
 		case header == nil:
 			continue
 		}
-		if header.Typeflag == tar.TypeGNUSparse && strings.Contains(header.Name, common.DiskImageName) {
-			klog.Infof("Untaring %d bytes to %s", header.Size, dest)
-			f, err := os.OpenFile(dest, os.O_APPEND|os.O_WRONLY, os.ModeDevice|os.ModePerm)
-			if err != nil {
-				return err
-			}
-			written, err := io.Copy(f, tr)
-			if err != nil {
-				return err
-			}
-			klog.Infof("Written %d", written)
-			f.Close()
-			return nil
+		if filepath.Clean(header.Name) != common.DiskImageName {
+			continue
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeGNUSparse {
+			return nil, errors.Errorf("%s is not a regular file", common.DiskImageName)
 		}
+		klog.Infof("Cloning %d bytes of %s", header.Size, header.Name)
+		return &tarEntryReadCloser{Reader: tr, stream: stream}, nil
 	}
 }
 
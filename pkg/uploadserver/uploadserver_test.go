@@ -20,6 +20,7 @@
 package uploadserver
 
 import (
+	"archive/tar"
 	"bytes"
 	"crypto/tls"
 	"crypto/x509"
@@ -153,11 +154,11 @@ func (amd *AsyncMockDataSource) GetResumePhase() importer.ProcessingPhase {
 }
 
 func saveAsyncProcessorSuccess(stream io.ReadCloser, dest, imageSize string, filesystemOverhead float64, preallocation bool, contentType string) (*importer.DataProcessor, error) {
-	return importer.NewDataProcessor(&AsyncMockDataSource{}, "", "", "", "", 0.055, false), nil
+	return importer.NewDataProcessor(&AsyncMockDataSource{}, "", "", "", "", 0.055, false, false), nil
 }
 
 func saveAsyncProcessorFailure(stream io.ReadCloser, dest, imageSize string, filesystemOverhead float64, preallocation bool, contentType string) (*importer.DataProcessor, error) {
-	return importer.NewDataProcessor(&AsyncMockDataSource{}, "", "", "", "", 0.055, false), fmt.Errorf("Error using datastream")
+	return importer.NewDataProcessor(&AsyncMockDataSource{}, "", "", "", "", 0.055, false, false), fmt.Errorf("Error using datastream")
 }
 
 func withAsyncProcessorSuccess(f func()) {
@@ -377,6 +378,43 @@ var _ = Describe("Upload server tests", func() {
 	)
 })
 
+var _ = Describe("Filesystem clone disk image reader", func() {
+	createTar := func(files map[string]string) io.ReadCloser {
+		var b bytes.Buffer
+		tw := tar.NewWriter(&b)
+		for _, name := range []string{"lost+found/", "./disk.img", "other"} {
+			data, ok := files[name]
+			if !ok {
+				continue
+			}
+			hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}
+			if strings.HasSuffix(name, "/") {
+				hdr.Typeflag = tar.TypeDir
+			}
+			Expect(tw.WriteHeader(hdr)).To(Succeed())
+			_, err := tw.Write([]byte(data))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		return io.NopCloser(&b)
+	}
+
+	It("Should return the disk image", func() {
+		rc, err := newTarDiskImageReader(createTar(map[string]string{"lost+found/": "", "other": "other", "./disk.img": "disk data"}))
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(rc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("disk data"))
+		Expect(rc.Close()).To(Succeed())
+	})
+
+	It("Should fail if there is no disk image", func() {
+		_, err := newTarDiskImageReader(createTar(map[string]string{"other": "other"}))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("disk.img not found"))
+	})
+})
+
 func newFormRequest(path string) *http.Request {
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
@@ -0,0 +1,322 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+const (
+	// ErrorCodeConnectionFailed is a connection to the source which failed or broke
+	ErrorCodeConnectionFailed = "ConnectionFailed"
+	// ErrorCodeDNSFailed is a source host name which can't be resolved
+	ErrorCodeDNSFailed = "DNSFailed"
+	// ErrorCodeTimeout is a source which didn't respond in time
+	ErrorCodeTimeout = "Timeout"
+	// ErrorCodeTLSFailed is a TLS handshake or certificate verification failure
+	ErrorCodeTLSFailed = "TLSFailed"
+	// ErrorCodeHTTPUnauthorized is an HTTP 401 response
+	ErrorCodeHTTPUnauthorized = "HTTPUnauthorized"
+	// ErrorCodeHTTPForbidden is an HTTP 403 response
+	ErrorCodeHTTPForbidden = "HTTPForbidden"
+	// ErrorCodeHTTPNotFound is an HTTP 404 or 410 response
+	ErrorCodeHTTPNotFound = "HTTPNotFound"
+	// ErrorCodeHTTPUnavailable is an HTTP response asking to retry later, like a 503
+	ErrorCodeHTTPUnavailable = "HTTPUnavailable"
+	// ErrorCodeHTTPStatus is any other unexpected HTTP response
+	ErrorCodeHTTPStatus = "HTTPStatus"
+	// ErrorCodeAuthFailed is an authentication or authorization failure
+	ErrorCodeAuthFailed = "AuthFailed"
+	// ErrorCodeNotFound is a source which doesn't exist
+	ErrorCodeNotFound = "NotFound"
+	// ErrorCodeInvalidImage is an image which can't be processed
+	ErrorCodeInvalidImage = "InvalidImage"
+	// ErrorCodeOutOfSpace is a target volume too small for the data
+	ErrorCodeOutOfSpace = "OutOfSpace"
+	// ErrorCodeSourceChanged is a source which changed since a DataImportCron polled it
+	ErrorCodeSourceChanged = "SourceChanged"
+	// ErrorCodeUnknown is any other error
+	ErrorCodeUnknown = "Unknown"
+
+	// maxTerminationErrorLength keeps the JSON payload within the 4096 bytes termination message limit,
+	// leaving room for the information some data sources append after it
+	maxTerminationErrorLength = 2048
+)
+
+// ErrSourceChanged is returned when the source no longer matches the version a DataImportCron polled
+var ErrSourceChanged = errors.New("source changed since it was polled")
+
+// TerminationError is the structured termination message written by a worker pod which failed
+type TerminationError struct {
+	// Code identifies the error, like HTTPNotFound or TLSFailed
+	Code string `json:"code"`
+	// Category is the class of the error
+	Category cdiv1.ImportErrorClass `json:"category"`
+	// Retryable tells whether retrying the same request may succeed
+	Retryable bool `json:"retryable"`
+	// Message describes the error
+	Message string `json:"message"`
+	// Details holds additional error information, like the HTTP status code
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// HTTPStatusError is an unexpected HTTP response status
+type HTTPStatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("expected status code 200, got %d. Status: %s", e.StatusCode, e.Status)
+}
+
+// errorMessagePatterns classifies error messages, the first matching class wins
+var errorMessagePatterns = []struct {
+	class    cdiv1.ImportErrorClass
+	patterns []string
+}{
+	{cdiv1.ImportErrorOutOfSpace, []string{"no space left on device", "is larger than the reported available", "a larger pvc is required",
+		"file largest block is bigger than maxblock", "disk quota exceeded"}},
+	{cdiv1.ImportErrorAuth, []string{"unauthorized", "forbidden", "authentication required", "access denied", "invalid credentials",
+		"unable to log in"}},
+	{cdiv1.ImportErrorInvalidImage, []string{"invalid image", "invalid format", "is invalid", "could not parse image", "unknown content type",
+		"checksum", "could not open", "image file does not exist", "failed to find vm disk image"}},
+	{cdiv1.ImportErrorNotFound, []string{"not found", "manifest unknown", "name unknown", "nosuchkey", "nosuchbucket", "does not exist"}},
+	{cdiv1.ImportErrorNetwork, []string{"dial tcp", "connection refused", "connection reset", "no such host", "i/o timeout", "tls handshake",
+		"network is unreachable", "unexpected eof", "broken pipe", "bad gateway", "service unavailable", "gateway timeout", "timeout"}},
+}
+
+// ClassifyErrorMessage returns the error class of a free-text error message
+func ClassifyErrorMessage(message string) cdiv1.ImportErrorClass {
+	message = strings.ToLower(message)
+	for _, p := range errorMessagePatterns {
+		for _, pattern := range p.patterns {
+			if strings.Contains(message, pattern) {
+				return p.class
+			}
+		}
+	}
+	return cdiv1.ImportErrorUnknown
+}
+
+// NewTerminationError classifies the error, using the message to describe it
+func NewTerminationError(err error, message string) *TerminationError {
+	te := classifyError(err)
+	te.Message = message
+	return te
+}
+
+func classifyError(err error) *TerminationError {
+	var statusErr *HTTPStatusError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certificateErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	var dnsErr *net.DNSError
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, ErrSourceChanged):
+		return &TerminationError{Code: ErrorCodeSourceChanged, Category: cdiv1.ImportErrorNotFound}
+	case errors.As(err, &statusErr):
+		return classifyHTTPStatus(statusErr.StatusCode)
+	case errors.As(err, &unknownAuthorityErr), errors.As(err, &hostnameErr), errors.As(err, &certificateErr), errors.As(err, &recordHeaderErr):
+		return &TerminationError{Code: ErrorCodeTLSFailed, Category: cdiv1.ImportErrorNetwork}
+	case errors.As(err, &dnsErr):
+		return &TerminationError{Code: ErrorCodeDNSFailed, Category: cdiv1.ImportErrorNetwork, Retryable: !dnsErr.IsNotFound}
+	case errors.Is(err, syscall.ENOSPC), errors.Is(err, syscall.EDQUOT):
+		return &TerminationError{Code: ErrorCodeOutOfSpace, Category: cdiv1.ImportErrorOutOfSpace}
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return &TerminationError{Code: ErrorCodeTimeout, Category: cdiv1.ImportErrorNetwork, Retryable: true}
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.EPIPE),
+		errors.Is(err, io.ErrUnexpectedEOF), errors.As(err, &netErr):
+		return &TerminationError{Code: ErrorCodeConnectionFailed, Category: cdiv1.ImportErrorNetwork, Retryable: true}
+	}
+
+	// Fall back to the message of errors which were flattened to text
+	switch class := ClassifyErrorMessage(err.Error()); class {
+	case cdiv1.ImportErrorNetwork:
+		return &TerminationError{Code: ErrorCodeConnectionFailed, Category: class, Retryable: true}
+	case cdiv1.ImportErrorAuth:
+		return &TerminationError{Code: ErrorCodeAuthFailed, Category: class}
+	case cdiv1.ImportErrorNotFound:
+		return &TerminationError{Code: ErrorCodeNotFound, Category: class}
+	case cdiv1.ImportErrorInvalidImage:
+		return &TerminationError{Code: ErrorCodeInvalidImage, Category: class}
+	case cdiv1.ImportErrorOutOfSpace:
+		return &TerminationError{Code: ErrorCodeOutOfSpace, Category: class}
+	}
+	// Unknown errors may be transient, like before structured errors
+	return &TerminationError{Code: ErrorCodeUnknown, Category: cdiv1.ImportErrorUnknown, Retryable: true}
+}
+
+func classifyHTTPStatus(statusCode int) *TerminationError {
+	te := &TerminationError{Details: map[string]string{"statusCode": strconv.Itoa(statusCode)}}
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		te.Code, te.Category = ErrorCodeHTTPUnauthorized, cdiv1.ImportErrorAuth
+	case statusCode == http.StatusForbidden:
+		te.Code, te.Category = ErrorCodeHTTPForbidden, cdiv1.ImportErrorAuth
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		te.Code, te.Category = ErrorCodeHTTPNotFound, cdiv1.ImportErrorNotFound
+	case statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError:
+		te.Code, te.Category, te.Retryable = ErrorCodeHTTPUnavailable, cdiv1.ImportErrorNetwork, true
+	default:
+		te.Code, te.Category = ErrorCodeHTTPStatus, cdiv1.ImportErrorUnknown
+	}
+	return te
+}
+
+// WriteTerminationError writes the error as a structured termination message, so the controller can tell errors apart
+func WriteTerminationError(err error, message string) error {
+	payload, jsonErr := MarshalTerminationError(NewTerminationError(err, message))
+	if jsonErr != nil {
+		return jsonErr
+	}
+	return WriteTerminationMessage(payload)
+}
+
+// MarshalTerminationError returns the JSON payload of the termination error, truncating its message
+// so the escaped payload fits in the termination message
+func MarshalTerminationError(te *TerminationError) (string, error) {
+	payload, err := json.Marshal(te)
+	if err != nil || len(payload) <= maxTerminationErrorLength {
+		return string(payload), err
+	}
+	// Search the longest message prefix which fits once escaped
+	message := te.Message
+	truncated := *te
+	fits := sort.Search(len(message)+1, func(i int) bool {
+		truncated.Message = strings.ToValidUTF8(message[:len(message)-i], "")
+		payload, err = json.Marshal(&truncated)
+		return err != nil || len(payload) <= maxTerminationErrorLength
+	})
+	truncated.Message = strings.ToValidUTF8(message[:len(message)-fits], "")
+	payload, err = json.Marshal(&truncated)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// IsErrorCode tells whether the reason is the code of a termination error
+func IsErrorCode(reason string) bool {
+	switch reason {
+	case ErrorCodeConnectionFailed, ErrorCodeDNSFailed, ErrorCodeTimeout, ErrorCodeTLSFailed, ErrorCodeHTTPUnauthorized,
+		ErrorCodeHTTPForbidden, ErrorCodeHTTPNotFound, ErrorCodeHTTPUnavailable, ErrorCodeHTTPStatus, ErrorCodeAuthFailed,
+		ErrorCodeNotFound, ErrorCodeInvalidImage, ErrorCodeOutOfSpace, ErrorCodeSourceChanged, ErrorCodeUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseTerminationError parses a structured termination message, ignoring any text appended after it
+func ParseTerminationError(message string) (*TerminationError, bool) {
+	if !strings.HasPrefix(message, "{") {
+		return nil, false
+	}
+	te := &TerminationError{}
+	if err := json.NewDecoder(strings.NewReader(message)).Decode(te); err != nil || te.Code == "" {
+		return nil, false
+	}
+	return te, true
+}
+
+// PreflightInfo is the termination message written by an importer pod which inspected its source in a dry run
+type PreflightInfo struct {
+	// Format is the image format, like qcow2 or raw
+	Format string `json:"format,omitempty"`
+	// VirtualSize is the size of the disk in the image, 0 if it can't be known without reading the whole image
+	VirtualSize int64 `json:"virtualSize,omitempty"`
+}
+
+// WritePreflightInfo writes the result of a dry run as the termination message
+func WritePreflightInfo(info *PreflightInfo) error {
+	payload, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return WriteTerminationMessage(string(payload))
+}
+
+// ParsePreflightInfo parses the termination message of a dry run which succeeded
+func ParsePreflightInfo(message string) (*PreflightInfo, bool) {
+	if !strings.HasPrefix(message, "{") {
+		return nil, false
+	}
+	info := &PreflightInfo{}
+	if err := json.NewDecoder(strings.NewReader(message)).Decode(info); err != nil {
+		return nil, false
+	}
+	return info, true
+}
+
+// CloneSourceResult is the termination message written by a fan-out clone source pod when some of its targets failed
+type CloneSourceResult struct {
+	// Message describes the result, like Clone Complete
+	Message string `json:"message"`
+	// FailedCount is the number of targets which failed
+	FailedCount int `json:"failedCount"`
+	// FailedTargets are the owner UIDs of the targets which failed
+	FailedTargets []string `json:"failedTargets,omitempty"`
+	// Truncated tells that not all failed targets fit in FailedTargets, so the unlisted targets may have failed too
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// WriteCloneSourceResult writes the result of a fan-out clone as the termination message
+func WriteCloneSourceResult(result *CloneSourceResult) error {
+	payload, err := MarshalCloneSourceResult(result)
+	if err != nil {
+		return err
+	}
+	return WriteTerminationMessage(payload)
+}
+
+// MarshalCloneSourceResult returns the JSON payload of the result, listing only as many failed targets
+// as fit in the termination message
+func MarshalCloneSourceResult(result *CloneSourceResult) (string, error) {
+	bounded := *result
+	bounded.FailedCount = len(result.FailedTargets)
+	payload, err := json.Marshal(&bounded)
+	if err != nil || len(payload) <= maxTerminationErrorLength {
+		return string(payload), err
+	}
+	// Search the longest list of failed targets which fits
+	failed := result.FailedTargets
+	bounded.Truncated = true
+	drop := sort.Search(len(failed)+1, func(i int) bool {
+		bounded.FailedTargets = failed[:len(failed)-i]
+		payload, err = json.Marshal(&bounded)
+		return err != nil || len(payload) <= maxTerminationErrorLength
+	})
+	bounded.FailedTargets = failed[:len(failed)-drop]
+	payload, err = json.Marshal(&bounded)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// ParseCloneSourceResult parses the termination message of a fan-out clone source pod
+func ParseCloneSourceResult(message string) (*CloneSourceResult, bool) {
+	if !strings.HasPrefix(message, "{") {
+		return nil, false
+	}
+	result := &CloneSourceResult{}
+	if err := json.NewDecoder(strings.NewReader(message)).Decode(result); err != nil || result.Message == "" || result.FailedCount == 0 {
+		return nil, false
+	}
+	return result, true
+}
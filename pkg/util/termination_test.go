@@ -0,0 +1,161 @@
+package util
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "deadline exceeded" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ = Describe("Termination errors", func() {
+	DescribeTable("Should classify", func(err error, code string, category cdiv1.ImportErrorClass, retryable bool) {
+		te := NewTerminationError(err, "message")
+		Expect(te.Code).To(Equal(code))
+		Expect(te.Category).To(Equal(category))
+		Expect(te.Retryable).To(Equal(retryable))
+		Expect(te.Message).To(Equal("message"))
+	},
+		Entry("HTTP 401", errors.Wrap(&HTTPStatusError{StatusCode: 401}, "wrapped"), ErrorCodeHTTPUnauthorized, cdiv1.ImportErrorAuth, false),
+		Entry("HTTP 403", &HTTPStatusError{StatusCode: 403}, ErrorCodeHTTPForbidden, cdiv1.ImportErrorAuth, false),
+		Entry("HTTP 404", errors.WithStack(&HTTPStatusError{StatusCode: 404}), ErrorCodeHTTPNotFound, cdiv1.ImportErrorNotFound, false),
+		Entry("HTTP 503", &HTTPStatusError{StatusCode: 503}, ErrorCodeHTTPUnavailable, cdiv1.ImportErrorNetwork, true),
+		Entry("HTTP 400", &HTTPStatusError{StatusCode: 400}, ErrorCodeHTTPStatus, cdiv1.ImportErrorUnknown, false),
+		Entry("unknown certificate authority", errors.Wrap(x509.UnknownAuthorityError{}, "wrapped"), ErrorCodeTLSFailed, cdiv1.ImportErrorNetwork, false),
+		Entry("unknown host", &net.DNSError{Err: "no such host", IsNotFound: true}, ErrorCodeDNSFailed, cdiv1.ImportErrorNetwork, false),
+		Entry("DNS server failure", &net.DNSError{Err: "server misbehaving"}, ErrorCodeDNSFailed, cdiv1.ImportErrorNetwork, true),
+		Entry("timeout", errors.Wrap(timeoutError{}, "wrapped"), ErrorCodeTimeout, cdiv1.ImportErrorNetwork, true),
+		Entry("connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), ErrorCodeConnectionFailed, cdiv1.ImportErrorNetwork, true),
+		Entry("unexpected EOF", errors.Wrap(io.ErrUnexpectedEOF, "wrapped"), ErrorCodeConnectionFailed, cdiv1.ImportErrorNetwork, true),
+		Entry("no space left", &os.PathError{Op: "write", Path: "disk.img", Err: syscall.ENOSPC}, ErrorCodeOutOfSpace, cdiv1.ImportErrorOutOfSpace, false),
+		Entry("flattened invalid image", errors.New("Invalid format qcow3 for image"), ErrorCodeInvalidImage, cdiv1.ImportErrorInvalidImage, false),
+		Entry("flattened network error", errors.New("read: connection reset by peer"), ErrorCodeConnectionFailed, cdiv1.ImportErrorNetwork, true),
+		Entry("source changed", errors.Wrap(ErrSourceChanged, "wrapped"), ErrorCodeSourceChanged, cdiv1.ImportErrorNotFound, false),
+		Entry("unknown error", errors.New("something went wrong"), ErrorCodeUnknown, cdiv1.ImportErrorUnknown, true),
+	)
+
+	It("Should keep the HTTP status code in the details", func() {
+		te := NewTerminationError(&HTTPStatusError{StatusCode: 404, Status: "404 Not Found"}, "message")
+		Expect(te.Details).To(HaveKeyWithValue("statusCode", "404"))
+	})
+
+	DescribeTable("Should truncate long messages after escaping them", func(message string) {
+		payload, err := MarshalTerminationError(NewTerminationError(errors.New("error"), message))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(payload)).To(BeNumerically("<=", maxTerminationErrorLength))
+		te, ok := ParseTerminationError(payload)
+		Expect(ok).To(BeTrue())
+		Expect(message).To(HavePrefix(te.Message))
+		Expect(len(te.Message)).To(BeNumerically(">", maxTerminationErrorLength/8))
+	},
+		Entry("plain text", strings.Repeat("x", 2*maxTerminationErrorLength)),
+		Entry("escaped characters", strings.Repeat(`<"\>`, maxTerminationErrorLength)),
+		Entry("multi-byte characters", strings.Repeat("ü", maxTerminationErrorLength)),
+	)
+
+	It("Should not truncate short messages", func() {
+		payload, err := MarshalTerminationError(NewTerminationError(errors.New("error"), "short message"))
+		Expect(err).ToNot(HaveOccurred())
+		te, ok := ParseTerminationError(payload)
+		Expect(ok).To(BeTrue())
+		Expect(te.Message).To(Equal("short message"))
+	})
+
+	It("Should parse a termination error followed by other information", func() {
+		payload, err := json.Marshal(NewTerminationError(&HTTPStatusError{StatusCode: 404}, "Unable to connect to http data source: 404"))
+		Expect(err).ToNot(HaveOccurred())
+		te, ok := ParseTerminationError(string(payload) + `; VDDK: {"Version":"1.2.3","Host":"esx"}`)
+		Expect(ok).To(BeTrue())
+		Expect(te.Code).To(Equal(ErrorCodeHTTPNotFound))
+		Expect(te.Category).To(Equal(cdiv1.ImportErrorNotFound))
+		Expect(te.Message).To(Equal("Unable to connect to http data source: 404"))
+	})
+
+	DescribeTable("Should not parse an unstructured termination message", func(message string) {
+		_, ok := ParseTerminationError(message)
+		Expect(ok).To(BeFalse())
+	},
+		Entry("free text", "Unable to process data: exit status 1"),
+		Entry("empty", ""),
+		Entry("JSON without code", `{"message":"error"}`),
+		Entry("invalid JSON", `{"code":`),
+	)
+
+	It("Should write the termination error as one line", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "termination-log")
+		Expect(os.WriteFile(file, nil, 0600)).To(Succeed())
+		payload, err := json.Marshal(NewTerminationError(errors.New("error"), "first line\nsecond line"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(WriteTerminationMessageToFile(file, string(payload))).To(Succeed())
+		data, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		te, ok := ParseTerminationError(string(data))
+		Expect(ok).To(BeTrue())
+		Expect(te.Message).To(Equal("first line\nsecond line"))
+	})
+
+	It("Should parse the result of a dry run", func() {
+		payload, err := json.Marshal(&PreflightInfo{Format: "qcow2", VirtualSize: 1 << 30})
+		Expect(err).ToNot(HaveOccurred())
+		info, ok := ParsePreflightInfo(string(payload))
+		Expect(ok).To(BeTrue())
+		Expect(info.Format).To(Equal("qcow2"))
+		Expect(info.VirtualSize).To(Equal(int64(1 << 30)))
+		_, ok = ParsePreflightInfo("Import Complete")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should parse the result of a fan-out clone", func() {
+		payload, err := MarshalCloneSourceResult(&CloneSourceResult{Message: "Clone Complete", FailedTargets: []string{"uid-target2"}})
+		Expect(err).ToNot(HaveOccurred())
+		result, ok := ParseCloneSourceResult(payload)
+		Expect(ok).To(BeTrue())
+		Expect(result.Message).To(Equal("Clone Complete"))
+		Expect(result.FailedCount).To(Equal(1))
+		Expect(result.FailedTargets).To(ConsistOf("uid-target2"))
+		Expect(result.Truncated).To(BeFalse())
+		_, ok = ParseCloneSourceResult("Clone Complete")
+		Expect(ok).To(BeFalse())
+		_, ok = ParseTerminationError(payload)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should list only the failed targets of a fan-out clone which fit in the termination message", func() {
+		var failed []string
+		for i := 0; i < 200; i++ {
+			failed = append(failed, fmt.Sprintf("%08d-0000-0000-0000-000000000000", i))
+		}
+		payload, err := MarshalCloneSourceResult(&CloneSourceResult{Message: "Clone Complete", FailedTargets: failed})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(payload)).To(BeNumerically("<=", maxTerminationErrorLength))
+		result, ok := ParseCloneSourceResult(payload)
+		Expect(ok).To(BeTrue())
+		Expect(result.FailedCount).To(Equal(200))
+		Expect(result.Truncated).To(BeTrue())
+		Expect(result.FailedTargets).ToNot(BeEmpty())
+		Expect(failed).To(ContainElements(result.FailedTargets))
+	})
+
+	It("Should not parse a cut off fan-out clone result", func() {
+		payload, err := MarshalCloneSourceResult(&CloneSourceResult{Message: "Clone Complete", FailedTargets: []string{"uid-target1", "uid-target2"}})
+		Expect(err).ToNot(HaveOccurred())
+		_, ok := ParseCloneSourceResult(payload[:len(payload)-10])
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -83,6 +83,16 @@ func (dv *DataVolume) AuthorizeUser(requestNamespace, requestName string, proxy
 		return CloneAuthResponse{Allowed: false, Reason: "", Handler: cloneSourceHandler}, err
 	}
 
+	granted, err := cloneSourceHandler.isGrantedByDataSource(proxy, targetNamespace)
+	if err != nil {
+		return CloneAuthResponse{Allowed: false, Reason: "", Handler: cloneSourceHandler}, err
+	}
+	if granted {
+		klog.V(3).Infof("DataVolume %s/%s namespace is granted by the DataSource", targetNamespace, targetName)
+		return CloneAuthResponse{Allowed: true, Reason: "", Handler: cloneSourceHandler}, nil
+	}
+
+	sourceNamespace = cloneSourceHandler.getAuthNamespace(sourceNamespace)
 	ok, reason, err := cloneSourceHandler.UserCloneAuthFunc(proxy.CreateSar, sourceNamespace, sourceName, targetNamespace, userInfo)
 	if err != nil {
 		return CloneAuthResponse{Allowed: false, Reason: reason, Handler: cloneSourceHandler}, err
@@ -146,6 +156,16 @@ func (dv *DataVolume) AuthorizeSA(requestNamespace, requestName string, proxy Au
 		return CloneAuthResponse{Allowed: false, Reason: "", Handler: cloneSourceHandler}, err
 	}
 
+	granted, err := cloneSourceHandler.isGrantedByDataSource(proxy, targetNamespace)
+	if err != nil {
+		return CloneAuthResponse{Allowed: false, Reason: "", Handler: cloneSourceHandler}, err
+	}
+	if granted {
+		klog.V(3).Infof("DataVolume %s/%s namespace is granted by the DataSource", targetNamespace, targetName)
+		return CloneAuthResponse{Allowed: true, Reason: "", Handler: cloneSourceHandler}, nil
+	}
+
+	sourceNamespace = cloneSourceHandler.getAuthNamespace(sourceNamespace)
 	ok, reason, err := cloneSourceHandler.SACloneAuthFunc(proxy.CreateSar, sourceNamespace, sourceName, saNamespace, saName)
 	if err != nil {
 		return CloneAuthResponse{Allowed: false, Reason: reason, Handler: cloneSourceHandler}, err
@@ -160,3 +180,22 @@ func (dv *DataVolume) AuthorizeSA(requestNamespace, requestName string, proxy Au
 
 	return CloneAuthResponse{Allowed: ok, Reason: reason, Handler: cloneSourceHandler}, err
 }
+
+// AuthorizeGrant indicates if the user setting the DataSource grant is authorized to share it.
+// As the grant lets the granted namespaces clone any source the DataSource refers to in its namespace,
+// including its earlier revisions and cache, the user must be allowed to clone any source in the namespace
+func (ds *DataSource) AuthorizeGrant(proxy AuthorizationHelperProxy, userInfo authentication.UserInfo) (bool, string, error) {
+	if ds.Spec.Grant == nil {
+		return true, "", nil
+	}
+	sarSpec := newUserSarSpec(userInfo)
+	ok, reason, err := sendSubjectAccessReviewsPvc(proxy.CreateSar, ds.Namespace, "", sarSpec)
+	if err != nil || !ok {
+		return false, reason, err
+	}
+	ok, reason, err = sendSubjectAccessReviewsSnapshot(proxy.CreateSar, ds.Namespace, "", sarSpec)
+	if err != nil || !ok {
+		return false, reason, err
+	}
+	return true, "", nil
+}
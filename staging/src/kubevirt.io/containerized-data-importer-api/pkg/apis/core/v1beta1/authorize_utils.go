@@ -23,12 +23,14 @@ import (
 	authorization "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 )
 
 func newCloneSourceHandler(dataVolume *DataVolume, dsGet dsGetFunc) (CloneSourceHandler, error) {
 	var pvcSource *DataVolumeSourcePVC
 	var snapshotSource *DataVolumeSourceSnapshot
+	var dataSource *DataSource
 
 	if dataVolume.Spec.Source != nil {
 		if dataVolume.Spec.Source.PVC != nil {
@@ -41,14 +43,16 @@ func newCloneSourceHandler(dataVolume *DataVolume, dsGet dsGetFunc) (CloneSource
 		if dataVolume.Spec.SourceRef.Namespace != nil && *dataVolume.Spec.SourceRef.Namespace != "" {
 			ns = *dataVolume.Spec.SourceRef.Namespace
 		}
-		dataSource, err := dsGet(ns, dataVolume.Spec.SourceRef.Name)
+		var err error
+		dataSource, err = dsGet(ns, dataVolume.Spec.SourceRef.Name)
 		if err != nil {
 			return CloneSourceHandler{}, err
 		}
-		if dataSource.Spec.Source.PVC != nil {
-			pvcSource = dataSource.Spec.Source.PVC
-		} else if dataSource.Spec.Source.Snapshot != nil {
-			snapshotSource = dataSource.Spec.Source.Snapshot
+		source := dataSource.GetCloneSource()
+		if source.PVC != nil {
+			pvcSource = source.PVC
+		} else if source.Snapshot != nil {
+			snapshotSource = source.Snapshot
 		}
 	}
 
@@ -61,6 +65,7 @@ func newCloneSourceHandler(dataVolume *DataVolume, dsGet dsGetFunc) (CloneSource
 			SACloneAuthFunc:   CanServiceAccountClonePVC,
 			SourceName:        pvcSource.Name,
 			SourceNamespace:   pvcSource.Namespace,
+			dataSource:        dataSource,
 		}, nil
 	case snapshotSource != nil:
 		return CloneSourceHandler{
@@ -70,6 +75,7 @@ func newCloneSourceHandler(dataVolume *DataVolume, dsGet dsGetFunc) (CloneSource
 			SACloneAuthFunc:   CanServiceAccountCloneSnapshot,
 			SourceName:        snapshotSource.Name,
 			SourceNamespace:   snapshotSource.Namespace,
+			dataSource:        dataSource,
 		}, nil
 	default:
 		return CloneSourceHandler{
@@ -111,6 +117,57 @@ type CloneSourceHandler struct {
 	SACloneAuthFunc   ServiceAccountCloneAuthFunc
 	SourceName        string
 	SourceNamespace   string
+
+	// dataSource is the DataSource referenced by the DataVolume, if any
+	dataSource *DataSource
+}
+
+// getAuthNamespace returns the namespace where the clone permissions are checked. The cache of a DataSource remote source
+// is shared in the CDI namespace, so cloning it through the DataSource needs the permissions on the DataSource namespace.
+// The DataVolume controller only clones a PVC named like a cache if it is the cache imported in the CDI namespace.
+func (h *CloneSourceHandler) getAuthNamespace(sourceNamespace string) string {
+	ds := h.dataSource
+	if ds != nil && h.CloneType == pvcClone && (&DataVolumeSourcePVC{Name: h.SourceName}).IsDataSourceCache() {
+		return ds.Namespace
+	}
+	return sourceNamespace
+}
+
+// isGrantedByDataSource tells whether the DataSource referenced from another namespace grants it access to the source.
+// A namespace which is not granted may still clone the source with clone permissions on it.
+func (h *CloneSourceHandler) isGrantedByDataSource(proxy AuthorizationHelperProxy, targetNamespace string) (bool, error) {
+	ds := h.dataSource
+	// Only the source in the DataSource namespace is granted, so a DataSource cannot grant access to other namespaces sources
+	if ds == nil || ds.Spec.Grant == nil || ds.Namespace == targetNamespace || h.getAuthNamespace(h.SourceNamespace) != ds.Namespace {
+		return false, nil
+	}
+	namespace, err := proxy.GetNamespace(targetNamespace)
+	if err != nil {
+		return false, err
+	}
+	// The DataSource admission authorized the user setting the grant to clone from its namespace
+	return ds.IsNamespaceGranted(namespace)
+}
+
+// IsNamespaceGranted tells whether the DataSource is shared with the namespace
+func (ds *DataSource) IsNamespaceGranted(namespace *corev1.Namespace) (bool, error) {
+	grant := ds.Spec.Grant
+	if grant == nil {
+		return false, nil
+	}
+	for _, name := range grant.Namespaces {
+		if name == namespace.Name {
+			return true, nil
+		}
+	}
+	if grant.NamespaceSelector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(grant.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(namespace.Labels)), nil
 }
 
 // CloneAuthResponse contains various response details
@@ -146,21 +203,7 @@ func CanUserClonePVC(createSar createSarFunc, sourceNamespace, pvcName, targetNa
 		return true, "", nil
 	}
 
-	var newExtra map[string]authorization.ExtraValue
-	if len(userInfo.Extra) > 0 {
-		newExtra = make(map[string]authorization.ExtraValue)
-		for k, v := range userInfo.Extra {
-			newExtra[k] = authorization.ExtraValue(v)
-		}
-	}
-
-	sarSpec := authorization.SubjectAccessReviewSpec{
-		User:   userInfo.Username,
-		Groups: userInfo.Groups,
-		Extra:  newExtra,
-	}
-
-	return sendSubjectAccessReviewsPvc(createSar, sourceNamespace, pvcName, sarSpec)
+	return sendSubjectAccessReviewsPvc(createSar, sourceNamespace, pvcName, newUserSarSpec(userInfo))
 }
 
 // CanServiceAccountClonePVC checks if a ServiceAccount has "appropriate" permission to clone from the given PVC
@@ -190,21 +233,7 @@ func CanUserCloneSnapshot(createSar createSarFunc, sourceNamespace, pvcName, tar
 		return true, "", nil
 	}
 
-	var newExtra map[string]authorization.ExtraValue
-	if len(userInfo.Extra) > 0 {
-		newExtra = make(map[string]authorization.ExtraValue)
-		for k, v := range userInfo.Extra {
-			newExtra[k] = authorization.ExtraValue(v)
-		}
-	}
-
-	sarSpec := authorization.SubjectAccessReviewSpec{
-		User:   userInfo.Username,
-		Groups: userInfo.Groups,
-		Extra:  newExtra,
-	}
-
-	return sendSubjectAccessReviewsSnapshot(createSar, sourceNamespace, pvcName, sarSpec)
+	return sendSubjectAccessReviewsSnapshot(createSar, sourceNamespace, pvcName, newUserSarSpec(userInfo))
 }
 
 // CanServiceAccountCloneSnapshot checks if a ServiceAccount has "appropriate" permission to clone from the given snapshot
@@ -227,6 +256,22 @@ func CanServiceAccountCloneSnapshot(createSar createSarFunc, pvcNamespace, pvcNa
 	return sendSubjectAccessReviewsSnapshot(createSar, pvcNamespace, pvcName, sarSpec)
 }
 
+func newUserSarSpec(userInfo authentication.UserInfo) authorization.SubjectAccessReviewSpec {
+	var newExtra map[string]authorization.ExtraValue
+	if len(userInfo.Extra) > 0 {
+		newExtra = make(map[string]authorization.ExtraValue)
+		for k, v := range userInfo.Extra {
+			newExtra[k] = authorization.ExtraValue(v)
+		}
+	}
+
+	return authorization.SubjectAccessReviewSpec{
+		User:   userInfo.Username,
+		Groups: userInfo.Groups,
+		Extra:  newExtra,
+	}
+}
+
 func sendSubjectAccessReviewsPvc(createSar createSarFunc, namespace, name string, sarSpec authorization.SubjectAccessReviewSpec) (bool, string, error) {
 	allowed := false
 
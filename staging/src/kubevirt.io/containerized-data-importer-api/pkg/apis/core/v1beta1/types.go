@@ -19,6 +19,7 @@ package v1beta1
 import (
 	ocpconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 )
@@ -66,8 +67,48 @@ type DataVolumeSpec struct {
 	FinalCheckpoint bool `json:"finalCheckpoint,omitempty"`
 	// Preallocation controls whether storage for DataVolumes should be allocated in advance.
 	Preallocation *bool `json:"preallocation,omitempty"`
+	// RetryPolicy controls how a failing import is retried, overriding the CDIConfig retry policy
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
+// RetryPolicy defines how a failing import is retried before its DataVolume fails
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of import attempts, including the first one. Unlimited by default.
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// BackoffBase is the delay before the first retry, doubled on each further retry. Defaults to 10s.
+	// +optional
+	BackoffBase *metav1.Duration `json:"backoffBase,omitempty"`
+	// BackoffCap is the maximum delay between retries. Defaults to 5m.
+	// +optional
+	BackoffCap *metav1.Duration `json:"backoffCap,omitempty"`
+	// RetryOn is the list of error classes which are retried, any other error fails the import on its first occurrence.
+	// By default, the errors which the importer reports as retryable are retried.
+	// +optional
+	// +listType=set
+	RetryOn []ImportErrorClass `json:"retryOn,omitempty"`
+}
+
+// ImportErrorClass is the class of an import error
+// +kubebuilder:validation:Enum=Network;Auth;NotFound;InvalidImage;OutOfSpace;Unknown
+type ImportErrorClass string
+
+const (
+	// ImportErrorNetwork is a connection or transfer error while reading the source
+	ImportErrorNetwork ImportErrorClass = "Network"
+	// ImportErrorAuth is an authentication or authorization error from the source
+	ImportErrorAuth ImportErrorClass = "Auth"
+	// ImportErrorNotFound is a source image which doesn't exist
+	ImportErrorNotFound ImportErrorClass = "NotFound"
+	// ImportErrorInvalidImage is a source image which can't be processed
+	ImportErrorInvalidImage ImportErrorClass = "InvalidImage"
+	// ImportErrorOutOfSpace is a target volume too small for the image
+	ImportErrorOutOfSpace ImportErrorClass = "OutOfSpace"
+	// ImportErrorUnknown is any other error
+	ImportErrorUnknown ImportErrorClass = "Unknown"
+)
+
 // StorageSpec defines the Storage type specification
 type StorageSpec struct {
 	// AccessModes contains the desired access modes the volume should have.
@@ -144,6 +185,19 @@ type DataVolumeSourcePVC struct {
 	Namespace string `json:"namespace"`
 	// The name of the source PVC
 	Name string `json:"name"`
+	// PathFilter selects the paths copied from the source PVC, only valid for filesystem clones with archive content
+	// +optional
+	PathFilter *DataVolumeSourcePathFilter `json:"pathFilter,omitempty"`
+}
+
+// DataVolumeSourcePathFilter provides the paths to include or exclude when cloning a filesystem PVC
+type DataVolumeSourcePathFilter struct {
+	// Include is the list of paths, relative to the root of the source PVC, to copy. Everything is copied if empty
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude is the list of paths or patterns, relative to the root of the source PVC, to skip
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
 }
 
 // DataVolumeSourceSnapshot provides the parameters to create a Data Volume from an existing VolumeSnapshot
@@ -232,6 +286,10 @@ type DataVolumeSourceHTTP struct {
 	// SecretExtraHeaders is a list of Secret references, each containing an extra HTTP header that may include sensitive information
 	// +optional
 	SecretExtraHeaders []string `json:"secretExtraHeaders,omitempty"`
+	// Checksum is the checksum of the image in the format <algorithm>:<hex digest>, e.g. sha256:1234...
+	// It identifies the image when the ImportDeduplication feature gate is enabled
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // DataVolumeSourceImageIO provides the parameters to create a Data Volume from an imageio source
@@ -423,6 +481,8 @@ type StorageProfileSpec struct {
 	DataImportCronSourceFormat *DataImportCronSourceFormat `json:"dataImportCronSourceFormat,omitempty"`
 	// SnapshotClass is optional specific VolumeSnapshotClass for CloneStrategySnapshot. If not set, a VolumeSnapshotClass is chosen according to the provisioner.
 	SnapshotClass *string `json:"snapshotClass,omitempty"`
+	// CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from source volumes of this storage class. Overrides the CDIConfig limit.
+	CloneBandwidthLimit *resource.Quantity `json:"cloneBandwidthLimit,omitempty"`
 }
 
 // StorageProfileStatus provides the most recently observed status of the StorageProfile
@@ -439,6 +499,8 @@ type StorageProfileStatus struct {
 	DataImportCronSourceFormat *DataImportCronSourceFormat `json:"dataImportCronSourceFormat,omitempty"`
 	// SnapshotClass is optional specific VolumeSnapshotClass for CloneStrategySnapshot. If not set, a VolumeSnapshotClass is chosen according to the provisioner.
 	SnapshotClass *string `json:"snapshotClass,omitempty"`
+	// CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from source volumes of this storage class. Overrides the CDIConfig limit.
+	CloneBandwidthLimit *resource.Quantity `json:"cloneBandwidthLimit,omitempty"`
 }
 
 // ClaimPropertySet is a set of properties applicable to PVC
@@ -481,6 +543,37 @@ type DataSource struct {
 type DataSourceSpec struct {
 	// Source is the source of the data referenced by the DataSource
 	Source DataSourceSource `json:"source"`
+	// PinnedRevision pins the DataSource source to an earlier revision listed in status.revisions, e.g. to roll back a bad image.
+	// A pinned DataSource is not updated by its DataImportCron.
+	// +optional
+	PinnedRevision *int64 `json:"pinnedRevision,omitempty"`
+	// Grant shares the DataSource with other namespaces. DataVolumes in the granted namespaces may clone the DataSource
+	// source without permissions on it, and DataVolumes in other namespaces may not reference the DataSource.
+	// +optional
+	Grant *DataSourceGrant `json:"grant,omitempty"`
+	// Cache is the storage of the cache PVC a remote source is imported to. Required for a remote source.
+	// +optional
+	Cache *DataSourceCache `json:"cache,omitempty"`
+}
+
+// DataSourceCache specifies the storage of the cache PVC a DataSource remote source is imported to
+type DataSourceCache struct {
+	// Size is the storage size of the cache PVC
+	Size resource.Quantity `json:"size"`
+	// StorageClassName is the storage class of the cache PVC. The default storage class is used if not set.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// DataSourceGrant specifies the namespaces a DataSource is shared with.
+// Permissions on the source are granted only for a source in the DataSource namespace.
+type DataSourceGrant struct {
+	// Namespaces are the names of the granted namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// NamespaceSelector selects the granted namespaces by their labels
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // DataSourceSource represents the source for our DataSource
@@ -489,15 +582,42 @@ type DataSourceSource struct {
 	PVC *DataVolumeSourcePVC `json:"pvc,omitempty"`
 	// +optional
 	Snapshot *DataVolumeSourceSnapshot `json:"snapshot,omitempty"`
+	// HTTP is a remote http source. The first DataVolume referencing the DataSource imports it to a cache PVC
+	// in the DataSource namespace, which is cloned by the DataVolumes referencing the DataSource.
+	// +optional
+	HTTP *DataVolumeSourceHTTP `json:"http,omitempty"`
+	// Registry is a remote registry source, imported to a cache PVC like the HTTP source
+	// +optional
+	Registry *DataVolumeSourceRegistry `json:"registry,omitempty"`
 }
 
 // DataSourceStatus provides the most recently observed status of the DataSource
 type DataSourceStatus struct {
 	// Source is the current source of the data referenced by the DataSource
-	Source     DataSourceSource      `json:"source,omitempty"`
+	Source DataSourceSource `json:"source,omitempty"`
+	// Revision is the revision number of the current source
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+	// Revisions is the bounded history of the DataSource sources, oldest first.
+	// Revisions whose source no longer exists are removed.
+	// +optional
+	Revisions  []DataSourceRevision  `json:"revisions,omitempty"`
 	Conditions []DataSourceCondition `json:"conditions,omitempty" optional:"true"`
 }
 
+// DataSourceRevision is a source the DataSource has pointed to
+type DataSourceRevision struct {
+	// Revision is the revision number, increasing with every new source
+	Revision int64 `json:"revision"`
+	// Source is the source of the revision
+	Source DataSourceSource `json:"source"`
+	// Digest is the digest of the DataImportCron import of the source, if any
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// Timestamp is the time the revision was created
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
 // DataSourceCondition represents the state of a data source condition
 type DataSourceCondition struct {
 	Type           DataSourceConditionType `json:"type" description:"type of condition ie. Ready"`
@@ -552,6 +672,14 @@ type DataImportCronSpec struct {
 	Template DataVolume `json:"template"`
 	// Schedule specifies in cron format when and how often to look for new imports
 	Schedule string `json:"schedule"`
+	// ScheduleJitter is the maximum delay added to the scheduled polls, to spread the polls and imports of crons sharing the same schedule.
+	// The delay of each cron is fixed, and derived from its UID.
+	// +optional
+	ScheduleJitter *metav1.Duration `json:"scheduleJitter,omitempty"`
+	// ImportWindow restricts when new imports are started. Polls still run according to the schedule,
+	// and an updated source is imported once the window opens.
+	// +optional
+	ImportWindow *DataImportCronImportWindow `json:"importWindow,omitempty"`
 	// GarbageCollect specifies whether old PVCs should be cleaned up after a new PVC is imported.
 	// Options are currently "Outdated" and "Never", defaults to "Outdated".
 	// +optional
@@ -559,12 +687,76 @@ type DataImportCronSpec struct {
 	// Number of import PVCs to keep when garbage collecting. Default is 3.
 	// +optional
 	ImportsToKeep *int32 `json:"importsToKeep,omitempty"`
+	// ImportsMaxAge is the age after which imports are garbage collected, based on their last use time.
+	// When set, ImportsToKeep is the minimum number of imports kept regardless of their age.
+	// +optional
+	ImportsMaxAge *metav1.Duration `json:"importsMaxAge,omitempty"`
+	// ImportsMaxTotalSize is the total size budget of the imports. Older imports exceeding the budget are garbage collected.
+	// When set, ImportsToKeep is the minimum number of imports kept regardless of their size.
+	// +optional
+	ImportsMaxTotalSize *resource.Quantity `json:"importsMaxTotalSize,omitempty"`
 	// ManagedDataSource specifies the name of the corresponding DataSource this cron will manage.
 	// DataSource has to be in the same namespace.
 	ManagedDataSource string `json:"managedDataSource"`
 	// RetentionPolicy specifies whether the created DataVolumes and DataSources are retained when their DataImportCron is deleted. Default is RatainAll.
 	// +optional
 	RetentionPolicy *DataImportCronRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// ChecksumURL is the URL of a checksum file, like SHA256SUMS, listing the sha256 checksum of the http source.
+	// When set, source updates are detected by checksum changes instead of the ETag or Last-Modified response headers.
+	// +optional
+	ChecksumURL *string `json:"checksumURL,omitempty"`
+	// Validation specifies a Job that validates each new import before the managed DataSource is updated to point to it.
+	// +optional
+	Validation *DataImportCronValidation `json:"validation,omitempty"`
+	// StorageClassTargets are additional storage classes each new import is cloned to, each with its own managed DataSource
+	// +optional
+	StorageClassTargets []DataImportCronStorageClassTarget `json:"storageClassTargets,omitempty"`
+}
+
+// DataImportCronImportWindow is a daily time window, in UTC, in which DataImportCron imports may start
+type DataImportCronImportWindow struct {
+	// Start is the window start time of day, in HH:MM format
+	Start string `json:"start"`
+	// End is the window end time of day, in HH:MM format. A window ending before its start ends on the next day.
+	End string `json:"end"`
+	// Days are the week days the window starts on, e.g. Monday. Defaults to all days.
+	// +optional
+	Days []string `json:"days,omitempty"`
+}
+
+// DataImportCronStorageClassTarget is an additional storage class a DataImportCron import is cloned to
+type DataImportCronStorageClassTarget struct {
+	// StorageClassName is the name of the storage class
+	StorageClassName string `json:"storageClassName"`
+	// ManagedDataSource is the name of the DataSource managed for the storage class.
+	// Defaults to the cron managedDataSource suffixed with the storage class name.
+	// +optional
+	ManagedDataSource string `json:"managedDataSource,omitempty"`
+}
+
+// DataImportCronValidation defines a Job run against a newly imported PVC before it is promoted to the managed DataSource.
+// The PVC is attached read-only, and its disk image path is passed in the CDI_VALIDATION_DISK environment variable.
+type DataImportCronValidation struct {
+	// Image is the container image of the validation Job
+	Image string `json:"image"`
+	// Command is the entrypoint of the validation container
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Args are the arguments to the entrypoint of the validation container
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env is a list of environment variables to set in the validation container
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources are the compute resources required by the validation container
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// BackoffLimit is the number of retries before the validation is considered failed. Default is 0.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is the duration in seconds the validation Job may run before it is considered failed
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 }
 
 // DataImportCronGarbageCollect represents the DataImportCron garbage collection mode
@@ -599,7 +791,47 @@ type DataImportCronStatus struct {
 	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
 	// SourceFormat defines the format of the DataImportCron-created disk image sources
 	SourceFormat *DataImportCronSourceFormat `json:"sourceFormat,omitempty"`
-	Conditions   []DataImportCronCondition   `json:"conditions,omitempty" optional:"true"`
+	// StorageClassTargets is the state of the copies of the last import in the storage class targets
+	StorageClassTargets []DataImportCronStorageClassTargetStatus `json:"storageClassTargets,omitempty"`
+	// History is a bounded list of the recent polls and imports, most recent first
+	History    []DataImportCronRun       `json:"history,omitempty"`
+	Conditions []DataImportCronCondition `json:"conditions,omitempty" optional:"true"`
+}
+
+// DataImportCronRun is a single poll or import of a DataImportCron
+type DataImportCronRun struct {
+	// Time of the run
+	Time metav1.Time `json:"time"`
+	// Digest is the source digest the run was for
+	Digest string `json:"digest,omitempty"`
+	// Action is the outcome of the run
+	Action DataImportCronRunAction `json:"action"`
+	// Message is the error reported by the poller or importer of a failed run
+	Message string `json:"message,omitempty"`
+}
+
+// DataImportCronRunAction is the outcome of a DataImportCron run
+type DataImportCronRunAction string
+
+const (
+	// DataImportCronRunSkipped means the poll found no new source digest
+	DataImportCronRunSkipped DataImportCronRunAction = "Skipped"
+	// DataImportCronRunImported means a new source digest was imported
+	DataImportCronRunImported DataImportCronRunAction = "Imported"
+	// DataImportCronRunFailed means the poll or import failed
+	DataImportCronRunFailed DataImportCronRunAction = "Failed"
+)
+
+// DataImportCronStorageClassTargetStatus is the state of a DataImportCron storage class target
+type DataImportCronStorageClassTargetStatus struct {
+	// StorageClassName is the name of the storage class
+	StorageClassName string `json:"storageClassName"`
+	// ManagedDataSource is the name of the DataSource managed for the storage class
+	ManagedDataSource string `json:"managedDataSource"`
+	// LastImportedPVC is the last copy of the import in the storage class
+	LastImportedPVC *DataVolumeSourcePVC `json:"lastImportedPVC,omitempty"`
+	// SourceFormat is the format of the disk image sources in the storage class
+	SourceFormat *DataImportCronSourceFormat `json:"sourceFormat,omitempty"`
 }
 
 // ImportStatus of a currently in progress import
@@ -625,6 +857,9 @@ const (
 
 	// DataImportCronUpToDate is the condition that indicates latest import is up to date
 	DataImportCronUpToDate DataImportCronConditionType = "UpToDate"
+
+	// DataImportCronValidated is the condition that indicates whether the latest import passed validation
+	DataImportCronValidated DataImportCronConditionType = "Validated"
 )
 
 // DataImportCronList provides the needed parameters to do request a list of DataImportCrons from the system
@@ -956,6 +1191,59 @@ type CDIConfigSpec struct {
 	// LogVerbosity overrides the default verbosity level used to initialize loggers
 	// +optional
 	LogVerbosity *int32 `json:"logVerbosity,omitempty"`
+	// CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from their source volume. Not limited by default.
+	// +optional
+	CloneBandwidthLimit *resource.Quantity `json:"cloneBandwidthLimit,omitempty"`
+	// DataImportCronMaxConcurrentImports is the maximum number of DataImportCron imports in progress in the cluster.
+	// Further imports are queued until others complete. Not limited by default.
+	// +optional
+	DataImportCronMaxConcurrentImports *int32 `json:"dataImportCronMaxConcurrentImports,omitempty"`
+	// DataImportCronValidationImages are the container images DataImportCron validation Jobs are allowed to run.
+	// A validation Job with any other image is not run, and the import fails validation.
+	// +optional
+	DataImportCronValidationImages []string `json:"dataImportCronValidationImages,omitempty"`
+	// WorkerPodLimits limits the number of concurrent importer and host-assisted clone pods.
+	// PVCs waiting for a worker pod are queued. Not limited by default.
+	// +optional
+	WorkerPodLimits *WorkerPodLimits `json:"workerPodLimits,omitempty"`
+	// RetryPolicy controls how failing imports are retried unless a DataVolume sets its own.
+	// Importer pods are restarted by Kubernetes indefinitely when neither sets a retry policy.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// ImportCache enables a node-local cache of imported registry image layers and HTTP images,
+	// shared by the importer pods running on each node
+	// +optional
+	ImportCache *ImportCacheSpec `json:"importCache,omitempty"`
+}
+
+// ImportCacheSpec defines the node-local import cache
+type ImportCacheSpec struct {
+	// SizeLimit is the maximum size of the cache on each node, the least recently used entries are evicted beyond it.
+	// Defaults to 10Gi.
+	// +optional
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+}
+
+// WorkerPodLimits defines the limits of concurrent CDI worker pods, i.e. importer pods,
+// and the upload server and clone source pods of host-assisted clones
+type WorkerPodLimits struct {
+	// Global is the maximum number of worker pods in the cluster
+	// +optional
+	Global *int32 `json:"global,omitempty"`
+	// PerNamespace is the maximum number of worker pods in each namespace
+	// +optional
+	PerNamespace *int32 `json:"perNamespace,omitempty"`
+	// PerStorageClass is the maximum number of worker pods writing to PVCs of each storage class
+	// +optional
+	PerStorageClass *int32 `json:"perStorageClass,omitempty"`
+	// PerSourceHost is the maximum number of importer pods reading from each source host
+	// +optional
+	PerSourceHost *int32 `json:"perSourceHost,omitempty"`
+	// PreemptLowerPriorityImports allows a queued worker pod to preempt the importer pods of lower priority PVCs,
+	// which restart their import from the beginning once admitted again. Only the imports annotated with
+	// cdi.kubevirt.io/storage.import.preemptible are preempted. The priority of a PVC is the value of its priority class.
+	// +optional
+	PreemptLowerPriorityImports bool `json:"preemptLowerPriorityImports,omitempty"`
 }
 
 // CDIConfigStatus provides the most recently observed status of the CDI Config resource
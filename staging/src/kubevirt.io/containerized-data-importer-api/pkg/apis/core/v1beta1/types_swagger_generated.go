@@ -21,6 +21,17 @@ func (DataVolumeSpec) SwaggerDoc() map[string]string {
 		"checkpoints":       "Checkpoints is a list of DataVolumeCheckpoints, representing stages in a multistage import.",
 		"finalCheckpoint":   "FinalCheckpoint indicates whether the current DataVolumeCheckpoint is the final checkpoint.",
 		"preallocation":     "Preallocation controls whether storage for DataVolumes should be allocated in advance.",
+		"retryPolicy":       "RetryPolicy controls how a failing import is retried, overriding the CDIConfig retry policy\n+optional",
+	}
+}
+
+func (RetryPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "RetryPolicy defines how a failing import is retried before its DataVolume fails",
+		"maxAttempts": "MaxAttempts is the maximum number of import attempts, including the first one. Unlimited by default.\n+optional",
+		"backoffBase": "BackoffBase is the delay before the first retry, doubled on each further retry. Defaults to 10s.\n+optional",
+		"backoffCap":  "BackoffCap is the maximum delay between retries. Defaults to 5m.\n+optional",
+		"retryOn":     "RetryOn is the list of error classes which are retried, any other error fails the import on its first occurrence.\nBy default, the errors which the importer reports as retryable are retried.\n+optional\n+listType=set",
 	}
 }
 
@@ -54,9 +65,18 @@ func (DataVolumeSource) SwaggerDoc() map[string]string {
 
 func (DataVolumeSourcePVC) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":          "DataVolumeSourcePVC provides the parameters to create a Data Volume from an existing PVC",
-		"namespace": "The namespace of the source PVC",
-		"name":      "The name of the source PVC",
+		"":           "DataVolumeSourcePVC provides the parameters to create a Data Volume from an existing PVC",
+		"namespace":  "The namespace of the source PVC",
+		"name":       "The name of the source PVC",
+		"pathFilter": "PathFilter selects the paths copied from the source PVC, only valid for filesystem clones with archive content\n+optional",
+	}
+}
+
+func (DataVolumeSourcePathFilter) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "DataVolumeSourcePathFilter provides the paths to include or exclude when cloning a filesystem PVC",
+		"include": "Include is the list of paths, relative to the root of the source PVC, to copy. Everything is copied if empty\n+optional",
+		"exclude": "Exclude is the list of paths or patterns, relative to the root of the source PVC, to skip\n+optional",
 	}
 }
 
@@ -116,6 +136,7 @@ func (DataVolumeSourceHTTP) SwaggerDoc() map[string]string {
 		"certConfigMap":      "CertConfigMap is a configmap reference, containing a Certificate Authority(CA) public key, and a base64 encoded pem certificate\n+optional",
 		"extraHeaders":       "ExtraHeaders is a list of strings containing extra headers to include with HTTP transfer requests\n+optional",
 		"secretExtraHeaders": "SecretExtraHeaders is a list of Secret references, each containing an extra HTTP header that may include sensitive information\n+optional",
+		"checksum":           "Checksum is the checksum of the image in the format <algorithm>:<hex digest>, e.g. sha256:1234...\nIt identifies the image when the ImportDeduplication feature gate is enabled\n+optional",
 	}
 }
 
@@ -185,6 +206,7 @@ func (StorageProfileSpec) SwaggerDoc() map[string]string {
 		"claimPropertySets":          "ClaimPropertySets is a provided set of properties applicable to PVC",
 		"dataImportCronSourceFormat": "DataImportCronSourceFormat defines the format of the DataImportCron-created disk image sources",
 		"snapshotClass":              "SnapshotClass is optional specific VolumeSnapshotClass for CloneStrategySnapshot. If not set, a VolumeSnapshotClass is chosen according to the provisioner.",
+		"cloneBandwidthLimit":        "CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from source volumes of this storage class. Overrides the CDIConfig limit.",
 	}
 }
 
@@ -197,6 +219,7 @@ func (StorageProfileStatus) SwaggerDoc() map[string]string {
 		"claimPropertySets":          "ClaimPropertySets computed from the spec and detected in the system",
 		"dataImportCronSourceFormat": "DataImportCronSourceFormat defines the format of the DataImportCron-created disk image sources",
 		"snapshotClass":              "SnapshotClass is optional specific VolumeSnapshotClass for CloneStrategySnapshot. If not set, a VolumeSnapshotClass is chosen according to the provisioner.",
+		"cloneBandwidthLimit":        "CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from source volumes of this storage class. Overrides the CDIConfig limit.",
 	}
 }
 
@@ -223,8 +246,27 @@ func (DataSource) SwaggerDoc() map[string]string {
 
 func (DataSourceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "DataSourceSpec defines specification for DataSource",
-		"source": "Source is the source of the data referenced by the DataSource",
+		"":               "DataSourceSpec defines specification for DataSource",
+		"source":         "Source is the source of the data referenced by the DataSource",
+		"pinnedRevision": "PinnedRevision pins the DataSource source to an earlier revision listed in status.revisions, e.g. to roll back a bad image.\nA pinned DataSource is not updated by its DataImportCron.\n+optional",
+		"grant":          "Grant shares the DataSource with other namespaces. DataVolumes in the granted namespaces may clone the DataSource\nsource without permissions on it, and DataVolumes in other namespaces may not reference the DataSource.\n+optional",
+		"cache":          "Cache is the storage of the cache PVC a remote source is imported to. Required for a remote source.\n+optional",
+	}
+}
+
+func (DataSourceCache) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DataSourceCache specifies the storage of the cache PVC a DataSource remote source is imported to",
+		"size":             "Size is the storage size of the cache PVC",
+		"storageClassName": "StorageClassName is the storage class of the cache PVC. The default storage class is used if not set.\n+optional",
+	}
+}
+
+func (DataSourceGrant) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DataSourceGrant specifies the namespaces a DataSource is shared with.\nPermissions on the source are granted only for a source in the DataSource namespace.",
+		"namespaces":        "Namespaces are the names of the granted namespaces\n+optional",
+		"namespaceSelector": "NamespaceSelector selects the granted namespaces by their labels\n+optional",
 	}
 }
 
@@ -233,13 +275,27 @@ func (DataSourceSource) SwaggerDoc() map[string]string {
 		"":         "DataSourceSource represents the source for our DataSource",
 		"pvc":      "+optional",
 		"snapshot": "+optional",
+		"http":     "HTTP is a remote http source. The first DataVolume referencing the DataSource imports it to a cache PVC\nin the DataSource namespace, which is cloned by the DataVolumes referencing the DataSource.\n+optional",
+		"registry": "Registry is a remote registry source, imported to a cache PVC like the HTTP source\n+optional",
 	}
 }
 
 func (DataSourceStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "DataSourceStatus provides the most recently observed status of the DataSource",
-		"source": "Source is the current source of the data referenced by the DataSource",
+		"":          "DataSourceStatus provides the most recently observed status of the DataSource",
+		"source":    "Source is the current source of the data referenced by the DataSource",
+		"revision":  "Revision is the revision number of the current source\n+optional",
+		"revisions": "Revisions is the bounded history of the DataSource sources, oldest first.\nRevisions whose source no longer exists are removed.\n+optional",
+	}
+}
+
+func (DataSourceRevision) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "DataSourceRevision is a source the DataSource has pointed to",
+		"revision":  "Revision is the revision number, increasing with every new source",
+		"source":    "Source is the source of the revision",
+		"digest":    "Digest is the digest of the DataImportCron import of the source, if any\n+optional",
+		"timestamp": "Timestamp is the time the revision was created",
 	}
 }
 
@@ -270,13 +326,50 @@ func (DataImportCron) SwaggerDoc() map[string]string {
 
 func (DataImportCronSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                  "DataImportCronSpec defines specification for DataImportCron",
-		"template":          "Template specifies template for the DVs to be created",
-		"schedule":          "Schedule specifies in cron format when and how often to look for new imports",
-		"garbageCollect":    "GarbageCollect specifies whether old PVCs should be cleaned up after a new PVC is imported.\nOptions are currently \"Outdated\" and \"Never\", defaults to \"Outdated\".\n+optional",
-		"importsToKeep":     "Number of import PVCs to keep when garbage collecting. Default is 3.\n+optional",
-		"managedDataSource": "ManagedDataSource specifies the name of the corresponding DataSource this cron will manage.\nDataSource has to be in the same namespace.",
-		"retentionPolicy":   "RetentionPolicy specifies whether the created DataVolumes and DataSources are retained when their DataImportCron is deleted. Default is RatainAll.\n+optional",
+		"":                    "DataImportCronSpec defines specification for DataImportCron",
+		"template":            "Template specifies template for the DVs to be created",
+		"schedule":            "Schedule specifies in cron format when and how often to look for new imports",
+		"scheduleJitter":      "ScheduleJitter is the maximum delay added to the scheduled polls, to spread the polls and imports of crons sharing the same schedule.\nThe delay of each cron is fixed, and derived from its UID.\n+optional",
+		"importWindow":        "ImportWindow restricts when new imports are started. Polls still run according to the schedule,\nand an updated source is imported once the window opens.\n+optional",
+		"garbageCollect":      "GarbageCollect specifies whether old PVCs should be cleaned up after a new PVC is imported.\nOptions are currently \"Outdated\" and \"Never\", defaults to \"Outdated\".\n+optional",
+		"importsToKeep":       "Number of import PVCs to keep when garbage collecting. Default is 3.\n+optional",
+		"importsMaxAge":       "ImportsMaxAge is the age after which imports are garbage collected, based on their last use time.\nWhen set, ImportsToKeep is the minimum number of imports kept regardless of their age.\n+optional",
+		"importsMaxTotalSize": "ImportsMaxTotalSize is the total size budget of the imports. Older imports exceeding the budget are garbage collected.\nWhen set, ImportsToKeep is the minimum number of imports kept regardless of their size.\n+optional",
+		"managedDataSource":   "ManagedDataSource specifies the name of the corresponding DataSource this cron will manage.\nDataSource has to be in the same namespace.",
+		"retentionPolicy":     "RetentionPolicy specifies whether the created DataVolumes and DataSources are retained when their DataImportCron is deleted. Default is RatainAll.\n+optional",
+		"checksumURL":         "ChecksumURL is the URL of a checksum file, like SHA256SUMS, listing the sha256 checksum of the http source.\nWhen set, source updates are detected by checksum changes instead of the ETag or Last-Modified response headers.\n+optional",
+		"validation":          "Validation specifies a Job that validates each new import before the managed DataSource is updated to point to it.\n+optional",
+		"storageClassTargets": "StorageClassTargets are additional storage classes each new import is cloned to, each with its own managed DataSource\n+optional",
+	}
+}
+
+func (DataImportCronImportWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "DataImportCronImportWindow is a daily time window, in UTC, in which DataImportCron imports may start",
+		"start": "Start is the window start time of day, in HH:MM format",
+		"end":   "End is the window end time of day, in HH:MM format. A window ending before its start ends on the next day.",
+		"days":  "Days are the week days the window starts on, e.g. Monday. Defaults to all days.\n+optional",
+	}
+}
+
+func (DataImportCronStorageClassTarget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DataImportCronStorageClassTarget is an additional storage class a DataImportCron import is cloned to",
+		"storageClassName":  "StorageClassName is the name of the storage class",
+		"managedDataSource": "ManagedDataSource is the name of the DataSource managed for the storage class.\nDefaults to the cron managedDataSource suffixed with the storage class name.\n+optional",
+	}
+}
+
+func (DataImportCronValidation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "DataImportCronValidation defines a Job run against a newly imported PVC before it is promoted to the managed DataSource.\nThe PVC is attached read-only, and its disk image path is passed in the CDI_VALIDATION_DISK environment variable.",
+		"image":                 "Image is the container image of the validation Job",
+		"command":               "Command is the entrypoint of the validation container\n+optional",
+		"args":                  "Args are the arguments to the entrypoint of the validation container\n+optional",
+		"env":                   "Env is a list of environment variables to set in the validation container\n+optional",
+		"resources":             "Resources are the compute resources required by the validation container\n+optional",
+		"backoffLimit":          "BackoffLimit is the number of retries before the validation is considered failed. Default is 0.\n+optional",
+		"activeDeadlineSeconds": "ActiveDeadlineSeconds is the duration in seconds the validation Job may run before it is considered failed\n+optional",
 	}
 }
 
@@ -288,6 +381,28 @@ func (DataImportCronStatus) SwaggerDoc() map[string]string {
 		"lastExecutionTimestamp": "LastExecutionTimestamp is the time of the last polling",
 		"lastImportTimestamp":    "LastImportTimestamp is the time of the last import",
 		"sourceFormat":           "SourceFormat defines the format of the DataImportCron-created disk image sources",
+		"storageClassTargets":    "StorageClassTargets is the state of the copies of the last import in the storage class targets",
+		"history":                "History is a bounded list of the recent polls and imports, most recent first",
+	}
+}
+
+func (DataImportCronRun) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "DataImportCronRun is a single poll or import of a DataImportCron",
+		"time":    "Time of the run",
+		"digest":  "Digest is the source digest the run was for",
+		"action":  "Action is the outcome of the run",
+		"message": "Message is the error reported by the poller or importer of a failed run",
+	}
+}
+
+func (DataImportCronStorageClassTargetStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DataImportCronStorageClassTargetStatus is the state of a DataImportCron storage class target",
+		"storageClassName":  "StorageClassName is the name of the storage class",
+		"managedDataSource": "ManagedDataSource is the name of the DataSource managed for the storage class",
+		"lastImportedPVC":   "LastImportedPVC is the last copy of the import in the storage class",
+		"sourceFormat":      "SourceFormat is the format of the disk image sources in the storage class",
 	}
 }
 
@@ -466,19 +581,43 @@ func (FilesystemOverhead) SwaggerDoc() map[string]string {
 
 func (CDIConfigSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                         "CDIConfigSpec defines specification for user configuration",
-		"uploadProxyURLOverride":   "Override the URL used when uploading to a DataVolume",
-		"importProxy":              "ImportProxy contains importer pod proxy configuration.\n+optional",
-		"scratchSpaceStorageClass": "Override the storage class to used for scratch space during transfer operations. The scratch space storage class is determined in the following order: 1. value of scratchSpaceStorageClass, if that doesn't exist, use the default storage class, if there is no default storage class, use the storage class of the DataVolume, if no storage class specified, use no storage class for scratch space",
-		"podResourceRequirements":  "ResourceRequirements describes the compute resource requirements.",
-		"featureGates":             "FeatureGates are a list of specific enabled feature gates",
-		"filesystemOverhead":       "FilesystemOverhead describes the space reserved for overhead when using Filesystem volumes. A value is between 0 and 1, if not defined it is 0.055 (5.5% overhead)",
-		"preallocation":            "Preallocation controls whether storage for DataVolumes should be allocated in advance.",
-		"insecureRegistries":       "InsecureRegistries is a list of TLS disabled registries",
-		"dataVolumeTTLSeconds":     "DataVolumeTTLSeconds is the time in seconds after DataVolume completion it can be garbage collected. Disabled by default.\n+optional",
-		"tlsSecurityProfile":       "TLSSecurityProfile is used by operators to apply cluster-wide TLS security settings to operands.",
-		"imagePullSecrets":         "The imagePullSecrets used to pull the container images",
-		"logVerbosity":             "LogVerbosity overrides the default verbosity level used to initialize loggers\n+optional",
+		"":                                   "CDIConfigSpec defines specification for user configuration",
+		"uploadProxyURLOverride":             "Override the URL used when uploading to a DataVolume",
+		"importProxy":                        "ImportProxy contains importer pod proxy configuration.\n+optional",
+		"scratchSpaceStorageClass":           "Override the storage class to used for scratch space during transfer operations. The scratch space storage class is determined in the following order: 1. value of scratchSpaceStorageClass, if that doesn't exist, use the default storage class, if there is no default storage class, use the storage class of the DataVolume, if no storage class specified, use no storage class for scratch space",
+		"podResourceRequirements":            "ResourceRequirements describes the compute resource requirements.",
+		"featureGates":                       "FeatureGates are a list of specific enabled feature gates",
+		"filesystemOverhead":                 "FilesystemOverhead describes the space reserved for overhead when using Filesystem volumes. A value is between 0 and 1, if not defined it is 0.055 (5.5% overhead)",
+		"preallocation":                      "Preallocation controls whether storage for DataVolumes should be allocated in advance.",
+		"insecureRegistries":                 "InsecureRegistries is a list of TLS disabled registries",
+		"dataVolumeTTLSeconds":               "DataVolumeTTLSeconds is the time in seconds after DataVolume completion it can be garbage collected. Disabled by default.\n+optional",
+		"tlsSecurityProfile":                 "TLSSecurityProfile is used by operators to apply cluster-wide TLS security settings to operands.",
+		"imagePullSecrets":                   "The imagePullSecrets used to pull the container images",
+		"logVerbosity":                       "LogVerbosity overrides the default verbosity level used to initialize loggers\n+optional",
+		"cloneBandwidthLimit":                "CloneBandwidthLimit is the maximum rate, in bytes per second, at which host-assisted clones read from their source volume. Not limited by default.\n+optional",
+		"dataImportCronMaxConcurrentImports": "DataImportCronMaxConcurrentImports is the maximum number of DataImportCron imports in progress in the cluster.\nFurther imports are queued until others complete. Not limited by default.\n+optional",
+		"dataImportCronValidationImages":     "DataImportCronValidationImages are the container images DataImportCron validation Jobs are allowed to run.\nA validation Job with any other image is not run, and the import fails validation.\n+optional",
+		"workerPodLimits":                    "WorkerPodLimits limits the number of concurrent importer and host-assisted clone pods.\nPVCs waiting for a worker pod are queued. Not limited by default.\n+optional",
+		"retryPolicy":                        "RetryPolicy controls how failing imports are retried unless a DataVolume sets its own.\nImporter pods are restarted by Kubernetes indefinitely when neither sets a retry policy.\n+optional",
+		"importCache":                        "ImportCache enables a node-local cache of imported registry image layers and HTTP images,\nshared by the importer pods running on each node\n+optional",
+	}
+}
+
+func (ImportCacheSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "ImportCacheSpec defines the node-local import cache",
+		"sizeLimit": "SizeLimit is the maximum size of the cache on each node, the least recently used entries are evicted beyond it.\nDefaults to 10Gi.\n+optional",
+	}
+}
+
+func (WorkerPodLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "WorkerPodLimits defines the limits of concurrent CDI worker pods, i.e. importer pods,\nand the upload server and clone source pods of host-assisted clones",
+		"global":                      "Global is the maximum number of worker pods in the cluster\n+optional",
+		"perNamespace":                "PerNamespace is the maximum number of worker pods in each namespace\n+optional",
+		"perStorageClass":             "PerStorageClass is the maximum number of worker pods writing to PVCs of each storage class\n+optional",
+		"perSourceHost":               "PerSourceHost is the maximum number of importer pods reading from each source host\n+optional",
+		"preemptLowerPriorityImports": "PreemptLowerPriorityImports allows a queued worker pod to preempt the importer pods of lower priority PVCs,\nwhich restart their import from the beginning once admitted again. Only the imports annotated with\ncdi.kubevirt.io/storage.import.preemptible are preempted. The priority of a PVC is the value of its priority class.\n+optional",
 	}
 }
 
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -77,3 +79,45 @@ func IsWaitForFirstConsumerBeforePopulating(pvc *corev1.PersistentVolumeClaim, g
 	}
 	return false, nil
 }
+
+// IsRemote tells whether the source is an http or registry source, which is imported to a cache PVC on first use
+func (source *DataSourceSource) IsRemote() bool {
+	return source.PVC == nil && source.Snapshot == nil && (source.HTTP != nil || source.Registry != nil)
+}
+
+// DataSourceCacheNamePrefix prefixes the names of the PVCs caching the imports of DataSource remote sources in the CDI namespace
+const DataSourceCacheNamePrefix = "cdi-datasource-cache"
+
+// IsDataSourceCache tells whether the PVC is named like the cache of a DataSource remote source
+func (source *DataVolumeSourcePVC) IsDataSourceCache() bool {
+	return strings.HasPrefix(source.Name, DataSourceCacheNamePrefix+"-")
+}
+
+// GetCloneSource returns the source cloned by the DataVolumes referencing the DataSource: the source of the
+// pinned revision, or the cache PVC for a remote source
+func (ds *DataSource) GetCloneSource() *DataSourceSource {
+	if revision := ds.GetPinnedRevision(); revision != nil {
+		return &revision.Source
+	}
+	if !ds.Spec.Source.IsRemote() {
+		return &ds.Spec.Source
+	}
+	// The cache PVC is named after the remote source by the DataSource controller, which records it in the status
+	if pvc := ds.Status.Source.PVC; pvc != nil && pvc.IsDataSourceCache() {
+		return &ds.Status.Source
+	}
+	return &DataSourceSource{}
+}
+
+// GetPinnedRevision returns the revision the DataSource is pinned to, or nil if it is not pinned or the revision is unknown
+func (ds *DataSource) GetPinnedRevision() *DataSourceRevision {
+	if ds.Spec.PinnedRevision == nil {
+		return nil
+	}
+	for i := range ds.Status.Revisions {
+		if ds.Status.Revisions[i].Revision == *ds.Spec.PinnedRevision {
+			return &ds.Status.Revisions[i]
+		}
+	}
+	return nil
+}
@@ -206,6 +206,36 @@ func (in *CDIConfigSpec) DeepCopyInto(out *CDIConfigSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.CloneBandwidthLimit != nil {
+		in, out := &in.CloneBandwidthLimit, &out.CloneBandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DataImportCronMaxConcurrentImports != nil {
+		in, out := &in.DataImportCronMaxConcurrentImports, &out.DataImportCronMaxConcurrentImports
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DataImportCronValidationImages != nil {
+		in, out := &in.DataImportCronValidationImages, &out.DataImportCronValidationImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerPodLimits != nil {
+		in, out := &in.WorkerPodLimits, &out.WorkerPodLimits
+		*out = new(WorkerPodLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImportCache != nil {
+		in, out := &in.ImportCache, &out.ImportCache
+		*out = new(ImportCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -468,6 +498,27 @@ func (in *DataImportCronCondition) DeepCopy() *DataImportCronCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronImportWindow) DeepCopyInto(out *DataImportCronImportWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronImportWindow.
+func (in *DataImportCronImportWindow) DeepCopy() *DataImportCronImportWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronImportWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronList) DeepCopyInto(out *DataImportCronList) {
 	*out = *in
@@ -501,10 +552,37 @@ func (in *DataImportCronList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronRun) DeepCopyInto(out *DataImportCronRun) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronRun.
+func (in *DataImportCronRun) DeepCopy() *DataImportCronRun {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronSpec) DeepCopyInto(out *DataImportCronSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.ScheduleJitter != nil {
+		in, out := &in.ScheduleJitter, &out.ScheduleJitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ImportWindow != nil {
+		in, out := &in.ImportWindow, &out.ImportWindow
+		*out = new(DataImportCronImportWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GarbageCollect != nil {
 		in, out := &in.GarbageCollect, &out.GarbageCollect
 		*out = new(DataImportCronGarbageCollect)
@@ -515,11 +593,36 @@ func (in *DataImportCronSpec) DeepCopyInto(out *DataImportCronSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ImportsMaxAge != nil {
+		in, out := &in.ImportsMaxAge, &out.ImportsMaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ImportsMaxTotalSize != nil {
+		in, out := &in.ImportsMaxTotalSize, &out.ImportsMaxTotalSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.RetentionPolicy != nil {
 		in, out := &in.RetentionPolicy, &out.RetentionPolicy
 		*out = new(DataImportCronRetentionPolicy)
 		**out = **in
 	}
+	if in.ChecksumURL != nil {
+		in, out := &in.ChecksumURL, &out.ChecksumURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(DataImportCronValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClassTargets != nil {
+		in, out := &in.StorageClassTargets, &out.StorageClassTargets
+		*out = make([]DataImportCronStorageClassTarget, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -544,7 +647,7 @@ func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	if in.LastImportedPVC != nil {
 		in, out := &in.LastImportedPVC, &out.LastImportedPVC
 		*out = new(DataVolumeSourcePVC)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LastExecutionTimestamp != nil {
 		in, out := &in.LastExecutionTimestamp, &out.LastExecutionTimestamp
@@ -559,6 +662,20 @@ func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 		*out = new(DataImportCronSourceFormat)
 		**out = **in
 	}
+	if in.StorageClassTargets != nil {
+		in, out := &in.StorageClassTargets, &out.StorageClassTargets
+		*out = make([]DataImportCronStorageClassTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]DataImportCronRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]DataImportCronCondition, len(*in))
@@ -579,6 +696,92 @@ func (in *DataImportCronStatus) DeepCopy() *DataImportCronStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronStorageClassTarget) DeepCopyInto(out *DataImportCronStorageClassTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronStorageClassTarget.
+func (in *DataImportCronStorageClassTarget) DeepCopy() *DataImportCronStorageClassTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronStorageClassTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronStorageClassTargetStatus) DeepCopyInto(out *DataImportCronStorageClassTargetStatus) {
+	*out = *in
+	if in.LastImportedPVC != nil {
+		in, out := &in.LastImportedPVC, &out.LastImportedPVC
+		*out = new(DataVolumeSourcePVC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceFormat != nil {
+		in, out := &in.SourceFormat, &out.SourceFormat
+		*out = new(DataImportCronSourceFormat)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronStorageClassTargetStatus.
+func (in *DataImportCronStorageClassTargetStatus) DeepCopy() *DataImportCronStorageClassTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronStorageClassTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronValidation) DeepCopyInto(out *DataImportCronValidation) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronValidation.
+func (in *DataImportCronValidation) DeepCopy() *DataImportCronValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSource) DeepCopyInto(out *DataSource) {
 	*out = *in
@@ -607,6 +810,28 @@ func (in *DataSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceCache) DeepCopyInto(out *DataSourceCache) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceCache.
+func (in *DataSourceCache) DeepCopy() *DataSourceCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSourceCondition) DeepCopyInto(out *DataSourceCondition) {
 	*out = *in
@@ -624,6 +849,32 @@ func (in *DataSourceCondition) DeepCopy() *DataSourceCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceGrant) DeepCopyInto(out *DataSourceGrant) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceGrant.
+func (in *DataSourceGrant) DeepCopy() *DataSourceGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSourceList) DeepCopyInto(out *DataSourceList) {
 	*out = *in
@@ -657,19 +908,47 @@ func (in *DataSourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceRevision) DeepCopyInto(out *DataSourceRevision) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceRevision.
+func (in *DataSourceRevision) DeepCopy() *DataSourceRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSourceSource) DeepCopyInto(out *DataSourceSource) {
 	*out = *in
 	if in.PVC != nil {
 		in, out := &in.PVC, &out.PVC
 		*out = new(DataVolumeSourcePVC)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Snapshot != nil {
 		in, out := &in.Snapshot, &out.Snapshot
 		*out = new(DataVolumeSourceSnapshot)
 		**out = **in
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(DataVolumeSourceHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(DataVolumeSourceRegistry)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -687,6 +966,21 @@ func (in *DataSourceSource) DeepCopy() *DataSourceSource {
 func (in *DataSourceSpec) DeepCopyInto(out *DataSourceSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
+	if in.PinnedRevision != nil {
+		in, out := &in.PinnedRevision, &out.PinnedRevision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Grant != nil {
+		in, out := &in.Grant, &out.Grant
+		*out = new(DataSourceGrant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(DataSourceCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -704,6 +998,13 @@ func (in *DataSourceSpec) DeepCopy() *DataSourceSpec {
 func (in *DataSourceStatus) DeepCopyInto(out *DataSourceStatus) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]DataSourceRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]DataSourceCondition, len(*in))
@@ -861,7 +1162,7 @@ func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	if in.PVC != nil {
 		in, out := &in.PVC, &out.PVC
 		*out = new(DataVolumeSourcePVC)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Upload != nil {
 		in, out := &in.Upload, &out.Upload
@@ -962,6 +1263,11 @@ func (in *DataVolumeSourceImageIO) DeepCopy() *DataVolumeSourceImageIO {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSourcePVC) DeepCopyInto(out *DataVolumeSourcePVC) {
 	*out = *in
+	if in.PathFilter != nil {
+		in, out := &in.PathFilter, &out.PathFilter
+		*out = new(DataVolumeSourcePathFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -975,6 +1281,32 @@ func (in *DataVolumeSourcePVC) DeepCopy() *DataVolumeSourcePVC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolumeSourcePathFilter) DeepCopyInto(out *DataVolumeSourcePathFilter) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolumeSourcePathFilter.
+func (in *DataVolumeSourcePathFilter) DeepCopy() *DataVolumeSourcePathFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolumeSourcePathFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSourceRef) DeepCopyInto(out *DataVolumeSourceRef) {
 	*out = *in
@@ -1134,6 +1466,11 @@ func (in *DataVolumeSpec) DeepCopyInto(out *DataVolumeSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1193,6 +1530,27 @@ func (in *FilesystemOverhead) DeepCopy() *FilesystemOverhead {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportCacheSpec) DeepCopyInto(out *ImportCacheSpec) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportCacheSpec.
+func (in *ImportCacheSpec) DeepCopy() *ImportCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportProxy) DeepCopyInto(out *ImportProxy) {
 	*out = *in
@@ -1428,6 +1786,42 @@ func (in *ObjectTransferStatus) DeepCopy() *ObjectTransferStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffBase != nil {
+		in, out := &in.BackoffBase, &out.BackoffBase
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BackoffCap != nil {
+		in, out := &in.BackoffCap, &out.BackoffCap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]ImportErrorClass, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageProfile) DeepCopyInto(out *StorageProfile) {
 	*out = *in
@@ -1514,6 +1908,11 @@ func (in *StorageProfileSpec) DeepCopyInto(out *StorageProfileSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CloneBandwidthLimit != nil {
+		in, out := &in.CloneBandwidthLimit, &out.CloneBandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -1562,6 +1961,11 @@ func (in *StorageProfileStatus) DeepCopyInto(out *StorageProfileStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CloneBandwidthLimit != nil {
+		in, out := &in.CloneBandwidthLimit, &out.CloneBandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -1973,3 +2377,39 @@ func (in *VolumeUploadSourceStatus) DeepCopy() *VolumeUploadSourceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPodLimits) DeepCopyInto(out *WorkerPodLimits) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerNamespace != nil {
+		in, out := &in.PerNamespace, &out.PerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerStorageClass != nil {
+		in, out := &in.PerStorageClass, &out.PerStorageClass
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerSourceHost != nil {
+		in, out := &in.PerSourceHost, &out.PerSourceHost
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPodLimits.
+func (in *WorkerPodLimits) DeepCopy() *WorkerPodLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPodLimits)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"crypto/rsa"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+
+	"kubevirt.io/containerized-data-importer/pkg/common"
+	"kubevirt.io/containerized-data-importer/pkg/importcache"
+	"kubevirt.io/containerized-data-importer/pkg/token"
+)
+
+const (
+	publicKeyEnvName = "APISERVER_PUBLIC_KEY"
+	tokenLeeway      = 10 * time.Second
+)
+
+func main() {
+	port := flag.Int("port", common.ImportCachePort, "server port")
+	dir := flag.String("dir", common.ImportCacheDir, "directory of the cache entries")
+	sizeLimit := flag.Int64("size-limit", 10*1024*1024*1024, "maximum size of the cache in bytes")
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	publicKey, err := getTokenPublicKey()
+	if err != nil {
+		klog.Fatalf("Failed reading the token public key: %v", err)
+	}
+	store, err := importcache.NewStore(*dir, *sizeLimit)
+	if err != nil {
+		klog.Fatalf("Failed creating the cache in %s: %v", *dir, err)
+	}
+	validator := token.NewValidator(common.ImportCacheTokenIssuer, publicKey, tokenLeeway)
+	klog.Infof("Serving the import cache in %s, limited to %d bytes, on port %d", *dir, *sizeLimit, *port)
+	if err := http.ListenAndServe(fmt.Sprintf(":%d", *port), importcache.NewServer(store, validator)); err != nil {
+		klog.Fatalf("Serve failed: %v", err)
+	}
+}
+
+func getTokenPublicKey() (*rsa.PublicKey, error) {
+	keys, err := keyutil.ParsePublicKeysPEM([]byte(os.Getenv(publicKeyEnvName)))
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("%s does not hold a single public key", publicKeyEnvName)
+	}
+	key, ok := keys[0].(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s does not hold an RSA public key", publicKeyEnvName)
+	}
+	return key, nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
 	"net/http"
 	neturl "net/url"
@@ -27,6 +28,8 @@ var (
 	cronNamespace string
 	cronName      string
 	url           string
+	sourceType    string
+	checksumURL   string
 	certDir       string
 	accessKey     string
 	secretKey     string
@@ -38,8 +41,10 @@ func init() {
 	flag.StringVar(&kubeURL, "server", "", "(Optional) URL address of a remote api server.  Do not set for local clusters.")
 	flag.StringVar(&cronNamespace, "ns", "", "DataImportCron namespace.")
 	flag.StringVar(&cronName, "cron", "", "DataImportCron name.")
-	flag.StringVar(&url, "url", "", "registry source url.")
-	flag.StringVar(&certDir, "certdir", "", "registry certificates path.")
+	flag.StringVar(&url, "url", "", "source url.")
+	flag.StringVar(&sourceType, "source", cc.SourceRegistry, "source type: registry, http or s3.")
+	flag.StringVar(&checksumURL, "checksum-url", "", "(Optional) url of a checksum file listing the http source.")
+	flag.StringVar(&certDir, "certdir", "", "source certificates path.")
 	flag.Parse()
 	if url == "" || cronNamespace == "" || cronName == "" {
 		log.Fatalf("One or more mandatory parameters are missing")
@@ -56,15 +61,25 @@ func main() {
 		allCertDir = certDir
 	}
 
-	digest, err := importer.GetImageDigest(url, accessKey, secretKey, allCertDir, insecureTLS)
+	var digest string
+	switch sourceType {
+	case cc.SourceRegistry:
+		digest, err = importer.GetImageDigest(url, accessKey, secretKey, allCertDir, insecureTLS)
+	case cc.SourceHTTP:
+		digest, err = importer.GetHTTPSourceDigest(url, checksumURL, accessKey, secretKey, allCertDir)
+	case cc.SourceS3:
+		digest, err = importer.GetS3SourceDigest(url, accessKey, secretKey, allCertDir)
+	default:
+		fatalf("Unsupported source type %s", sourceType)
+	}
 	if err != nil {
-		log.Fatalf("Failed to get image digest: %v", err)
+		fatalf("Failed to get %s source digest: %v", sourceType, err)
 	}
 	log.Printf("Digest is %s", digest)
 
 	cfg, err := clientcmd.BuildConfigFromFlags(kubeURL, configPath)
 	if err != nil {
-		log.Fatalf("Failed to build config; kubeURL %s configPath %s: %v", kubeURL, configPath, err)
+		fatalf("Failed to build config; kubeURL %s configPath %s: %v", kubeURL, configPath, err)
 	}
 
 	// Don't proxy k8s api calls
@@ -74,12 +89,12 @@ func main() {
 
 	cdiClient, err := cdiClientset.NewForConfig(cfg)
 	if err != nil {
-		log.Fatalf("Failed to create Clientset: %v", err)
+		fatalf("Failed to create Clientset: %v", err)
 	}
 
 	dataImportCron, err := cdiClient.CdiV1beta1().DataImportCrons(cronNamespace).Get(context.TODO(), cronName, metav1.GetOptions{})
 	if err != nil {
-		log.Fatalf("Failed getting DataImportCron %s/%s: %v", cronNamespace, cronName, err)
+		fatalf("Failed getting DataImportCron %s/%s: %v", cronNamespace, cronName, err)
 	}
 	cc.AddAnnotation(dataImportCron, controller.AnnLastCronTime, time.Now().Format(time.RFC3339))
 
@@ -92,6 +107,15 @@ func main() {
 
 	_, err = cdiClient.CdiV1beta1().DataImportCrons(cronNamespace).Update(context.TODO(), dataImportCron, metav1.UpdateOptions{})
 	if err != nil {
-		log.Fatalf("Failed updating DataImportCron %s/%s: %v", cronNamespace, cronName, err)
+		fatalf("Failed updating DataImportCron %s/%s: %v", cronNamespace, cronName, err)
+	}
+}
+
+// fatalf logs the error and writes it to the termination message, so the DataImportCron run history shows it
+func fatalf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if err := util.WriteTerminationMessage(msg); err != nil {
+		log.Printf("%v", err)
 	}
+	log.Fatal(msg)
 }